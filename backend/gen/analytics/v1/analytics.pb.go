@@ -0,0 +1,681 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: analytics/v1/analytics.proto
+
+package analyticsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Dimension int32
+
+const (
+	Dimension_DIMENSION_UNSPECIFIED Dimension = 0
+	Dimension_DIMENSION_QTYPE       Dimension = 1
+	Dimension_DIMENSION_RCODE       Dimension = 2
+	Dimension_DIMENSION_COUNTRY     Dimension = 3
+	Dimension_DIMENSION_NAME        Dimension = 4
+)
+
+// Enum value maps for Dimension.
+var (
+	Dimension_name = map[int32]string{
+		0: "DIMENSION_UNSPECIFIED",
+		1: "DIMENSION_QTYPE",
+		2: "DIMENSION_RCODE",
+		3: "DIMENSION_COUNTRY",
+		4: "DIMENSION_NAME",
+	}
+	Dimension_value = map[string]int32{
+		"DIMENSION_UNSPECIFIED": 0,
+		"DIMENSION_QTYPE":       1,
+		"DIMENSION_RCODE":       2,
+		"DIMENSION_COUNTRY":     3,
+		"DIMENSION_NAME":        4,
+	}
+)
+
+func (x Dimension) Enum() *Dimension {
+	p := new(Dimension)
+	*p = x
+	return p
+}
+
+func (x Dimension) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Dimension) Descriptor() protoreflect.EnumDescriptor {
+	return file_analytics_v1_analytics_proto_enumTypes[0].Descriptor()
+}
+
+func (Dimension) Type() protoreflect.EnumType {
+	return &file_analytics_v1_analytics_proto_enumTypes[0]
+}
+
+func (x Dimension) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Dimension.Descriptor instead.
+func (Dimension) EnumDescriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{0}
+}
+
+type QueryFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Qtype         string                 `protobuf:"bytes,2,opt,name=qtype,proto3" json:"qtype,omitempty"`
+	Rcode         string                 `protobuf:"bytes,3,opt,name=rcode,proto3" json:"rcode,omitempty"`
+	Country       string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryFilter) Reset() {
+	*x = QueryFilter{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFilter) ProtoMessage() {}
+
+func (x *QueryFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryFilter.ProtoReflect.Descriptor instead.
+func (*QueryFilter) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueryFilter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QueryFilter) GetQtype() string {
+	if x != nil {
+		return x.Qtype
+	}
+	return ""
+}
+
+func (x *QueryFilter) GetRcode() string {
+	if x != nil {
+		return x.Rcode
+	}
+	return ""
+}
+
+func (x *QueryFilter) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type Point struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     string                 `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Point) Reset() {
+	*x = Point{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Point) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Point) ProtoMessage() {}
+
+func (x *Point) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Point.ProtoReflect.Descriptor instead.
+func (*Point) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Point) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *Point) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Series struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Points        []*Point               `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Series) Reset() {
+	*x = Series{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Series) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Series) ProtoMessage() {}
+
+func (x *Series) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Series.ProtoReflect.Descriptor instead.
+func (*Series) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Series) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Series) GetPoints() []*Point {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *Series) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetQueryTimeSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneId        string                 `protobuf:"bytes,1,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	Start         string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	StepSeconds   int64                  `protobuf:"varint,4,opt,name=step_seconds,json=stepSeconds,proto3" json:"step_seconds,omitempty"`
+	GroupBy       Dimension              `protobuf:"varint,5,opt,name=group_by,json=groupBy,proto3,enum=analytics.v1.Dimension" json:"group_by,omitempty"`
+	Filter        *QueryFilter           `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueryTimeSeriesRequest) Reset() {
+	*x = GetQueryTimeSeriesRequest{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueryTimeSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueryTimeSeriesRequest) ProtoMessage() {}
+
+func (x *GetQueryTimeSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueryTimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetQueryTimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetQueryTimeSeriesRequest) GetZoneId() string {
+	if x != nil {
+		return x.ZoneId
+	}
+	return ""
+}
+
+func (x *GetQueryTimeSeriesRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *GetQueryTimeSeriesRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *GetQueryTimeSeriesRequest) GetStepSeconds() int64 {
+	if x != nil {
+		return x.StepSeconds
+	}
+	return 0
+}
+
+func (x *GetQueryTimeSeriesRequest) GetGroupBy() Dimension {
+	if x != nil {
+		return x.GroupBy
+	}
+	return Dimension_DIMENSION_UNSPECIFIED
+}
+
+func (x *GetQueryTimeSeriesRequest) GetFilter() *QueryFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetQueryTimeSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        []*Series              `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	StepSeconds   int64                  `protobuf:"varint,2,opt,name=step_seconds,json=stepSeconds,proto3" json:"step_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueryTimeSeriesResponse) Reset() {
+	*x = GetQueryTimeSeriesResponse{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueryTimeSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueryTimeSeriesResponse) ProtoMessage() {}
+
+func (x *GetQueryTimeSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueryTimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetQueryTimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetQueryTimeSeriesResponse) GetSeries() []*Series {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *GetQueryTimeSeriesResponse) GetStepSeconds() int64 {
+	if x != nil {
+		return x.StepSeconds
+	}
+	return 0
+}
+
+type NameCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NameCount) Reset() {
+	*x = NameCount{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NameCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NameCount) ProtoMessage() {}
+
+func (x *NameCount) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NameCount.ProtoReflect.Descriptor instead.
+func (*NameCount) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NameCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NameCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetTopNamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneId        string                 `protobuf:"bytes,1,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	Start         string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Filter        *QueryFilter           `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopNamesRequest) Reset() {
+	*x = GetTopNamesRequest{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopNamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopNamesRequest) ProtoMessage() {}
+
+func (x *GetTopNamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopNamesRequest.ProtoReflect.Descriptor instead.
+func (*GetTopNamesRequest) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTopNamesRequest) GetZoneId() string {
+	if x != nil {
+		return x.ZoneId
+	}
+	return ""
+}
+
+func (x *GetTopNamesRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *GetTopNamesRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *GetTopNamesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTopNamesRequest) GetFilter() *QueryFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetTopNamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         []*NameCount           `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopNamesResponse) Reset() {
+	*x = GetTopNamesResponse{}
+	mi := &file_analytics_v1_analytics_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopNamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopNamesResponse) ProtoMessage() {}
+
+func (x *GetTopNamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_v1_analytics_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopNamesResponse.ProtoReflect.Descriptor instead.
+func (*GetTopNamesResponse) Descriptor() ([]byte, []int) {
+	return file_analytics_v1_analytics_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTopNamesResponse) GetNames() []*NameCount {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+var File_analytics_v1_analytics_proto protoreflect.FileDescriptor
+
+const file_analytics_v1_analytics_proto_rawDesc = "" +
+	"\n" +
+	"\x1canalytics/v1/analytics.proto\x12\fanalytics.v1\"g\n" +
+	"\vQueryFilter\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05qtype\x18\x02 \x01(\tR\x05qtype\x12\x14\n" +
+	"\x05rcode\x18\x03 \x01(\tR\x05rcode\x12\x18\n" +
+	"\acountry\x18\x04 \x01(\tR\acountry\";\n" +
+	"\x05Point\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\tR\ttimestamp\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"]\n" +
+	"\x06Series\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12+\n" +
+	"\x06points\x18\x02 \x03(\v2\x13.analytics.v1.PointR\x06points\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\"\xe6\x01\n" +
+	"\x19GetQueryTimeSeriesRequest\x12\x17\n" +
+	"\azone_id\x18\x01 \x01(\tR\x06zoneId\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\x12!\n" +
+	"\fstep_seconds\x18\x04 \x01(\x03R\vstepSeconds\x122\n" +
+	"\bgroup_by\x18\x05 \x01(\x0e2\x17.analytics.v1.DimensionR\agroupBy\x121\n" +
+	"\x06filter\x18\x06 \x01(\v2\x19.analytics.v1.QueryFilterR\x06filter\"m\n" +
+	"\x1aGetQueryTimeSeriesResponse\x12,\n" +
+	"\x06series\x18\x01 \x03(\v2\x14.analytics.v1.SeriesR\x06series\x12!\n" +
+	"\fstep_seconds\x18\x02 \x01(\x03R\vstepSeconds\"5\n" +
+	"\tNameCount\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\x9e\x01\n" +
+	"\x12GetTopNamesRequest\x12\x17\n" +
+	"\azone_id\x18\x01 \x01(\tR\x06zoneId\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x121\n" +
+	"\x06filter\x18\x05 \x01(\v2\x19.analytics.v1.QueryFilterR\x06filter\"D\n" +
+	"\x13GetTopNamesResponse\x12-\n" +
+	"\x05names\x18\x01 \x03(\v2\x17.analytics.v1.NameCountR\x05names*{\n" +
+	"\tDimension\x12\x19\n" +
+	"\x15DIMENSION_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fDIMENSION_QTYPE\x10\x01\x12\x13\n" +
+	"\x0fDIMENSION_RCODE\x10\x02\x12\x15\n" +
+	"\x11DIMENSION_COUNTRY\x10\x03\x12\x12\n" +
+	"\x0eDIMENSION_NAME\x10\x042\xd3\x01\n" +
+	"\x10AnalyticsService\x12i\n" +
+	"\x12GetQueryTimeSeries\x12'.analytics.v1.GetQueryTimeSeriesRequest\x1a(.analytics.v1.GetQueryTimeSeriesResponse\"\x00\x12T\n" +
+	"\vGetTopNames\x12 .analytics.v1.GetTopNamesRequest\x1a!.analytics.v1.GetTopNamesResponse\"\x00B%Z#dnsarc/gen/analytics/v1;analyticsv1b\x06proto3"
+
+var (
+	file_analytics_v1_analytics_proto_rawDescOnce sync.Once
+	file_analytics_v1_analytics_proto_rawDescData []byte
+)
+
+func file_analytics_v1_analytics_proto_rawDescGZIP() []byte {
+	file_analytics_v1_analytics_proto_rawDescOnce.Do(func() {
+		file_analytics_v1_analytics_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_analytics_v1_analytics_proto_rawDesc), len(file_analytics_v1_analytics_proto_rawDesc)))
+	})
+	return file_analytics_v1_analytics_proto_rawDescData
+}
+
+var file_analytics_v1_analytics_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_analytics_v1_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_analytics_v1_analytics_proto_goTypes = []any{
+	(Dimension)(0),                     // 0: analytics.v1.Dimension
+	(*QueryFilter)(nil),                // 1: analytics.v1.QueryFilter
+	(*Point)(nil),                      // 2: analytics.v1.Point
+	(*Series)(nil),                     // 3: analytics.v1.Series
+	(*GetQueryTimeSeriesRequest)(nil),  // 4: analytics.v1.GetQueryTimeSeriesRequest
+	(*GetQueryTimeSeriesResponse)(nil), // 5: analytics.v1.GetQueryTimeSeriesResponse
+	(*NameCount)(nil),                  // 6: analytics.v1.NameCount
+	(*GetTopNamesRequest)(nil),         // 7: analytics.v1.GetTopNamesRequest
+	(*GetTopNamesResponse)(nil),        // 8: analytics.v1.GetTopNamesResponse
+}
+var file_analytics_v1_analytics_proto_depIdxs = []int32{
+	2, // 0: analytics.v1.Series.points:type_name -> analytics.v1.Point
+	0, // 1: analytics.v1.GetQueryTimeSeriesRequest.group_by:type_name -> analytics.v1.Dimension
+	1, // 2: analytics.v1.GetQueryTimeSeriesRequest.filter:type_name -> analytics.v1.QueryFilter
+	3, // 3: analytics.v1.GetQueryTimeSeriesResponse.series:type_name -> analytics.v1.Series
+	1, // 4: analytics.v1.GetTopNamesRequest.filter:type_name -> analytics.v1.QueryFilter
+	6, // 5: analytics.v1.GetTopNamesResponse.names:type_name -> analytics.v1.NameCount
+	4, // 6: analytics.v1.AnalyticsService.GetQueryTimeSeries:input_type -> analytics.v1.GetQueryTimeSeriesRequest
+	7, // 7: analytics.v1.AnalyticsService.GetTopNames:input_type -> analytics.v1.GetTopNamesRequest
+	5, // 8: analytics.v1.AnalyticsService.GetQueryTimeSeries:output_type -> analytics.v1.GetQueryTimeSeriesResponse
+	8, // 9: analytics.v1.AnalyticsService.GetTopNames:output_type -> analytics.v1.GetTopNamesResponse
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_analytics_v1_analytics_proto_init() }
+func file_analytics_v1_analytics_proto_init() {
+	if File_analytics_v1_analytics_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_analytics_v1_analytics_proto_rawDesc), len(file_analytics_v1_analytics_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_analytics_v1_analytics_proto_goTypes,
+		DependencyIndexes: file_analytics_v1_analytics_proto_depIdxs,
+		EnumInfos:         file_analytics_v1_analytics_proto_enumTypes,
+		MessageInfos:      file_analytics_v1_analytics_proto_msgTypes,
+	}.Build()
+	File_analytics_v1_analytics_proto = out.File
+	file_analytics_v1_analytics_proto_goTypes = nil
+	file_analytics_v1_analytics_proto_depIdxs = nil
+}
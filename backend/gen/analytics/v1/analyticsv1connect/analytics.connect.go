@@ -0,0 +1,138 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: analytics/v1/analytics.proto
+
+package analyticsv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	v1 "dnsarc/gen/analytics/v1"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AnalyticsServiceName is the fully-qualified name of the AnalyticsService service.
+	AnalyticsServiceName = "analytics.v1.AnalyticsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AnalyticsServiceGetQueryTimeSeriesProcedure is the fully-qualified name of the AnalyticsService's
+	// GetQueryTimeSeries RPC.
+	AnalyticsServiceGetQueryTimeSeriesProcedure = "/analytics.v1.AnalyticsService/GetQueryTimeSeries"
+	// AnalyticsServiceGetTopNamesProcedure is the fully-qualified name of the AnalyticsService's
+	// GetTopNames RPC.
+	AnalyticsServiceGetTopNamesProcedure = "/analytics.v1.AnalyticsService/GetTopNames"
+)
+
+// AnalyticsServiceClient is a client for the analytics.v1.AnalyticsService service.
+type AnalyticsServiceClient interface {
+	GetQueryTimeSeries(context.Context, *connect.Request[v1.GetQueryTimeSeriesRequest]) (*connect.Response[v1.GetQueryTimeSeriesResponse], error)
+	GetTopNames(context.Context, *connect.Request[v1.GetTopNamesRequest]) (*connect.Response[v1.GetTopNamesResponse], error)
+}
+
+// NewAnalyticsServiceClient constructs a client for the analytics.v1.AnalyticsService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAnalyticsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AnalyticsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	analyticsServiceMethods := v1.File_analytics_v1_analytics_proto.Services().ByName("AnalyticsService").Methods()
+	return &analyticsServiceClient{
+		getQueryTimeSeries: connect.NewClient[v1.GetQueryTimeSeriesRequest, v1.GetQueryTimeSeriesResponse](
+			httpClient,
+			baseURL+AnalyticsServiceGetQueryTimeSeriesProcedure,
+			connect.WithSchema(analyticsServiceMethods.ByName("GetQueryTimeSeries")),
+			connect.WithClientOptions(opts...),
+		),
+		getTopNames: connect.NewClient[v1.GetTopNamesRequest, v1.GetTopNamesResponse](
+			httpClient,
+			baseURL+AnalyticsServiceGetTopNamesProcedure,
+			connect.WithSchema(analyticsServiceMethods.ByName("GetTopNames")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// analyticsServiceClient implements AnalyticsServiceClient.
+type analyticsServiceClient struct {
+	getQueryTimeSeries *connect.Client[v1.GetQueryTimeSeriesRequest, v1.GetQueryTimeSeriesResponse]
+	getTopNames        *connect.Client[v1.GetTopNamesRequest, v1.GetTopNamesResponse]
+}
+
+// GetQueryTimeSeries calls analytics.v1.AnalyticsService.GetQueryTimeSeries.
+func (c *analyticsServiceClient) GetQueryTimeSeries(ctx context.Context, req *connect.Request[v1.GetQueryTimeSeriesRequest]) (*connect.Response[v1.GetQueryTimeSeriesResponse], error) {
+	return c.getQueryTimeSeries.CallUnary(ctx, req)
+}
+
+// GetTopNames calls analytics.v1.AnalyticsService.GetTopNames.
+func (c *analyticsServiceClient) GetTopNames(ctx context.Context, req *connect.Request[v1.GetTopNamesRequest]) (*connect.Response[v1.GetTopNamesResponse], error) {
+	return c.getTopNames.CallUnary(ctx, req)
+}
+
+// AnalyticsServiceHandler is an implementation of the analytics.v1.AnalyticsService service.
+type AnalyticsServiceHandler interface {
+	GetQueryTimeSeries(context.Context, *connect.Request[v1.GetQueryTimeSeriesRequest]) (*connect.Response[v1.GetQueryTimeSeriesResponse], error)
+	GetTopNames(context.Context, *connect.Request[v1.GetTopNamesRequest]) (*connect.Response[v1.GetTopNamesResponse], error)
+}
+
+// NewAnalyticsServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAnalyticsServiceHandler(svc AnalyticsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	analyticsServiceMethods := v1.File_analytics_v1_analytics_proto.Services().ByName("AnalyticsService").Methods()
+	analyticsServiceGetQueryTimeSeriesHandler := connect.NewUnaryHandler(
+		AnalyticsServiceGetQueryTimeSeriesProcedure,
+		svc.GetQueryTimeSeries,
+		connect.WithSchema(analyticsServiceMethods.ByName("GetQueryTimeSeries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	analyticsServiceGetTopNamesHandler := connect.NewUnaryHandler(
+		AnalyticsServiceGetTopNamesProcedure,
+		svc.GetTopNames,
+		connect.WithSchema(analyticsServiceMethods.ByName("GetTopNames")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/analytics.v1.AnalyticsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AnalyticsServiceGetQueryTimeSeriesProcedure:
+			analyticsServiceGetQueryTimeSeriesHandler.ServeHTTP(w, r)
+		case AnalyticsServiceGetTopNamesProcedure:
+			analyticsServiceGetTopNamesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAnalyticsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAnalyticsServiceHandler struct{}
+
+func (UnimplementedAnalyticsServiceHandler) GetQueryTimeSeries(context.Context, *connect.Request[v1.GetQueryTimeSeriesRequest]) (*connect.Response[v1.GetQueryTimeSeriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("analytics.v1.AnalyticsService.GetQueryTimeSeries is not implemented"))
+}
+
+func (UnimplementedAnalyticsServiceHandler) GetTopNames(context.Context, *connect.Request[v1.GetTopNamesRequest]) (*connect.Response[v1.GetTopNamesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("analytics.v1.AnalyticsService.GetTopNames is not implemented"))
+}
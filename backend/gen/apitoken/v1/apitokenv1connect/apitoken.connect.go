@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: apitoken/v1/apitoken.proto
+
+package apitokenv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	v1 "dnsarc/gen/apitoken/v1"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ApiTokenServiceName is the fully-qualified name of the ApiTokenService service.
+	ApiTokenServiceName = "apitoken.v1.ApiTokenService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ApiTokenServiceCreateApiTokenProcedure is the fully-qualified name of the ApiTokenService's
+	// CreateApiToken RPC.
+	ApiTokenServiceCreateApiTokenProcedure = "/apitoken.v1.ApiTokenService/CreateApiToken"
+	// ApiTokenServiceListApiTokensProcedure is the fully-qualified name of the ApiTokenService's
+	// ListApiTokens RPC.
+	ApiTokenServiceListApiTokensProcedure = "/apitoken.v1.ApiTokenService/ListApiTokens"
+	// ApiTokenServiceRevokeApiTokenProcedure is the fully-qualified name of the ApiTokenService's
+	// RevokeApiToken RPC.
+	ApiTokenServiceRevokeApiTokenProcedure = "/apitoken.v1.ApiTokenService/RevokeApiToken"
+)
+
+// ApiTokenServiceClient is a client for the apitoken.v1.ApiTokenService service.
+type ApiTokenServiceClient interface {
+	CreateApiToken(context.Context, *connect.Request[v1.CreateApiTokenRequest]) (*connect.Response[v1.CreateApiTokenResponse], error)
+	ListApiTokens(context.Context, *connect.Request[v1.ListApiTokensRequest]) (*connect.Response[v1.ListApiTokensResponse], error)
+	RevokeApiToken(context.Context, *connect.Request[v1.RevokeApiTokenRequest]) (*connect.Response[v1.RevokeApiTokenResponse], error)
+}
+
+// NewApiTokenServiceClient constructs a client for the apitoken.v1.ApiTokenService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewApiTokenServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ApiTokenServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	apiTokenServiceMethods := v1.File_apitoken_v1_apitoken_proto.Services().ByName("ApiTokenService").Methods()
+	return &apiTokenServiceClient{
+		createApiToken: connect.NewClient[v1.CreateApiTokenRequest, v1.CreateApiTokenResponse](
+			httpClient,
+			baseURL+ApiTokenServiceCreateApiTokenProcedure,
+			connect.WithSchema(apiTokenServiceMethods.ByName("CreateApiToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listApiTokens: connect.NewClient[v1.ListApiTokensRequest, v1.ListApiTokensResponse](
+			httpClient,
+			baseURL+ApiTokenServiceListApiTokensProcedure,
+			connect.WithSchema(apiTokenServiceMethods.ByName("ListApiTokens")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeApiToken: connect.NewClient[v1.RevokeApiTokenRequest, v1.RevokeApiTokenResponse](
+			httpClient,
+			baseURL+ApiTokenServiceRevokeApiTokenProcedure,
+			connect.WithSchema(apiTokenServiceMethods.ByName("RevokeApiToken")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// apiTokenServiceClient implements ApiTokenServiceClient.
+type apiTokenServiceClient struct {
+	createApiToken *connect.Client[v1.CreateApiTokenRequest, v1.CreateApiTokenResponse]
+	listApiTokens  *connect.Client[v1.ListApiTokensRequest, v1.ListApiTokensResponse]
+	revokeApiToken *connect.Client[v1.RevokeApiTokenRequest, v1.RevokeApiTokenResponse]
+}
+
+// CreateApiToken calls apitoken.v1.ApiTokenService.CreateApiToken.
+func (c *apiTokenServiceClient) CreateApiToken(ctx context.Context, req *connect.Request[v1.CreateApiTokenRequest]) (*connect.Response[v1.CreateApiTokenResponse], error) {
+	return c.createApiToken.CallUnary(ctx, req)
+}
+
+// ListApiTokens calls apitoken.v1.ApiTokenService.ListApiTokens.
+func (c *apiTokenServiceClient) ListApiTokens(ctx context.Context, req *connect.Request[v1.ListApiTokensRequest]) (*connect.Response[v1.ListApiTokensResponse], error) {
+	return c.listApiTokens.CallUnary(ctx, req)
+}
+
+// RevokeApiToken calls apitoken.v1.ApiTokenService.RevokeApiToken.
+func (c *apiTokenServiceClient) RevokeApiToken(ctx context.Context, req *connect.Request[v1.RevokeApiTokenRequest]) (*connect.Response[v1.RevokeApiTokenResponse], error) {
+	return c.revokeApiToken.CallUnary(ctx, req)
+}
+
+// ApiTokenServiceHandler is an implementation of the apitoken.v1.ApiTokenService service.
+type ApiTokenServiceHandler interface {
+	CreateApiToken(context.Context, *connect.Request[v1.CreateApiTokenRequest]) (*connect.Response[v1.CreateApiTokenResponse], error)
+	ListApiTokens(context.Context, *connect.Request[v1.ListApiTokensRequest]) (*connect.Response[v1.ListApiTokensResponse], error)
+	RevokeApiToken(context.Context, *connect.Request[v1.RevokeApiTokenRequest]) (*connect.Response[v1.RevokeApiTokenResponse], error)
+}
+
+// NewApiTokenServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewApiTokenServiceHandler(svc ApiTokenServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	apiTokenServiceMethods := v1.File_apitoken_v1_apitoken_proto.Services().ByName("ApiTokenService").Methods()
+	apiTokenServiceCreateApiTokenHandler := connect.NewUnaryHandler(
+		ApiTokenServiceCreateApiTokenProcedure,
+		svc.CreateApiToken,
+		connect.WithSchema(apiTokenServiceMethods.ByName("CreateApiToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiTokenServiceListApiTokensHandler := connect.NewUnaryHandler(
+		ApiTokenServiceListApiTokensProcedure,
+		svc.ListApiTokens,
+		connect.WithSchema(apiTokenServiceMethods.ByName("ListApiTokens")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiTokenServiceRevokeApiTokenHandler := connect.NewUnaryHandler(
+		ApiTokenServiceRevokeApiTokenProcedure,
+		svc.RevokeApiToken,
+		connect.WithSchema(apiTokenServiceMethods.ByName("RevokeApiToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/apitoken.v1.ApiTokenService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ApiTokenServiceCreateApiTokenProcedure:
+			apiTokenServiceCreateApiTokenHandler.ServeHTTP(w, r)
+		case ApiTokenServiceListApiTokensProcedure:
+			apiTokenServiceListApiTokensHandler.ServeHTTP(w, r)
+		case ApiTokenServiceRevokeApiTokenProcedure:
+			apiTokenServiceRevokeApiTokenHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedApiTokenServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedApiTokenServiceHandler struct{}
+
+func (UnimplementedApiTokenServiceHandler) CreateApiToken(context.Context, *connect.Request[v1.CreateApiTokenRequest]) (*connect.Response[v1.CreateApiTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("apitoken.v1.ApiTokenService.CreateApiToken is not implemented"))
+}
+
+func (UnimplementedApiTokenServiceHandler) ListApiTokens(context.Context, *connect.Request[v1.ListApiTokensRequest]) (*connect.Response[v1.ListApiTokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("apitoken.v1.ApiTokenService.ListApiTokens is not implemented"))
+}
+
+func (UnimplementedApiTokenServiceHandler) RevokeApiToken(context.Context, *connect.Request[v1.RevokeApiTokenRequest]) (*connect.Response[v1.RevokeApiTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("apitoken.v1.ApiTokenService.RevokeApiToken is not implemented"))
+}
@@ -36,14 +36,84 @@ const (
 	// AuthServiceGoogleLoginURLProcedure is the fully-qualified name of the AuthService's
 	// GoogleLoginURL RPC.
 	AuthServiceGoogleLoginURLProcedure = "/auth.v1.AuthService/GoogleLoginURL"
+	// AuthServiceListLoginProvidersProcedure is the fully-qualified name of the AuthService's
+	// ListLoginProviders RPC.
+	AuthServiceListLoginProvidersProcedure = "/auth.v1.AuthService/ListLoginProviders"
+	// AuthServiceGetLoginURLProcedure is the fully-qualified name of the AuthService's GetLoginURL RPC.
+	AuthServiceGetLoginURLProcedure = "/auth.v1.AuthService/GetLoginURL"
+	// AuthServiceGetLinkURLProcedure is the fully-qualified name of the AuthService's GetLinkURL RPC.
+	AuthServiceGetLinkURLProcedure = "/auth.v1.AuthService/GetLinkURL"
+	// AuthServiceListIdentitiesProcedure is the fully-qualified name of the AuthService's
+	// ListIdentities RPC.
+	AuthServiceListIdentitiesProcedure = "/auth.v1.AuthService/ListIdentities"
+	// AuthServiceUnlinkIdentityProcedure is the fully-qualified name of the AuthService's
+	// UnlinkIdentity RPC.
+	AuthServiceUnlinkIdentityProcedure = "/auth.v1.AuthService/UnlinkIdentity"
+	// AuthServiceExchangeAuthCodeProcedure is the fully-qualified name of the AuthService's
+	// ExchangeAuthCode RPC.
+	AuthServiceExchangeAuthCodeProcedure = "/auth.v1.AuthService/ExchangeAuthCode"
+	// AuthServiceCompleteLinkProcedure is the fully-qualified name of the AuthService's CompleteLink
+	// RPC.
+	AuthServiceCompleteLinkProcedure = "/auth.v1.AuthService/CompleteLink"
 	// AuthServiceWhoAmIProcedure is the fully-qualified name of the AuthService's WhoAmI RPC.
 	AuthServiceWhoAmIProcedure = "/auth.v1.AuthService/WhoAmI"
+	// AuthServiceRegisterProcedure is the fully-qualified name of the AuthService's Register RPC.
+	AuthServiceRegisterProcedure = "/auth.v1.AuthService/Register"
+	// AuthServiceLoginProcedure is the fully-qualified name of the AuthService's Login RPC.
+	AuthServiceLoginProcedure = "/auth.v1.AuthService/Login"
+	// AuthServiceVerifyEmailProcedure is the fully-qualified name of the AuthService's VerifyEmail RPC.
+	AuthServiceVerifyEmailProcedure = "/auth.v1.AuthService/VerifyEmail"
+	// AuthServiceForgotPasswordProcedure is the fully-qualified name of the AuthService's
+	// ForgotPassword RPC.
+	AuthServiceForgotPasswordProcedure = "/auth.v1.AuthService/ForgotPassword"
+	// AuthServiceResetPasswordProcedure is the fully-qualified name of the AuthService's ResetPassword
+	// RPC.
+	AuthServiceResetPasswordProcedure = "/auth.v1.AuthService/ResetPassword"
+	// AuthServiceBeginMFAWebAuthnProcedure is the fully-qualified name of the AuthService's
+	// BeginMFAWebAuthn RPC.
+	AuthServiceBeginMFAWebAuthnProcedure = "/auth.v1.AuthService/BeginMFAWebAuthn"
+	// AuthServiceVerifyMFAProcedure is the fully-qualified name of the AuthService's VerifyMFA RPC.
+	AuthServiceVerifyMFAProcedure = "/auth.v1.AuthService/VerifyMFA"
+	// AuthServiceRefreshTokenProcedure is the fully-qualified name of the AuthService's RefreshToken
+	// RPC.
+	AuthServiceRefreshTokenProcedure = "/auth.v1.AuthService/RefreshToken"
+	// AuthServiceLogoutProcedure is the fully-qualified name of the AuthService's Logout RPC.
+	AuthServiceLogoutProcedure = "/auth.v1.AuthService/Logout"
+	// AuthServiceListSessionsProcedure is the fully-qualified name of the AuthService's ListSessions
+	// RPC.
+	AuthServiceListSessionsProcedure = "/auth.v1.AuthService/ListSessions"
+	// AuthServiceRevokeSessionProcedure is the fully-qualified name of the AuthService's RevokeSession
+	// RPC.
+	AuthServiceRevokeSessionProcedure = "/auth.v1.AuthService/RevokeSession"
+	// AuthServiceRevokeOtherSessionsProcedure is the fully-qualified name of the AuthService's
+	// RevokeOtherSessions RPC.
+	AuthServiceRevokeOtherSessionsProcedure = "/auth.v1.AuthService/RevokeOtherSessions"
 )
 
 // AuthServiceClient is a client for the auth.v1.AuthService service.
 type AuthServiceClient interface {
+	// Deprecated: do not use.
 	GoogleLoginURL(context.Context, *connect.Request[v1.GoogleLoginURLRequest]) (*connect.Response[v1.GoogleLoginURLResponse], error)
+	ListLoginProviders(context.Context, *connect.Request[v1.ListLoginProvidersRequest]) (*connect.Response[v1.ListLoginProvidersResponse], error)
+	GetLoginURL(context.Context, *connect.Request[v1.GetLoginURLRequest]) (*connect.Response[v1.GetLoginURLResponse], error)
+	GetLinkURL(context.Context, *connect.Request[v1.GetLinkURLRequest]) (*connect.Response[v1.GetLinkURLResponse], error)
+	ListIdentities(context.Context, *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error)
+	UnlinkIdentity(context.Context, *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error)
+	ExchangeAuthCode(context.Context, *connect.Request[v1.ExchangeAuthCodeRequest]) (*connect.Response[v1.ExchangeAuthCodeResponse], error)
+	CompleteLink(context.Context, *connect.Request[v1.CompleteLinkRequest]) (*connect.Response[v1.CompleteLinkResponse], error)
 	WhoAmI(context.Context, *connect.Request[v1.WhoAmIRequest]) (*connect.Response[v1.WhoAmIResponse], error)
+	Register(context.Context, *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error)
+	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
+	VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error)
+	ForgotPassword(context.Context, *connect.Request[v1.ForgotPasswordRequest]) (*connect.Response[v1.ForgotPasswordResponse], error)
+	ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error)
+	BeginMFAWebAuthn(context.Context, *connect.Request[v1.BeginMFAWebAuthnRequest]) (*connect.Response[v1.BeginMFAWebAuthnResponse], error)
+	VerifyMFA(context.Context, *connect.Request[v1.VerifyMFARequest]) (*connect.Response[v1.VerifyMFAResponse], error)
+	RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.RefreshTokenResponse], error)
+	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	RevokeOtherSessions(context.Context, *connect.Request[v1.RevokeOtherSessionsRequest]) (*connect.Response[v1.RevokeOtherSessionsResponse], error)
 }
 
 // NewAuthServiceClient constructs a client for the auth.v1.AuthService service. By default, it uses
@@ -63,35 +133,285 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(authServiceMethods.ByName("GoogleLoginURL")),
 			connect.WithClientOptions(opts...),
 		),
+		listLoginProviders: connect.NewClient[v1.ListLoginProvidersRequest, v1.ListLoginProvidersResponse](
+			httpClient,
+			baseURL+AuthServiceListLoginProvidersProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ListLoginProviders")),
+			connect.WithClientOptions(opts...),
+		),
+		getLoginURL: connect.NewClient[v1.GetLoginURLRequest, v1.GetLoginURLResponse](
+			httpClient,
+			baseURL+AuthServiceGetLoginURLProcedure,
+			connect.WithSchema(authServiceMethods.ByName("GetLoginURL")),
+			connect.WithClientOptions(opts...),
+		),
+		getLinkURL: connect.NewClient[v1.GetLinkURLRequest, v1.GetLinkURLResponse](
+			httpClient,
+			baseURL+AuthServiceGetLinkURLProcedure,
+			connect.WithSchema(authServiceMethods.ByName("GetLinkURL")),
+			connect.WithClientOptions(opts...),
+		),
+		listIdentities: connect.NewClient[v1.ListIdentitiesRequest, v1.ListIdentitiesResponse](
+			httpClient,
+			baseURL+AuthServiceListIdentitiesProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ListIdentities")),
+			connect.WithClientOptions(opts...),
+		),
+		unlinkIdentity: connect.NewClient[v1.UnlinkIdentityRequest, v1.UnlinkIdentityResponse](
+			httpClient,
+			baseURL+AuthServiceUnlinkIdentityProcedure,
+			connect.WithSchema(authServiceMethods.ByName("UnlinkIdentity")),
+			connect.WithClientOptions(opts...),
+		),
+		exchangeAuthCode: connect.NewClient[v1.ExchangeAuthCodeRequest, v1.ExchangeAuthCodeResponse](
+			httpClient,
+			baseURL+AuthServiceExchangeAuthCodeProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ExchangeAuthCode")),
+			connect.WithClientOptions(opts...),
+		),
+		completeLink: connect.NewClient[v1.CompleteLinkRequest, v1.CompleteLinkResponse](
+			httpClient,
+			baseURL+AuthServiceCompleteLinkProcedure,
+			connect.WithSchema(authServiceMethods.ByName("CompleteLink")),
+			connect.WithClientOptions(opts...),
+		),
 		whoAmI: connect.NewClient[v1.WhoAmIRequest, v1.WhoAmIResponse](
 			httpClient,
 			baseURL+AuthServiceWhoAmIProcedure,
 			connect.WithSchema(authServiceMethods.ByName("WhoAmI")),
 			connect.WithClientOptions(opts...),
 		),
+		register: connect.NewClient[v1.RegisterRequest, v1.RegisterResponse](
+			httpClient,
+			baseURL+AuthServiceRegisterProcedure,
+			connect.WithSchema(authServiceMethods.ByName("Register")),
+			connect.WithClientOptions(opts...),
+		),
+		login: connect.NewClient[v1.LoginRequest, v1.LoginResponse](
+			httpClient,
+			baseURL+AuthServiceLoginProcedure,
+			connect.WithSchema(authServiceMethods.ByName("Login")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyEmail: connect.NewClient[v1.VerifyEmailRequest, v1.VerifyEmailResponse](
+			httpClient,
+			baseURL+AuthServiceVerifyEmailProcedure,
+			connect.WithSchema(authServiceMethods.ByName("VerifyEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		forgotPassword: connect.NewClient[v1.ForgotPasswordRequest, v1.ForgotPasswordResponse](
+			httpClient,
+			baseURL+AuthServiceForgotPasswordProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ForgotPassword")),
+			connect.WithClientOptions(opts...),
+		),
+		resetPassword: connect.NewClient[v1.ResetPasswordRequest, v1.ResetPasswordResponse](
+			httpClient,
+			baseURL+AuthServiceResetPasswordProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ResetPassword")),
+			connect.WithClientOptions(opts...),
+		),
+		beginMFAWebAuthn: connect.NewClient[v1.BeginMFAWebAuthnRequest, v1.BeginMFAWebAuthnResponse](
+			httpClient,
+			baseURL+AuthServiceBeginMFAWebAuthnProcedure,
+			connect.WithSchema(authServiceMethods.ByName("BeginMFAWebAuthn")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyMFA: connect.NewClient[v1.VerifyMFARequest, v1.VerifyMFAResponse](
+			httpClient,
+			baseURL+AuthServiceVerifyMFAProcedure,
+			connect.WithSchema(authServiceMethods.ByName("VerifyMFA")),
+			connect.WithClientOptions(opts...),
+		),
+		refreshToken: connect.NewClient[v1.RefreshTokenRequest, v1.RefreshTokenResponse](
+			httpClient,
+			baseURL+AuthServiceRefreshTokenProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RefreshToken")),
+			connect.WithClientOptions(opts...),
+		),
+		logout: connect.NewClient[v1.LogoutRequest, v1.LogoutResponse](
+			httpClient,
+			baseURL+AuthServiceLogoutProcedure,
+			connect.WithSchema(authServiceMethods.ByName("Logout")),
+			connect.WithClientOptions(opts...),
+		),
+		listSessions: connect.NewClient[v1.ListSessionsRequest, v1.ListSessionsResponse](
+			httpClient,
+			baseURL+AuthServiceListSessionsProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ListSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeSession: connect.NewClient[v1.RevokeSessionRequest, v1.RevokeSessionResponse](
+			httpClient,
+			baseURL+AuthServiceRevokeSessionProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RevokeSession")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeOtherSessions: connect.NewClient[v1.RevokeOtherSessionsRequest, v1.RevokeOtherSessionsResponse](
+			httpClient,
+			baseURL+AuthServiceRevokeOtherSessionsProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RevokeOtherSessions")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // authServiceClient implements AuthServiceClient.
 type authServiceClient struct {
-	googleLoginURL *connect.Client[v1.GoogleLoginURLRequest, v1.GoogleLoginURLResponse]
-	whoAmI         *connect.Client[v1.WhoAmIRequest, v1.WhoAmIResponse]
+	googleLoginURL      *connect.Client[v1.GoogleLoginURLRequest, v1.GoogleLoginURLResponse]
+	listLoginProviders  *connect.Client[v1.ListLoginProvidersRequest, v1.ListLoginProvidersResponse]
+	getLoginURL         *connect.Client[v1.GetLoginURLRequest, v1.GetLoginURLResponse]
+	getLinkURL          *connect.Client[v1.GetLinkURLRequest, v1.GetLinkURLResponse]
+	listIdentities      *connect.Client[v1.ListIdentitiesRequest, v1.ListIdentitiesResponse]
+	unlinkIdentity      *connect.Client[v1.UnlinkIdentityRequest, v1.UnlinkIdentityResponse]
+	exchangeAuthCode    *connect.Client[v1.ExchangeAuthCodeRequest, v1.ExchangeAuthCodeResponse]
+	completeLink        *connect.Client[v1.CompleteLinkRequest, v1.CompleteLinkResponse]
+	whoAmI              *connect.Client[v1.WhoAmIRequest, v1.WhoAmIResponse]
+	register            *connect.Client[v1.RegisterRequest, v1.RegisterResponse]
+	login               *connect.Client[v1.LoginRequest, v1.LoginResponse]
+	verifyEmail         *connect.Client[v1.VerifyEmailRequest, v1.VerifyEmailResponse]
+	forgotPassword      *connect.Client[v1.ForgotPasswordRequest, v1.ForgotPasswordResponse]
+	resetPassword       *connect.Client[v1.ResetPasswordRequest, v1.ResetPasswordResponse]
+	beginMFAWebAuthn    *connect.Client[v1.BeginMFAWebAuthnRequest, v1.BeginMFAWebAuthnResponse]
+	verifyMFA           *connect.Client[v1.VerifyMFARequest, v1.VerifyMFAResponse]
+	refreshToken        *connect.Client[v1.RefreshTokenRequest, v1.RefreshTokenResponse]
+	logout              *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
+	listSessions        *connect.Client[v1.ListSessionsRequest, v1.ListSessionsResponse]
+	revokeSession       *connect.Client[v1.RevokeSessionRequest, v1.RevokeSessionResponse]
+	revokeOtherSessions *connect.Client[v1.RevokeOtherSessionsRequest, v1.RevokeOtherSessionsResponse]
 }
 
 // GoogleLoginURL calls auth.v1.AuthService.GoogleLoginURL.
+//
+// Deprecated: do not use.
 func (c *authServiceClient) GoogleLoginURL(ctx context.Context, req *connect.Request[v1.GoogleLoginURLRequest]) (*connect.Response[v1.GoogleLoginURLResponse], error) {
 	return c.googleLoginURL.CallUnary(ctx, req)
 }
 
+// ListLoginProviders calls auth.v1.AuthService.ListLoginProviders.
+func (c *authServiceClient) ListLoginProviders(ctx context.Context, req *connect.Request[v1.ListLoginProvidersRequest]) (*connect.Response[v1.ListLoginProvidersResponse], error) {
+	return c.listLoginProviders.CallUnary(ctx, req)
+}
+
+// GetLoginURL calls auth.v1.AuthService.GetLoginURL.
+func (c *authServiceClient) GetLoginURL(ctx context.Context, req *connect.Request[v1.GetLoginURLRequest]) (*connect.Response[v1.GetLoginURLResponse], error) {
+	return c.getLoginURL.CallUnary(ctx, req)
+}
+
+// GetLinkURL calls auth.v1.AuthService.GetLinkURL.
+func (c *authServiceClient) GetLinkURL(ctx context.Context, req *connect.Request[v1.GetLinkURLRequest]) (*connect.Response[v1.GetLinkURLResponse], error) {
+	return c.getLinkURL.CallUnary(ctx, req)
+}
+
+// ListIdentities calls auth.v1.AuthService.ListIdentities.
+func (c *authServiceClient) ListIdentities(ctx context.Context, req *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error) {
+	return c.listIdentities.CallUnary(ctx, req)
+}
+
+// UnlinkIdentity calls auth.v1.AuthService.UnlinkIdentity.
+func (c *authServiceClient) UnlinkIdentity(ctx context.Context, req *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error) {
+	return c.unlinkIdentity.CallUnary(ctx, req)
+}
+
+// ExchangeAuthCode calls auth.v1.AuthService.ExchangeAuthCode.
+func (c *authServiceClient) ExchangeAuthCode(ctx context.Context, req *connect.Request[v1.ExchangeAuthCodeRequest]) (*connect.Response[v1.ExchangeAuthCodeResponse], error) {
+	return c.exchangeAuthCode.CallUnary(ctx, req)
+}
+
+// CompleteLink calls auth.v1.AuthService.CompleteLink.
+func (c *authServiceClient) CompleteLink(ctx context.Context, req *connect.Request[v1.CompleteLinkRequest]) (*connect.Response[v1.CompleteLinkResponse], error) {
+	return c.completeLink.CallUnary(ctx, req)
+}
+
 // WhoAmI calls auth.v1.AuthService.WhoAmI.
 func (c *authServiceClient) WhoAmI(ctx context.Context, req *connect.Request[v1.WhoAmIRequest]) (*connect.Response[v1.WhoAmIResponse], error) {
 	return c.whoAmI.CallUnary(ctx, req)
 }
 
+// Register calls auth.v1.AuthService.Register.
+func (c *authServiceClient) Register(ctx context.Context, req *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error) {
+	return c.register.CallUnary(ctx, req)
+}
+
+// Login calls auth.v1.AuthService.Login.
+func (c *authServiceClient) Login(ctx context.Context, req *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error) {
+	return c.login.CallUnary(ctx, req)
+}
+
+// VerifyEmail calls auth.v1.AuthService.VerifyEmail.
+func (c *authServiceClient) VerifyEmail(ctx context.Context, req *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error) {
+	return c.verifyEmail.CallUnary(ctx, req)
+}
+
+// ForgotPassword calls auth.v1.AuthService.ForgotPassword.
+func (c *authServiceClient) ForgotPassword(ctx context.Context, req *connect.Request[v1.ForgotPasswordRequest]) (*connect.Response[v1.ForgotPasswordResponse], error) {
+	return c.forgotPassword.CallUnary(ctx, req)
+}
+
+// ResetPassword calls auth.v1.AuthService.ResetPassword.
+func (c *authServiceClient) ResetPassword(ctx context.Context, req *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error) {
+	return c.resetPassword.CallUnary(ctx, req)
+}
+
+// BeginMFAWebAuthn calls auth.v1.AuthService.BeginMFAWebAuthn.
+func (c *authServiceClient) BeginMFAWebAuthn(ctx context.Context, req *connect.Request[v1.BeginMFAWebAuthnRequest]) (*connect.Response[v1.BeginMFAWebAuthnResponse], error) {
+	return c.beginMFAWebAuthn.CallUnary(ctx, req)
+}
+
+// VerifyMFA calls auth.v1.AuthService.VerifyMFA.
+func (c *authServiceClient) VerifyMFA(ctx context.Context, req *connect.Request[v1.VerifyMFARequest]) (*connect.Response[v1.VerifyMFAResponse], error) {
+	return c.verifyMFA.CallUnary(ctx, req)
+}
+
+// RefreshToken calls auth.v1.AuthService.RefreshToken.
+func (c *authServiceClient) RefreshToken(ctx context.Context, req *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.RefreshTokenResponse], error) {
+	return c.refreshToken.CallUnary(ctx, req)
+}
+
+// Logout calls auth.v1.AuthService.Logout.
+func (c *authServiceClient) Logout(ctx context.Context, req *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error) {
+	return c.logout.CallUnary(ctx, req)
+}
+
+// ListSessions calls auth.v1.AuthService.ListSessions.
+func (c *authServiceClient) ListSessions(ctx context.Context, req *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return c.listSessions.CallUnary(ctx, req)
+}
+
+// RevokeSession calls auth.v1.AuthService.RevokeSession.
+func (c *authServiceClient) RevokeSession(ctx context.Context, req *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return c.revokeSession.CallUnary(ctx, req)
+}
+
+// RevokeOtherSessions calls auth.v1.AuthService.RevokeOtherSessions.
+func (c *authServiceClient) RevokeOtherSessions(ctx context.Context, req *connect.Request[v1.RevokeOtherSessionsRequest]) (*connect.Response[v1.RevokeOtherSessionsResponse], error) {
+	return c.revokeOtherSessions.CallUnary(ctx, req)
+}
+
 // AuthServiceHandler is an implementation of the auth.v1.AuthService service.
 type AuthServiceHandler interface {
+	// Deprecated: do not use.
 	GoogleLoginURL(context.Context, *connect.Request[v1.GoogleLoginURLRequest]) (*connect.Response[v1.GoogleLoginURLResponse], error)
+	ListLoginProviders(context.Context, *connect.Request[v1.ListLoginProvidersRequest]) (*connect.Response[v1.ListLoginProvidersResponse], error)
+	GetLoginURL(context.Context, *connect.Request[v1.GetLoginURLRequest]) (*connect.Response[v1.GetLoginURLResponse], error)
+	GetLinkURL(context.Context, *connect.Request[v1.GetLinkURLRequest]) (*connect.Response[v1.GetLinkURLResponse], error)
+	ListIdentities(context.Context, *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error)
+	UnlinkIdentity(context.Context, *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error)
+	ExchangeAuthCode(context.Context, *connect.Request[v1.ExchangeAuthCodeRequest]) (*connect.Response[v1.ExchangeAuthCodeResponse], error)
+	CompleteLink(context.Context, *connect.Request[v1.CompleteLinkRequest]) (*connect.Response[v1.CompleteLinkResponse], error)
 	WhoAmI(context.Context, *connect.Request[v1.WhoAmIRequest]) (*connect.Response[v1.WhoAmIResponse], error)
+	Register(context.Context, *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error)
+	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
+	VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error)
+	ForgotPassword(context.Context, *connect.Request[v1.ForgotPasswordRequest]) (*connect.Response[v1.ForgotPasswordResponse], error)
+	ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error)
+	BeginMFAWebAuthn(context.Context, *connect.Request[v1.BeginMFAWebAuthnRequest]) (*connect.Response[v1.BeginMFAWebAuthnResponse], error)
+	VerifyMFA(context.Context, *connect.Request[v1.VerifyMFARequest]) (*connect.Response[v1.VerifyMFAResponse], error)
+	RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.RefreshTokenResponse], error)
+	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	RevokeOtherSessions(context.Context, *connect.Request[v1.RevokeOtherSessionsRequest]) (*connect.Response[v1.RevokeOtherSessionsResponse], error)
 }
 
 // NewAuthServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -107,18 +427,170 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(authServiceMethods.ByName("GoogleLoginURL")),
 		connect.WithHandlerOptions(opts...),
 	)
+	authServiceListLoginProvidersHandler := connect.NewUnaryHandler(
+		AuthServiceListLoginProvidersProcedure,
+		svc.ListLoginProviders,
+		connect.WithSchema(authServiceMethods.ByName("ListLoginProviders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceGetLoginURLHandler := connect.NewUnaryHandler(
+		AuthServiceGetLoginURLProcedure,
+		svc.GetLoginURL,
+		connect.WithSchema(authServiceMethods.ByName("GetLoginURL")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceGetLinkURLHandler := connect.NewUnaryHandler(
+		AuthServiceGetLinkURLProcedure,
+		svc.GetLinkURL,
+		connect.WithSchema(authServiceMethods.ByName("GetLinkURL")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceListIdentitiesHandler := connect.NewUnaryHandler(
+		AuthServiceListIdentitiesProcedure,
+		svc.ListIdentities,
+		connect.WithSchema(authServiceMethods.ByName("ListIdentities")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceUnlinkIdentityHandler := connect.NewUnaryHandler(
+		AuthServiceUnlinkIdentityProcedure,
+		svc.UnlinkIdentity,
+		connect.WithSchema(authServiceMethods.ByName("UnlinkIdentity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceExchangeAuthCodeHandler := connect.NewUnaryHandler(
+		AuthServiceExchangeAuthCodeProcedure,
+		svc.ExchangeAuthCode,
+		connect.WithSchema(authServiceMethods.ByName("ExchangeAuthCode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceCompleteLinkHandler := connect.NewUnaryHandler(
+		AuthServiceCompleteLinkProcedure,
+		svc.CompleteLink,
+		connect.WithSchema(authServiceMethods.ByName("CompleteLink")),
+		connect.WithHandlerOptions(opts...),
+	)
 	authServiceWhoAmIHandler := connect.NewUnaryHandler(
 		AuthServiceWhoAmIProcedure,
 		svc.WhoAmI,
 		connect.WithSchema(authServiceMethods.ByName("WhoAmI")),
 		connect.WithHandlerOptions(opts...),
 	)
+	authServiceRegisterHandler := connect.NewUnaryHandler(
+		AuthServiceRegisterProcedure,
+		svc.Register,
+		connect.WithSchema(authServiceMethods.ByName("Register")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceLoginHandler := connect.NewUnaryHandler(
+		AuthServiceLoginProcedure,
+		svc.Login,
+		connect.WithSchema(authServiceMethods.ByName("Login")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceVerifyEmailHandler := connect.NewUnaryHandler(
+		AuthServiceVerifyEmailProcedure,
+		svc.VerifyEmail,
+		connect.WithSchema(authServiceMethods.ByName("VerifyEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceForgotPasswordHandler := connect.NewUnaryHandler(
+		AuthServiceForgotPasswordProcedure,
+		svc.ForgotPassword,
+		connect.WithSchema(authServiceMethods.ByName("ForgotPassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceResetPasswordHandler := connect.NewUnaryHandler(
+		AuthServiceResetPasswordProcedure,
+		svc.ResetPassword,
+		connect.WithSchema(authServiceMethods.ByName("ResetPassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceBeginMFAWebAuthnHandler := connect.NewUnaryHandler(
+		AuthServiceBeginMFAWebAuthnProcedure,
+		svc.BeginMFAWebAuthn,
+		connect.WithSchema(authServiceMethods.ByName("BeginMFAWebAuthn")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceVerifyMFAHandler := connect.NewUnaryHandler(
+		AuthServiceVerifyMFAProcedure,
+		svc.VerifyMFA,
+		connect.WithSchema(authServiceMethods.ByName("VerifyMFA")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRefreshTokenHandler := connect.NewUnaryHandler(
+		AuthServiceRefreshTokenProcedure,
+		svc.RefreshToken,
+		connect.WithSchema(authServiceMethods.ByName("RefreshToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceLogoutHandler := connect.NewUnaryHandler(
+		AuthServiceLogoutProcedure,
+		svc.Logout,
+		connect.WithSchema(authServiceMethods.ByName("Logout")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceListSessionsHandler := connect.NewUnaryHandler(
+		AuthServiceListSessionsProcedure,
+		svc.ListSessions,
+		connect.WithSchema(authServiceMethods.ByName("ListSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRevokeSessionHandler := connect.NewUnaryHandler(
+		AuthServiceRevokeSessionProcedure,
+		svc.RevokeSession,
+		connect.WithSchema(authServiceMethods.ByName("RevokeSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRevokeOtherSessionsHandler := connect.NewUnaryHandler(
+		AuthServiceRevokeOtherSessionsProcedure,
+		svc.RevokeOtherSessions,
+		connect.WithSchema(authServiceMethods.ByName("RevokeOtherSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/auth.v1.AuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AuthServiceGoogleLoginURLProcedure:
 			authServiceGoogleLoginURLHandler.ServeHTTP(w, r)
+		case AuthServiceListLoginProvidersProcedure:
+			authServiceListLoginProvidersHandler.ServeHTTP(w, r)
+		case AuthServiceGetLoginURLProcedure:
+			authServiceGetLoginURLHandler.ServeHTTP(w, r)
+		case AuthServiceGetLinkURLProcedure:
+			authServiceGetLinkURLHandler.ServeHTTP(w, r)
+		case AuthServiceListIdentitiesProcedure:
+			authServiceListIdentitiesHandler.ServeHTTP(w, r)
+		case AuthServiceUnlinkIdentityProcedure:
+			authServiceUnlinkIdentityHandler.ServeHTTP(w, r)
+		case AuthServiceExchangeAuthCodeProcedure:
+			authServiceExchangeAuthCodeHandler.ServeHTTP(w, r)
+		case AuthServiceCompleteLinkProcedure:
+			authServiceCompleteLinkHandler.ServeHTTP(w, r)
 		case AuthServiceWhoAmIProcedure:
 			authServiceWhoAmIHandler.ServeHTTP(w, r)
+		case AuthServiceRegisterProcedure:
+			authServiceRegisterHandler.ServeHTTP(w, r)
+		case AuthServiceLoginProcedure:
+			authServiceLoginHandler.ServeHTTP(w, r)
+		case AuthServiceVerifyEmailProcedure:
+			authServiceVerifyEmailHandler.ServeHTTP(w, r)
+		case AuthServiceForgotPasswordProcedure:
+			authServiceForgotPasswordHandler.ServeHTTP(w, r)
+		case AuthServiceResetPasswordProcedure:
+			authServiceResetPasswordHandler.ServeHTTP(w, r)
+		case AuthServiceBeginMFAWebAuthnProcedure:
+			authServiceBeginMFAWebAuthnHandler.ServeHTTP(w, r)
+		case AuthServiceVerifyMFAProcedure:
+			authServiceVerifyMFAHandler.ServeHTTP(w, r)
+		case AuthServiceRefreshTokenProcedure:
+			authServiceRefreshTokenHandler.ServeHTTP(w, r)
+		case AuthServiceLogoutProcedure:
+			authServiceLogoutHandler.ServeHTTP(w, r)
+		case AuthServiceListSessionsProcedure:
+			authServiceListSessionsHandler.ServeHTTP(w, r)
+		case AuthServiceRevokeSessionProcedure:
+			authServiceRevokeSessionHandler.ServeHTTP(w, r)
+		case AuthServiceRevokeOtherSessionsProcedure:
+			authServiceRevokeOtherSessionsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -132,6 +604,82 @@ func (UnimplementedAuthServiceHandler) GoogleLoginURL(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.GoogleLoginURL is not implemented"))
 }
 
+func (UnimplementedAuthServiceHandler) ListLoginProviders(context.Context, *connect.Request[v1.ListLoginProvidersRequest]) (*connect.Response[v1.ListLoginProvidersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ListLoginProviders is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) GetLoginURL(context.Context, *connect.Request[v1.GetLoginURLRequest]) (*connect.Response[v1.GetLoginURLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.GetLoginURL is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) GetLinkURL(context.Context, *connect.Request[v1.GetLinkURLRequest]) (*connect.Response[v1.GetLinkURLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.GetLinkURL is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ListIdentities(context.Context, *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ListIdentities is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) UnlinkIdentity(context.Context, *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.UnlinkIdentity is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ExchangeAuthCode(context.Context, *connect.Request[v1.ExchangeAuthCodeRequest]) (*connect.Response[v1.ExchangeAuthCodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ExchangeAuthCode is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) CompleteLink(context.Context, *connect.Request[v1.CompleteLinkRequest]) (*connect.Response[v1.CompleteLinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.CompleteLink is not implemented"))
+}
+
 func (UnimplementedAuthServiceHandler) WhoAmI(context.Context, *connect.Request[v1.WhoAmIRequest]) (*connect.Response[v1.WhoAmIResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.WhoAmI is not implemented"))
 }
+
+func (UnimplementedAuthServiceHandler) Register(context.Context, *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.Register is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.Login is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.VerifyEmail is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ForgotPassword(context.Context, *connect.Request[v1.ForgotPasswordRequest]) (*connect.Response[v1.ForgotPasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ForgotPassword is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ResetPassword is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) BeginMFAWebAuthn(context.Context, *connect.Request[v1.BeginMFAWebAuthnRequest]) (*connect.Response[v1.BeginMFAWebAuthnResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.BeginMFAWebAuthn is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) VerifyMFA(context.Context, *connect.Request[v1.VerifyMFARequest]) (*connect.Response[v1.VerifyMFAResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.VerifyMFA is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RefreshToken(context.Context, *connect.Request[v1.RefreshTokenRequest]) (*connect.Response[v1.RefreshTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.RefreshToken is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.Logout is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ListSessions is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.RevokeSession is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RevokeOtherSessions(context.Context, *connect.Request[v1.RevokeOtherSessionsRequest]) (*connect.Response[v1.RevokeOtherSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.RevokeOtherSessions is not implemented"))
+}
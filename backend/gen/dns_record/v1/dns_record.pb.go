@@ -22,15 +22,17 @@ const (
 )
 
 type CreateDNSRecordRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ZoneName      string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	Ttl           int32                  `protobuf:"varint,5,opt,name=ttl,proto3" json:"ttl,omitempty"`
-	Weight        int32                  `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ZoneName       string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Content        string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Ttl            int32                  `protobuf:"varint,5,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Weight         int32                  `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	Svcb           *SVCBData              `protobuf:"bytes,7,opt,name=svcb,proto3" json:"svcb,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,8,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateDNSRecordRequest) Reset() {
@@ -105,6 +107,164 @@ func (x *CreateDNSRecordRequest) GetWeight() int32 {
 	return 0
 }
 
+func (x *CreateDNSRecordRequest) GetSvcb() *SVCBData {
+	if x != nil {
+		return x.Svcb
+	}
+	return nil
+}
+
+func (x *CreateDNSRecordRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type SvcParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alpn          []string               `protobuf:"bytes,1,rep,name=alpn,proto3" json:"alpn,omitempty"`
+	NoDefaultAlpn bool                   `protobuf:"varint,2,opt,name=no_default_alpn,json=noDefaultAlpn,proto3" json:"no_default_alpn,omitempty"`
+	Port          uint32                 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Ipv4Hint      []string               `protobuf:"bytes,4,rep,name=ipv4hint,proto3" json:"ipv4hint,omitempty"`
+	Ipv6Hint      []string               `protobuf:"bytes,5,rep,name=ipv6hint,proto3" json:"ipv6hint,omitempty"`
+	Ech           string                 `protobuf:"bytes,6,opt,name=ech,proto3" json:"ech,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SvcParams) Reset() {
+	*x = SvcParams{}
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SvcParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SvcParams) ProtoMessage() {}
+
+func (x *SvcParams) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SvcParams.ProtoReflect.Descriptor instead.
+func (*SvcParams) Descriptor() ([]byte, []int) {
+	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SvcParams) GetAlpn() []string {
+	if x != nil {
+		return x.Alpn
+	}
+	return nil
+}
+
+func (x *SvcParams) GetNoDefaultAlpn() bool {
+	if x != nil {
+		return x.NoDefaultAlpn
+	}
+	return false
+}
+
+func (x *SvcParams) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *SvcParams) GetIpv4Hint() []string {
+	if x != nil {
+		return x.Ipv4Hint
+	}
+	return nil
+}
+
+func (x *SvcParams) GetIpv6Hint() []string {
+	if x != nil {
+		return x.Ipv6Hint
+	}
+	return nil
+}
+
+func (x *SvcParams) GetEch() string {
+	if x != nil {
+		return x.Ech
+	}
+	return ""
+}
+
+type SVCBData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Priority      uint32                 `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Params        *SvcParams             `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SVCBData) Reset() {
+	*x = SVCBData{}
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SVCBData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SVCBData) ProtoMessage() {}
+
+func (x *SVCBData) ProtoReflect() protoreflect.Message {
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SVCBData.ProtoReflect.Descriptor instead.
+func (*SVCBData) Descriptor() ([]byte, []int) {
+	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SVCBData) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *SVCBData) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *SVCBData) GetParams() *SvcParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
 type DNSRecord struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -117,13 +277,14 @@ type DNSRecord struct {
 	Ttl           int32                  `protobuf:"varint,8,opt,name=ttl,proto3" json:"ttl,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Svcb          *SVCBData              `protobuf:"bytes,11,opt,name=svcb,proto3" json:"svcb,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DNSRecord) Reset() {
 	*x = DNSRecord{}
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[1]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -135,7 +296,7 @@ func (x *DNSRecord) String() string {
 func (*DNSRecord) ProtoMessage() {}
 
 func (x *DNSRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[1]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -148,7 +309,7 @@ func (x *DNSRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DNSRecord.ProtoReflect.Descriptor instead.
 func (*DNSRecord) Descriptor() ([]byte, []int) {
-	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{1}
+	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *DNSRecord) GetId() string {
@@ -221,6 +382,13 @@ func (x *DNSRecord) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *DNSRecord) GetSvcb() *SVCBData {
+	if x != nil {
+		return x.Svcb
+	}
+	return nil
+}
+
 type CreateDNSRecordResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Record        *DNSRecord             `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
@@ -230,7 +398,7 @@ type CreateDNSRecordResponse struct {
 
 func (x *CreateDNSRecordResponse) Reset() {
 	*x = CreateDNSRecordResponse{}
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[2]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -242,7 +410,7 @@ func (x *CreateDNSRecordResponse) String() string {
 func (*CreateDNSRecordResponse) ProtoMessage() {}
 
 func (x *CreateDNSRecordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[2]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -255,7 +423,7 @@ func (x *CreateDNSRecordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDNSRecordResponse.ProtoReflect.Descriptor instead.
 func (*CreateDNSRecordResponse) Descriptor() ([]byte, []int) {
-	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{2}
+	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateDNSRecordResponse) GetRecord() *DNSRecord {
@@ -274,7 +442,7 @@ type ListDNSRecordsRequest struct {
 
 func (x *ListDNSRecordsRequest) Reset() {
 	*x = ListDNSRecordsRequest{}
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[3]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -286,7 +454,7 @@ func (x *ListDNSRecordsRequest) String() string {
 func (*ListDNSRecordsRequest) ProtoMessage() {}
 
 func (x *ListDNSRecordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[3]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,7 +467,7 @@ func (x *ListDNSRecordsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDNSRecordsRequest.ProtoReflect.Descriptor instead.
 func (*ListDNSRecordsRequest) Descriptor() ([]byte, []int) {
-	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{3}
+	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListDNSRecordsRequest) GetZoneId() string {
@@ -318,7 +486,7 @@ type ListDNSRecordsResponse struct {
 
 func (x *ListDNSRecordsResponse) Reset() {
 	*x = ListDNSRecordsResponse{}
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[4]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -330,7 +498,7 @@ func (x *ListDNSRecordsResponse) String() string {
 func (*ListDNSRecordsResponse) ProtoMessage() {}
 
 func (x *ListDNSRecordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[4]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +511,7 @@ func (x *ListDNSRecordsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDNSRecordsResponse.ProtoReflect.Descriptor instead.
 func (*ListDNSRecordsResponse) Descriptor() ([]byte, []int) {
-	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{4}
+	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListDNSRecordsResponse) GetRecords() []*DNSRecord {
@@ -354,15 +522,16 @@ func (x *ListDNSRecordsResponse) GetRecords() []*DNSRecord {
 }
 
 type ListDNSRecordsByZoneNameRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ZoneName      string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ZoneName       string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ListDNSRecordsByZoneNameRequest) Reset() {
 	*x = ListDNSRecordsByZoneNameRequest{}
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[5]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -374,7 +543,7 @@ func (x *ListDNSRecordsByZoneNameRequest) String() string {
 func (*ListDNSRecordsByZoneNameRequest) ProtoMessage() {}
 
 func (x *ListDNSRecordsByZoneNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[5]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -387,7 +556,7 @@ func (x *ListDNSRecordsByZoneNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDNSRecordsByZoneNameRequest.ProtoReflect.Descriptor instead.
 func (*ListDNSRecordsByZoneNameRequest) Descriptor() ([]byte, []int) {
-	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{5}
+	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListDNSRecordsByZoneNameRequest) GetZoneName() string {
@@ -397,6 +566,13 @@ func (x *ListDNSRecordsByZoneNameRequest) GetZoneName() string {
 	return ""
 }
 
+func (x *ListDNSRecordsByZoneNameRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
 type ListDNSRecordsByZoneNameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Records       []*DNSRecord           `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
@@ -406,7 +582,7 @@ type ListDNSRecordsByZoneNameResponse struct {
 
 func (x *ListDNSRecordsByZoneNameResponse) Reset() {
 	*x = ListDNSRecordsByZoneNameResponse{}
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[6]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -418,7 +594,7 @@ func (x *ListDNSRecordsByZoneNameResponse) String() string {
 func (*ListDNSRecordsByZoneNameResponse) ProtoMessage() {}
 
 func (x *ListDNSRecordsByZoneNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[6]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -431,7 +607,7 @@ func (x *ListDNSRecordsByZoneNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDNSRecordsByZoneNameResponse.ProtoReflect.Descriptor instead.
 func (*ListDNSRecordsByZoneNameResponse) Descriptor() ([]byte, []int) {
-	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{6}
+	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListDNSRecordsByZoneNameResponse) GetRecords() []*DNSRecord {
@@ -450,7 +626,7 @@ type GetDNSRecordRequest struct {
 
 func (x *GetDNSRecordRequest) Reset() {
 	*x = GetDNSRecordRequest{}
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[7]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -462,7 +638,7 @@ func (x *GetDNSRecordRequest) String() string {
 func (*GetDNSRecordRequest) ProtoMessage() {}
 
 func (x *GetDNSRecordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[7]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -475,7 +651,7 @@ func (x *GetDNSRecordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDNSRecordRequest.ProtoReflect.Descriptor instead.
 func (*GetDNSRecordRequest) Descriptor() ([]byte, []int) {
-	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{7}
+	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetDNSRecordRequest) GetId() string {
@@ -494,7 +670,7 @@ type GetDNSRecordResponse struct {
 
 func (x *GetDNSRecordResponse) Reset() {
 	*x = GetDNSRecordResponse{}
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[8]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -506,7 +682,7 @@ func (x *GetDNSRecordResponse) String() string {
 func (*GetDNSRecordResponse) ProtoMessage() {}
 
 func (x *GetDNSRecordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[8]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,7 +695,7 @@ func (x *GetDNSRecordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDNSRecordResponse.ProtoReflect.Descriptor instead.
 func (*GetDNSRecordResponse) Descriptor() ([]byte, []int) {
-	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{8}
+	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetDNSRecordResponse) GetRecord() *DNSRecord {
@@ -537,13 +713,14 @@ type UpdateDNSRecordRequest struct {
 	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
 	Ttl           int32                  `protobuf:"varint,5,opt,name=ttl,proto3" json:"ttl,omitempty"`
 	Weight        int32                  `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	Svcb          *SVCBData              `protobuf:"bytes,7,opt,name=svcb,proto3" json:"svcb,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateDNSRecordRequest) Reset() {
 	*x = UpdateDNSRecordRequest{}
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[9]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -555,7 +732,7 @@ func (x *UpdateDNSRecordRequest) String() string {
 func (*UpdateDNSRecordRequest) ProtoMessage() {}
 
 func (x *UpdateDNSRecordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[9]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,7 +745,7 @@ func (x *UpdateDNSRecordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDNSRecordRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDNSRecordRequest) Descriptor() ([]byte, []int) {
-	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{9}
+	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateDNSRecordRequest) GetId() string {
@@ -613,6 +790,13 @@ func (x *UpdateDNSRecordRequest) GetWeight() int32 {
 	return 0
 }
 
+func (x *UpdateDNSRecordRequest) GetSvcb() *SVCBData {
+	if x != nil {
+		return x.Svcb
+	}
+	return nil
+}
+
 type UpdateDNSRecordResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Record        *DNSRecord             `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
@@ -622,7 +806,7 @@ type UpdateDNSRecordResponse struct {
 
 func (x *UpdateDNSRecordResponse) Reset() {
 	*x = UpdateDNSRecordResponse{}
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[10]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -634,7 +818,7 @@ func (x *UpdateDNSRecordResponse) String() string {
 func (*UpdateDNSRecordResponse) ProtoMessage() {}
 
 func (x *UpdateDNSRecordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[10]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -647,7 +831,7 @@ func (x *UpdateDNSRecordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDNSRecordResponse.ProtoReflect.Descriptor instead.
 func (*UpdateDNSRecordResponse) Descriptor() ([]byte, []int) {
-	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{10}
+	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateDNSRecordResponse) GetRecord() *DNSRecord {
@@ -666,7 +850,7 @@ type DeleteDNSRecordRequest struct {
 
 func (x *DeleteDNSRecordRequest) Reset() {
 	*x = DeleteDNSRecordRequest{}
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[11]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -678,7 +862,7 @@ func (x *DeleteDNSRecordRequest) String() string {
 func (*DeleteDNSRecordRequest) ProtoMessage() {}
 
 func (x *DeleteDNSRecordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[11]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,7 +875,7 @@ func (x *DeleteDNSRecordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDNSRecordRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDNSRecordRequest) Descriptor() ([]byte, []int) {
-	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{11}
+	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteDNSRecordRequest) GetId() string {
@@ -709,7 +893,7 @@ type DeleteDNSRecordResponse struct {
 
 func (x *DeleteDNSRecordResponse) Reset() {
 	*x = DeleteDNSRecordResponse{}
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[12]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -721,7 +905,7 @@ func (x *DeleteDNSRecordResponse) String() string {
 func (*DeleteDNSRecordResponse) ProtoMessage() {}
 
 func (x *DeleteDNSRecordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dns_record_v1_dns_record_proto_msgTypes[12]
+	mi := &file_dns_record_v1_dns_record_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -734,21 +918,34 @@ func (x *DeleteDNSRecordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDNSRecordResponse.ProtoReflect.Descriptor instead.
 func (*DeleteDNSRecordResponse) Descriptor() ([]byte, []int) {
-	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{12}
+	return file_dns_record_v1_dns_record_proto_rawDescGZIP(), []int{14}
 }
 
 var File_dns_record_v1_dns_record_proto protoreflect.FileDescriptor
 
 const file_dns_record_v1_dns_record_proto_rawDesc = "" +
 	"\n" +
-	"\x1edns_record/v1/dns_record.proto\x12\rdns_record.v1\"\xa1\x01\n" +
+	"\x1edns_record/v1/dns_record.proto\x12\rdns_record.v1\"\xf7\x01\n" +
 	"\x16CreateDNSRecordRequest\x12\x1b\n" +
 	"\tzone_name\x18\x01 \x01(\tR\bzoneName\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04type\x18\x03 \x01(\tR\x04type\x12\x18\n" +
 	"\acontent\x18\x04 \x01(\tR\acontent\x12\x10\n" +
 	"\x03ttl\x18\x05 \x01(\x05R\x03ttl\x12\x16\n" +
-	"\x06weight\x18\x06 \x01(\x05R\x06weight\"\xfb\x01\n" +
+	"\x06weight\x18\x06 \x01(\x05R\x06weight\x12+\n" +
+	"\x04svcb\x18\a \x01(\v2\x17.dns_record.v1.SVCBDataR\x04svcb\x12'\n" +
+	"\x0forganization_id\x18\b \x01(\tR\x0eorganizationId\"\xa5\x01\n" +
+	"\tSvcParams\x12\x12\n" +
+	"\x04alpn\x18\x01 \x03(\tR\x04alpn\x12&\n" +
+	"\x0fno_default_alpn\x18\x02 \x01(\bR\rnoDefaultAlpn\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\rR\x04port\x12\x1a\n" +
+	"\bipv4hint\x18\x04 \x03(\tR\bipv4hint\x12\x1a\n" +
+	"\bipv6hint\x18\x05 \x03(\tR\bipv6hint\x12\x10\n" +
+	"\x03ech\x18\x06 \x01(\tR\x03ech\"p\n" +
+	"\bSVCBData\x12\x1a\n" +
+	"\bpriority\x18\x01 \x01(\rR\bpriority\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x120\n" +
+	"\x06params\x18\x03 \x01(\v2\x18.dns_record.v1.SvcParamsR\x06params\"\xa8\x02\n" +
 	"\tDNSRecord\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\azone_id\x18\x02 \x01(\tR\x06zoneId\x12\x1b\n" +
@@ -762,28 +959,31 @@ const file_dns_record_v1_dns_record_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\tR\tupdatedAt\"K\n" +
+	" \x01(\tR\tupdatedAt\x12+\n" +
+	"\x04svcb\x18\v \x01(\v2\x17.dns_record.v1.SVCBDataR\x04svcb\"K\n" +
 	"\x17CreateDNSRecordResponse\x120\n" +
 	"\x06record\x18\x01 \x01(\v2\x18.dns_record.v1.DNSRecordR\x06record\"0\n" +
 	"\x15ListDNSRecordsRequest\x12\x17\n" +
 	"\azone_id\x18\x01 \x01(\tR\x06zoneId\"L\n" +
 	"\x16ListDNSRecordsResponse\x122\n" +
-	"\arecords\x18\x01 \x03(\v2\x18.dns_record.v1.DNSRecordR\arecords\">\n" +
+	"\arecords\x18\x01 \x03(\v2\x18.dns_record.v1.DNSRecordR\arecords\"g\n" +
 	"\x1fListDNSRecordsByZoneNameRequest\x12\x1b\n" +
-	"\tzone_name\x18\x01 \x01(\tR\bzoneName\"V\n" +
+	"\tzone_name\x18\x01 \x01(\tR\bzoneName\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\"V\n" +
 	" ListDNSRecordsByZoneNameResponse\x122\n" +
 	"\arecords\x18\x01 \x03(\v2\x18.dns_record.v1.DNSRecordR\arecords\"%\n" +
 	"\x13GetDNSRecordRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
 	"\x14GetDNSRecordResponse\x120\n" +
-	"\x06record\x18\x01 \x01(\v2\x18.dns_record.v1.DNSRecordR\x06record\"\x94\x01\n" +
+	"\x06record\x18\x01 \x01(\v2\x18.dns_record.v1.DNSRecordR\x06record\"\xc1\x01\n" +
 	"\x16UpdateDNSRecordRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04type\x18\x03 \x01(\tR\x04type\x12\x18\n" +
 	"\acontent\x18\x04 \x01(\tR\acontent\x12\x10\n" +
 	"\x03ttl\x18\x05 \x01(\x05R\x03ttl\x12\x16\n" +
-	"\x06weight\x18\x06 \x01(\x05R\x06weight\"K\n" +
+	"\x06weight\x18\x06 \x01(\x05R\x06weight\x12+\n" +
+	"\x04svcb\x18\a \x01(\v2\x17.dns_record.v1.SVCBDataR\x04svcb\"K\n" +
 	"\x17UpdateDNSRecordResponse\x120\n" +
 	"\x06record\x18\x01 \x01(\v2\x18.dns_record.v1.DNSRecordR\x06record\"(\n" +
 	"\x16DeleteDNSRecordRequest\x12\x0e\n" +
@@ -809,45 +1009,51 @@ func file_dns_record_v1_dns_record_proto_rawDescGZIP() []byte {
 	return file_dns_record_v1_dns_record_proto_rawDescData
 }
 
-var file_dns_record_v1_dns_record_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_dns_record_v1_dns_record_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_dns_record_v1_dns_record_proto_goTypes = []any{
 	(*CreateDNSRecordRequest)(nil),           // 0: dns_record.v1.CreateDNSRecordRequest
-	(*DNSRecord)(nil),                        // 1: dns_record.v1.DNSRecord
-	(*CreateDNSRecordResponse)(nil),          // 2: dns_record.v1.CreateDNSRecordResponse
-	(*ListDNSRecordsRequest)(nil),            // 3: dns_record.v1.ListDNSRecordsRequest
-	(*ListDNSRecordsResponse)(nil),           // 4: dns_record.v1.ListDNSRecordsResponse
-	(*ListDNSRecordsByZoneNameRequest)(nil),  // 5: dns_record.v1.ListDNSRecordsByZoneNameRequest
-	(*ListDNSRecordsByZoneNameResponse)(nil), // 6: dns_record.v1.ListDNSRecordsByZoneNameResponse
-	(*GetDNSRecordRequest)(nil),              // 7: dns_record.v1.GetDNSRecordRequest
-	(*GetDNSRecordResponse)(nil),             // 8: dns_record.v1.GetDNSRecordResponse
-	(*UpdateDNSRecordRequest)(nil),           // 9: dns_record.v1.UpdateDNSRecordRequest
-	(*UpdateDNSRecordResponse)(nil),          // 10: dns_record.v1.UpdateDNSRecordResponse
-	(*DeleteDNSRecordRequest)(nil),           // 11: dns_record.v1.DeleteDNSRecordRequest
-	(*DeleteDNSRecordResponse)(nil),          // 12: dns_record.v1.DeleteDNSRecordResponse
+	(*SvcParams)(nil),                        // 1: dns_record.v1.SvcParams
+	(*SVCBData)(nil),                         // 2: dns_record.v1.SVCBData
+	(*DNSRecord)(nil),                        // 3: dns_record.v1.DNSRecord
+	(*CreateDNSRecordResponse)(nil),          // 4: dns_record.v1.CreateDNSRecordResponse
+	(*ListDNSRecordsRequest)(nil),            // 5: dns_record.v1.ListDNSRecordsRequest
+	(*ListDNSRecordsResponse)(nil),           // 6: dns_record.v1.ListDNSRecordsResponse
+	(*ListDNSRecordsByZoneNameRequest)(nil),  // 7: dns_record.v1.ListDNSRecordsByZoneNameRequest
+	(*ListDNSRecordsByZoneNameResponse)(nil), // 8: dns_record.v1.ListDNSRecordsByZoneNameResponse
+	(*GetDNSRecordRequest)(nil),              // 9: dns_record.v1.GetDNSRecordRequest
+	(*GetDNSRecordResponse)(nil),             // 10: dns_record.v1.GetDNSRecordResponse
+	(*UpdateDNSRecordRequest)(nil),           // 11: dns_record.v1.UpdateDNSRecordRequest
+	(*UpdateDNSRecordResponse)(nil),          // 12: dns_record.v1.UpdateDNSRecordResponse
+	(*DeleteDNSRecordRequest)(nil),           // 13: dns_record.v1.DeleteDNSRecordRequest
+	(*DeleteDNSRecordResponse)(nil),          // 14: dns_record.v1.DeleteDNSRecordResponse
 }
 var file_dns_record_v1_dns_record_proto_depIdxs = []int32{
-	1,  // 0: dns_record.v1.CreateDNSRecordResponse.record:type_name -> dns_record.v1.DNSRecord
-	1,  // 1: dns_record.v1.ListDNSRecordsResponse.records:type_name -> dns_record.v1.DNSRecord
-	1,  // 2: dns_record.v1.ListDNSRecordsByZoneNameResponse.records:type_name -> dns_record.v1.DNSRecord
-	1,  // 3: dns_record.v1.GetDNSRecordResponse.record:type_name -> dns_record.v1.DNSRecord
-	1,  // 4: dns_record.v1.UpdateDNSRecordResponse.record:type_name -> dns_record.v1.DNSRecord
-	0,  // 5: dns_record.v1.DNSRecordService.CreateDNSRecord:input_type -> dns_record.v1.CreateDNSRecordRequest
-	3,  // 6: dns_record.v1.DNSRecordService.ListDNSRecords:input_type -> dns_record.v1.ListDNSRecordsRequest
-	5,  // 7: dns_record.v1.DNSRecordService.ListDNSRecordsByZoneName:input_type -> dns_record.v1.ListDNSRecordsByZoneNameRequest
-	7,  // 8: dns_record.v1.DNSRecordService.GetDNSRecord:input_type -> dns_record.v1.GetDNSRecordRequest
-	9,  // 9: dns_record.v1.DNSRecordService.UpdateDNSRecord:input_type -> dns_record.v1.UpdateDNSRecordRequest
-	11, // 10: dns_record.v1.DNSRecordService.DeleteDNSRecord:input_type -> dns_record.v1.DeleteDNSRecordRequest
-	2,  // 11: dns_record.v1.DNSRecordService.CreateDNSRecord:output_type -> dns_record.v1.CreateDNSRecordResponse
-	4,  // 12: dns_record.v1.DNSRecordService.ListDNSRecords:output_type -> dns_record.v1.ListDNSRecordsResponse
-	6,  // 13: dns_record.v1.DNSRecordService.ListDNSRecordsByZoneName:output_type -> dns_record.v1.ListDNSRecordsByZoneNameResponse
-	8,  // 14: dns_record.v1.DNSRecordService.GetDNSRecord:output_type -> dns_record.v1.GetDNSRecordResponse
-	10, // 15: dns_record.v1.DNSRecordService.UpdateDNSRecord:output_type -> dns_record.v1.UpdateDNSRecordResponse
-	12, // 16: dns_record.v1.DNSRecordService.DeleteDNSRecord:output_type -> dns_record.v1.DeleteDNSRecordResponse
-	11, // [11:17] is the sub-list for method output_type
-	5,  // [5:11] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	2,  // 0: dns_record.v1.CreateDNSRecordRequest.svcb:type_name -> dns_record.v1.SVCBData
+	1,  // 1: dns_record.v1.SVCBData.params:type_name -> dns_record.v1.SvcParams
+	2,  // 2: dns_record.v1.DNSRecord.svcb:type_name -> dns_record.v1.SVCBData
+	3,  // 3: dns_record.v1.CreateDNSRecordResponse.record:type_name -> dns_record.v1.DNSRecord
+	3,  // 4: dns_record.v1.ListDNSRecordsResponse.records:type_name -> dns_record.v1.DNSRecord
+	3,  // 5: dns_record.v1.ListDNSRecordsByZoneNameResponse.records:type_name -> dns_record.v1.DNSRecord
+	3,  // 6: dns_record.v1.GetDNSRecordResponse.record:type_name -> dns_record.v1.DNSRecord
+	2,  // 7: dns_record.v1.UpdateDNSRecordRequest.svcb:type_name -> dns_record.v1.SVCBData
+	3,  // 8: dns_record.v1.UpdateDNSRecordResponse.record:type_name -> dns_record.v1.DNSRecord
+	0,  // 9: dns_record.v1.DNSRecordService.CreateDNSRecord:input_type -> dns_record.v1.CreateDNSRecordRequest
+	5,  // 10: dns_record.v1.DNSRecordService.ListDNSRecords:input_type -> dns_record.v1.ListDNSRecordsRequest
+	7,  // 11: dns_record.v1.DNSRecordService.ListDNSRecordsByZoneName:input_type -> dns_record.v1.ListDNSRecordsByZoneNameRequest
+	9,  // 12: dns_record.v1.DNSRecordService.GetDNSRecord:input_type -> dns_record.v1.GetDNSRecordRequest
+	11, // 13: dns_record.v1.DNSRecordService.UpdateDNSRecord:input_type -> dns_record.v1.UpdateDNSRecordRequest
+	13, // 14: dns_record.v1.DNSRecordService.DeleteDNSRecord:input_type -> dns_record.v1.DeleteDNSRecordRequest
+	4,  // 15: dns_record.v1.DNSRecordService.CreateDNSRecord:output_type -> dns_record.v1.CreateDNSRecordResponse
+	6,  // 16: dns_record.v1.DNSRecordService.ListDNSRecords:output_type -> dns_record.v1.ListDNSRecordsResponse
+	8,  // 17: dns_record.v1.DNSRecordService.ListDNSRecordsByZoneName:output_type -> dns_record.v1.ListDNSRecordsByZoneNameResponse
+	10, // 18: dns_record.v1.DNSRecordService.GetDNSRecord:output_type -> dns_record.v1.GetDNSRecordResponse
+	12, // 19: dns_record.v1.DNSRecordService.UpdateDNSRecord:output_type -> dns_record.v1.UpdateDNSRecordResponse
+	14, // 20: dns_record.v1.DNSRecordService.DeleteDNSRecord:output_type -> dns_record.v1.DeleteDNSRecordResponse
+	15, // [15:21] is the sub-list for method output_type
+	9,  // [9:15] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_dns_record_v1_dns_record_proto_init() }
@@ -861,7 +1067,7 @@ func file_dns_record_v1_dns_record_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dns_record_v1_dns_record_proto_rawDesc), len(file_dns_record_v1_dns_record_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,566 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: grant/v1/grant.proto
+
+package grantv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PrincipalType int32
+
+const (
+	PrincipalType_PRINCIPAL_TYPE_UNSPECIFIED PrincipalType = 0
+	PrincipalType_PRINCIPAL_TYPE_USER        PrincipalType = 1
+	PrincipalType_PRINCIPAL_TYPE_API_TOKEN   PrincipalType = 2
+)
+
+// Enum value maps for PrincipalType.
+var (
+	PrincipalType_name = map[int32]string{
+		0: "PRINCIPAL_TYPE_UNSPECIFIED",
+		1: "PRINCIPAL_TYPE_USER",
+		2: "PRINCIPAL_TYPE_API_TOKEN",
+	}
+	PrincipalType_value = map[string]int32{
+		"PRINCIPAL_TYPE_UNSPECIFIED": 0,
+		"PRINCIPAL_TYPE_USER":        1,
+		"PRINCIPAL_TYPE_API_TOKEN":   2,
+	}
+)
+
+func (x PrincipalType) Enum() *PrincipalType {
+	p := new(PrincipalType)
+	*p = x
+	return p
+}
+
+func (x PrincipalType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrincipalType) Descriptor() protoreflect.EnumDescriptor {
+	return file_grant_v1_grant_proto_enumTypes[0].Descriptor()
+}
+
+func (PrincipalType) Type() protoreflect.EnumType {
+	return &file_grant_v1_grant_proto_enumTypes[0]
+}
+
+func (x PrincipalType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrincipalType.Descriptor instead.
+func (PrincipalType) EnumDescriptor() ([]byte, []int) {
+	return file_grant_v1_grant_proto_rawDescGZIP(), []int{0}
+}
+
+type RecordGrant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ZoneId        string                 `protobuf:"bytes,2,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	PrincipalType PrincipalType          `protobuf:"varint,3,opt,name=principal_type,json=principalType,proto3,enum=grant.v1.PrincipalType" json:"principal_type,omitempty"`
+	PrincipalId   string                 `protobuf:"bytes,4,opt,name=principal_id,json=principalId,proto3" json:"principal_id,omitempty"`
+	NamePattern   string                 `protobuf:"bytes,5,opt,name=name_pattern,json=namePattern,proto3" json:"name_pattern,omitempty"`
+	Types         []string               `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordGrant) Reset() {
+	*x = RecordGrant{}
+	mi := &file_grant_v1_grant_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordGrant) ProtoMessage() {}
+
+func (x *RecordGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_grant_v1_grant_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordGrant.ProtoReflect.Descriptor instead.
+func (*RecordGrant) Descriptor() ([]byte, []int) {
+	return file_grant_v1_grant_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecordGrant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RecordGrant) GetZoneId() string {
+	if x != nil {
+		return x.ZoneId
+	}
+	return ""
+}
+
+func (x *RecordGrant) GetPrincipalType() PrincipalType {
+	if x != nil {
+		return x.PrincipalType
+	}
+	return PrincipalType_PRINCIPAL_TYPE_UNSPECIFIED
+}
+
+func (x *RecordGrant) GetPrincipalId() string {
+	if x != nil {
+		return x.PrincipalId
+	}
+	return ""
+}
+
+func (x *RecordGrant) GetNamePattern() string {
+	if x != nil {
+		return x.NamePattern
+	}
+	return ""
+}
+
+func (x *RecordGrant) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *RecordGrant) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *RecordGrant) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateRecordGrantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneId        string                 `protobuf:"bytes,1,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	PrincipalType PrincipalType          `protobuf:"varint,2,opt,name=principal_type,json=principalType,proto3,enum=grant.v1.PrincipalType" json:"principal_type,omitempty"`
+	PrincipalId   string                 `protobuf:"bytes,3,opt,name=principal_id,json=principalId,proto3" json:"principal_id,omitempty"`
+	NamePattern   string                 `protobuf:"bytes,4,opt,name=name_pattern,json=namePattern,proto3" json:"name_pattern,omitempty"`
+	Types         []string               `protobuf:"bytes,5,rep,name=types,proto3" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecordGrantRequest) Reset() {
+	*x = CreateRecordGrantRequest{}
+	mi := &file_grant_v1_grant_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecordGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecordGrantRequest) ProtoMessage() {}
+
+func (x *CreateRecordGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grant_v1_grant_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecordGrantRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecordGrantRequest) Descriptor() ([]byte, []int) {
+	return file_grant_v1_grant_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRecordGrantRequest) GetZoneId() string {
+	if x != nil {
+		return x.ZoneId
+	}
+	return ""
+}
+
+func (x *CreateRecordGrantRequest) GetPrincipalType() PrincipalType {
+	if x != nil {
+		return x.PrincipalType
+	}
+	return PrincipalType_PRINCIPAL_TYPE_UNSPECIFIED
+}
+
+func (x *CreateRecordGrantRequest) GetPrincipalId() string {
+	if x != nil {
+		return x.PrincipalId
+	}
+	return ""
+}
+
+func (x *CreateRecordGrantRequest) GetNamePattern() string {
+	if x != nil {
+		return x.NamePattern
+	}
+	return ""
+}
+
+func (x *CreateRecordGrantRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type CreateRecordGrantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grant         *RecordGrant           `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecordGrantResponse) Reset() {
+	*x = CreateRecordGrantResponse{}
+	mi := &file_grant_v1_grant_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecordGrantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecordGrantResponse) ProtoMessage() {}
+
+func (x *CreateRecordGrantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grant_v1_grant_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecordGrantResponse.ProtoReflect.Descriptor instead.
+func (*CreateRecordGrantResponse) Descriptor() ([]byte, []int) {
+	return file_grant_v1_grant_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateRecordGrantResponse) GetGrant() *RecordGrant {
+	if x != nil {
+		return x.Grant
+	}
+	return nil
+}
+
+type ListRecordGrantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneId        string                 `protobuf:"bytes,1,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecordGrantsRequest) Reset() {
+	*x = ListRecordGrantsRequest{}
+	mi := &file_grant_v1_grant_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecordGrantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecordGrantsRequest) ProtoMessage() {}
+
+func (x *ListRecordGrantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grant_v1_grant_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecordGrantsRequest.ProtoReflect.Descriptor instead.
+func (*ListRecordGrantsRequest) Descriptor() ([]byte, []int) {
+	return file_grant_v1_grant_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRecordGrantsRequest) GetZoneId() string {
+	if x != nil {
+		return x.ZoneId
+	}
+	return ""
+}
+
+type ListRecordGrantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grants        []*RecordGrant         `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecordGrantsResponse) Reset() {
+	*x = ListRecordGrantsResponse{}
+	mi := &file_grant_v1_grant_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecordGrantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecordGrantsResponse) ProtoMessage() {}
+
+func (x *ListRecordGrantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grant_v1_grant_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecordGrantsResponse.ProtoReflect.Descriptor instead.
+func (*ListRecordGrantsResponse) Descriptor() ([]byte, []int) {
+	return file_grant_v1_grant_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListRecordGrantsResponse) GetGrants() []*RecordGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+type DeleteRecordGrantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecordGrantRequest) Reset() {
+	*x = DeleteRecordGrantRequest{}
+	mi := &file_grant_v1_grant_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecordGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecordGrantRequest) ProtoMessage() {}
+
+func (x *DeleteRecordGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grant_v1_grant_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecordGrantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecordGrantRequest) Descriptor() ([]byte, []int) {
+	return file_grant_v1_grant_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteRecordGrantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteRecordGrantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecordGrantResponse) Reset() {
+	*x = DeleteRecordGrantResponse{}
+	mi := &file_grant_v1_grant_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecordGrantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecordGrantResponse) ProtoMessage() {}
+
+func (x *DeleteRecordGrantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grant_v1_grant_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecordGrantResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRecordGrantResponse) Descriptor() ([]byte, []int) {
+	return file_grant_v1_grant_proto_rawDescGZIP(), []int{6}
+}
+
+var File_grant_v1_grant_proto protoreflect.FileDescriptor
+
+const file_grant_v1_grant_proto_rawDesc = "" +
+	"\n" +
+	"\x14grant/v1/grant.proto\x12\bgrant.v1\"\x90\x02\n" +
+	"\vRecordGrant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\azone_id\x18\x02 \x01(\tR\x06zoneId\x12>\n" +
+	"\x0eprincipal_type\x18\x03 \x01(\x0e2\x17.grant.v1.PrincipalTypeR\rprincipalType\x12!\n" +
+	"\fprincipal_id\x18\x04 \x01(\tR\vprincipalId\x12!\n" +
+	"\fname_pattern\x18\x05 \x01(\tR\vnamePattern\x12\x14\n" +
+	"\x05types\x18\x06 \x03(\tR\x05types\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"\xcf\x01\n" +
+	"\x18CreateRecordGrantRequest\x12\x17\n" +
+	"\azone_id\x18\x01 \x01(\tR\x06zoneId\x12>\n" +
+	"\x0eprincipal_type\x18\x02 \x01(\x0e2\x17.grant.v1.PrincipalTypeR\rprincipalType\x12!\n" +
+	"\fprincipal_id\x18\x03 \x01(\tR\vprincipalId\x12!\n" +
+	"\fname_pattern\x18\x04 \x01(\tR\vnamePattern\x12\x14\n" +
+	"\x05types\x18\x05 \x03(\tR\x05types\"H\n" +
+	"\x19CreateRecordGrantResponse\x12+\n" +
+	"\x05grant\x18\x01 \x01(\v2\x15.grant.v1.RecordGrantR\x05grant\"2\n" +
+	"\x17ListRecordGrantsRequest\x12\x17\n" +
+	"\azone_id\x18\x01 \x01(\tR\x06zoneId\"I\n" +
+	"\x18ListRecordGrantsResponse\x12-\n" +
+	"\x06grants\x18\x01 \x03(\v2\x15.grant.v1.RecordGrantR\x06grants\"*\n" +
+	"\x18DeleteRecordGrantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1b\n" +
+	"\x19DeleteRecordGrantResponse*f\n" +
+	"\rPrincipalType\x12\x1e\n" +
+	"\x1aPRINCIPAL_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13PRINCIPAL_TYPE_USER\x10\x01\x12\x1c\n" +
+	"\x18PRINCIPAL_TYPE_API_TOKEN\x10\x022\xb1\x02\n" +
+	"\x12RecordGrantService\x12^\n" +
+	"\x11CreateRecordGrant\x12\".grant.v1.CreateRecordGrantRequest\x1a#.grant.v1.CreateRecordGrantResponse\"\x00\x12[\n" +
+	"\x10ListRecordGrants\x12!.grant.v1.ListRecordGrantsRequest\x1a\".grant.v1.ListRecordGrantsResponse\"\x00\x12^\n" +
+	"\x11DeleteRecordGrant\x12\".grant.v1.DeleteRecordGrantRequest\x1a#.grant.v1.DeleteRecordGrantResponse\"\x00B\x1dZ\x1bdnsarc/gen/grant/v1;grantv1b\x06proto3"
+
+var (
+	file_grant_v1_grant_proto_rawDescOnce sync.Once
+	file_grant_v1_grant_proto_rawDescData []byte
+)
+
+func file_grant_v1_grant_proto_rawDescGZIP() []byte {
+	file_grant_v1_grant_proto_rawDescOnce.Do(func() {
+		file_grant_v1_grant_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_grant_v1_grant_proto_rawDesc), len(file_grant_v1_grant_proto_rawDesc)))
+	})
+	return file_grant_v1_grant_proto_rawDescData
+}
+
+var file_grant_v1_grant_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_grant_v1_grant_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_grant_v1_grant_proto_goTypes = []any{
+	(PrincipalType)(0),                // 0: grant.v1.PrincipalType
+	(*RecordGrant)(nil),               // 1: grant.v1.RecordGrant
+	(*CreateRecordGrantRequest)(nil),  // 2: grant.v1.CreateRecordGrantRequest
+	(*CreateRecordGrantResponse)(nil), // 3: grant.v1.CreateRecordGrantResponse
+	(*ListRecordGrantsRequest)(nil),   // 4: grant.v1.ListRecordGrantsRequest
+	(*ListRecordGrantsResponse)(nil),  // 5: grant.v1.ListRecordGrantsResponse
+	(*DeleteRecordGrantRequest)(nil),  // 6: grant.v1.DeleteRecordGrantRequest
+	(*DeleteRecordGrantResponse)(nil), // 7: grant.v1.DeleteRecordGrantResponse
+}
+var file_grant_v1_grant_proto_depIdxs = []int32{
+	0, // 0: grant.v1.RecordGrant.principal_type:type_name -> grant.v1.PrincipalType
+	0, // 1: grant.v1.CreateRecordGrantRequest.principal_type:type_name -> grant.v1.PrincipalType
+	1, // 2: grant.v1.CreateRecordGrantResponse.grant:type_name -> grant.v1.RecordGrant
+	1, // 3: grant.v1.ListRecordGrantsResponse.grants:type_name -> grant.v1.RecordGrant
+	2, // 4: grant.v1.RecordGrantService.CreateRecordGrant:input_type -> grant.v1.CreateRecordGrantRequest
+	4, // 5: grant.v1.RecordGrantService.ListRecordGrants:input_type -> grant.v1.ListRecordGrantsRequest
+	6, // 6: grant.v1.RecordGrantService.DeleteRecordGrant:input_type -> grant.v1.DeleteRecordGrantRequest
+	3, // 7: grant.v1.RecordGrantService.CreateRecordGrant:output_type -> grant.v1.CreateRecordGrantResponse
+	5, // 8: grant.v1.RecordGrantService.ListRecordGrants:output_type -> grant.v1.ListRecordGrantsResponse
+	7, // 9: grant.v1.RecordGrantService.DeleteRecordGrant:output_type -> grant.v1.DeleteRecordGrantResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_grant_v1_grant_proto_init() }
+func file_grant_v1_grant_proto_init() {
+	if File_grant_v1_grant_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_grant_v1_grant_proto_rawDesc), len(file_grant_v1_grant_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_grant_v1_grant_proto_goTypes,
+		DependencyIndexes: file_grant_v1_grant_proto_depIdxs,
+		EnumInfos:         file_grant_v1_grant_proto_enumTypes,
+		MessageInfos:      file_grant_v1_grant_proto_msgTypes,
+	}.Build()
+	File_grant_v1_grant_proto = out.File
+	file_grant_v1_grant_proto_goTypes = nil
+	file_grant_v1_grant_proto_depIdxs = nil
+}
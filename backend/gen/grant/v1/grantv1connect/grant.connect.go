@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: grant/v1/grant.proto
+
+package grantv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	v1 "dnsarc/gen/grant/v1"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RecordGrantServiceName is the fully-qualified name of the RecordGrantService service.
+	RecordGrantServiceName = "grant.v1.RecordGrantService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RecordGrantServiceCreateRecordGrantProcedure is the fully-qualified name of the
+	// RecordGrantService's CreateRecordGrant RPC.
+	RecordGrantServiceCreateRecordGrantProcedure = "/grant.v1.RecordGrantService/CreateRecordGrant"
+	// RecordGrantServiceListRecordGrantsProcedure is the fully-qualified name of the
+	// RecordGrantService's ListRecordGrants RPC.
+	RecordGrantServiceListRecordGrantsProcedure = "/grant.v1.RecordGrantService/ListRecordGrants"
+	// RecordGrantServiceDeleteRecordGrantProcedure is the fully-qualified name of the
+	// RecordGrantService's DeleteRecordGrant RPC.
+	RecordGrantServiceDeleteRecordGrantProcedure = "/grant.v1.RecordGrantService/DeleteRecordGrant"
+)
+
+// RecordGrantServiceClient is a client for the grant.v1.RecordGrantService service.
+type RecordGrantServiceClient interface {
+	CreateRecordGrant(context.Context, *connect.Request[v1.CreateRecordGrantRequest]) (*connect.Response[v1.CreateRecordGrantResponse], error)
+	ListRecordGrants(context.Context, *connect.Request[v1.ListRecordGrantsRequest]) (*connect.Response[v1.ListRecordGrantsResponse], error)
+	DeleteRecordGrant(context.Context, *connect.Request[v1.DeleteRecordGrantRequest]) (*connect.Response[v1.DeleteRecordGrantResponse], error)
+}
+
+// NewRecordGrantServiceClient constructs a client for the grant.v1.RecordGrantService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRecordGrantServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RecordGrantServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	recordGrantServiceMethods := v1.File_grant_v1_grant_proto.Services().ByName("RecordGrantService").Methods()
+	return &recordGrantServiceClient{
+		createRecordGrant: connect.NewClient[v1.CreateRecordGrantRequest, v1.CreateRecordGrantResponse](
+			httpClient,
+			baseURL+RecordGrantServiceCreateRecordGrantProcedure,
+			connect.WithSchema(recordGrantServiceMethods.ByName("CreateRecordGrant")),
+			connect.WithClientOptions(opts...),
+		),
+		listRecordGrants: connect.NewClient[v1.ListRecordGrantsRequest, v1.ListRecordGrantsResponse](
+			httpClient,
+			baseURL+RecordGrantServiceListRecordGrantsProcedure,
+			connect.WithSchema(recordGrantServiceMethods.ByName("ListRecordGrants")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRecordGrant: connect.NewClient[v1.DeleteRecordGrantRequest, v1.DeleteRecordGrantResponse](
+			httpClient,
+			baseURL+RecordGrantServiceDeleteRecordGrantProcedure,
+			connect.WithSchema(recordGrantServiceMethods.ByName("DeleteRecordGrant")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// recordGrantServiceClient implements RecordGrantServiceClient.
+type recordGrantServiceClient struct {
+	createRecordGrant *connect.Client[v1.CreateRecordGrantRequest, v1.CreateRecordGrantResponse]
+	listRecordGrants  *connect.Client[v1.ListRecordGrantsRequest, v1.ListRecordGrantsResponse]
+	deleteRecordGrant *connect.Client[v1.DeleteRecordGrantRequest, v1.DeleteRecordGrantResponse]
+}
+
+// CreateRecordGrant calls grant.v1.RecordGrantService.CreateRecordGrant.
+func (c *recordGrantServiceClient) CreateRecordGrant(ctx context.Context, req *connect.Request[v1.CreateRecordGrantRequest]) (*connect.Response[v1.CreateRecordGrantResponse], error) {
+	return c.createRecordGrant.CallUnary(ctx, req)
+}
+
+// ListRecordGrants calls grant.v1.RecordGrantService.ListRecordGrants.
+func (c *recordGrantServiceClient) ListRecordGrants(ctx context.Context, req *connect.Request[v1.ListRecordGrantsRequest]) (*connect.Response[v1.ListRecordGrantsResponse], error) {
+	return c.listRecordGrants.CallUnary(ctx, req)
+}
+
+// DeleteRecordGrant calls grant.v1.RecordGrantService.DeleteRecordGrant.
+func (c *recordGrantServiceClient) DeleteRecordGrant(ctx context.Context, req *connect.Request[v1.DeleteRecordGrantRequest]) (*connect.Response[v1.DeleteRecordGrantResponse], error) {
+	return c.deleteRecordGrant.CallUnary(ctx, req)
+}
+
+// RecordGrantServiceHandler is an implementation of the grant.v1.RecordGrantService service.
+type RecordGrantServiceHandler interface {
+	CreateRecordGrant(context.Context, *connect.Request[v1.CreateRecordGrantRequest]) (*connect.Response[v1.CreateRecordGrantResponse], error)
+	ListRecordGrants(context.Context, *connect.Request[v1.ListRecordGrantsRequest]) (*connect.Response[v1.ListRecordGrantsResponse], error)
+	DeleteRecordGrant(context.Context, *connect.Request[v1.DeleteRecordGrantRequest]) (*connect.Response[v1.DeleteRecordGrantResponse], error)
+}
+
+// NewRecordGrantServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRecordGrantServiceHandler(svc RecordGrantServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	recordGrantServiceMethods := v1.File_grant_v1_grant_proto.Services().ByName("RecordGrantService").Methods()
+	recordGrantServiceCreateRecordGrantHandler := connect.NewUnaryHandler(
+		RecordGrantServiceCreateRecordGrantProcedure,
+		svc.CreateRecordGrant,
+		connect.WithSchema(recordGrantServiceMethods.ByName("CreateRecordGrant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordGrantServiceListRecordGrantsHandler := connect.NewUnaryHandler(
+		RecordGrantServiceListRecordGrantsProcedure,
+		svc.ListRecordGrants,
+		connect.WithSchema(recordGrantServiceMethods.ByName("ListRecordGrants")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recordGrantServiceDeleteRecordGrantHandler := connect.NewUnaryHandler(
+		RecordGrantServiceDeleteRecordGrantProcedure,
+		svc.DeleteRecordGrant,
+		connect.WithSchema(recordGrantServiceMethods.ByName("DeleteRecordGrant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/grant.v1.RecordGrantService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RecordGrantServiceCreateRecordGrantProcedure:
+			recordGrantServiceCreateRecordGrantHandler.ServeHTTP(w, r)
+		case RecordGrantServiceListRecordGrantsProcedure:
+			recordGrantServiceListRecordGrantsHandler.ServeHTTP(w, r)
+		case RecordGrantServiceDeleteRecordGrantProcedure:
+			recordGrantServiceDeleteRecordGrantHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRecordGrantServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRecordGrantServiceHandler struct{}
+
+func (UnimplementedRecordGrantServiceHandler) CreateRecordGrant(context.Context, *connect.Request[v1.CreateRecordGrantRequest]) (*connect.Response[v1.CreateRecordGrantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grant.v1.RecordGrantService.CreateRecordGrant is not implemented"))
+}
+
+func (UnimplementedRecordGrantServiceHandler) ListRecordGrants(context.Context, *connect.Request[v1.ListRecordGrantsRequest]) (*connect.Response[v1.ListRecordGrantsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grant.v1.RecordGrantService.ListRecordGrants is not implemented"))
+}
+
+func (UnimplementedRecordGrantServiceHandler) DeleteRecordGrant(context.Context, *connect.Request[v1.DeleteRecordGrantRequest]) (*connect.Response[v1.DeleteRecordGrantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("grant.v1.RecordGrantService.DeleteRecordGrant is not implemented"))
+}
@@ -0,0 +1,1119 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: mfa/v1/mfa.proto
+
+package mfav1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WebAuthnCredential struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    string                 `protobuf:"bytes,4,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebAuthnCredential) Reset() {
+	*x = WebAuthnCredential{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebAuthnCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebAuthnCredential) ProtoMessage() {}
+
+func (x *WebAuthnCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebAuthnCredential.ProtoReflect.Descriptor instead.
+func (*WebAuthnCredential) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WebAuthnCredential) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebAuthnCredential) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebAuthnCredential) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WebAuthnCredential) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+type GetMFAStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMFAStatusRequest) Reset() {
+	*x = GetMFAStatusRequest{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMFAStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMFAStatusRequest) ProtoMessage() {}
+
+func (x *GetMFAStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMFAStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetMFAStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{1}
+}
+
+type GetMFAStatusResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	TotpEnabled            bool                   `protobuf:"varint,1,opt,name=totp_enabled,json=totpEnabled,proto3" json:"totp_enabled,omitempty"`
+	WebauthnCredentials    []*WebAuthnCredential  `protobuf:"bytes,2,rep,name=webauthn_credentials,json=webauthnCredentials,proto3" json:"webauthn_credentials,omitempty"`
+	RecoveryCodesRemaining int32                  `protobuf:"varint,3,opt,name=recovery_codes_remaining,json=recoveryCodesRemaining,proto3" json:"recovery_codes_remaining,omitempty"`
+	WebauthnAvailable      bool                   `protobuf:"varint,4,opt,name=webauthn_available,json=webauthnAvailable,proto3" json:"webauthn_available,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetMFAStatusResponse) Reset() {
+	*x = GetMFAStatusResponse{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMFAStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMFAStatusResponse) ProtoMessage() {}
+
+func (x *GetMFAStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMFAStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetMFAStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetMFAStatusResponse) GetTotpEnabled() bool {
+	if x != nil {
+		return x.TotpEnabled
+	}
+	return false
+}
+
+func (x *GetMFAStatusResponse) GetWebauthnCredentials() []*WebAuthnCredential {
+	if x != nil {
+		return x.WebauthnCredentials
+	}
+	return nil
+}
+
+func (x *GetMFAStatusResponse) GetRecoveryCodesRemaining() int32 {
+	if x != nil {
+		return x.RecoveryCodesRemaining
+	}
+	return 0
+}
+
+func (x *GetMFAStatusResponse) GetWebauthnAvailable() bool {
+	if x != nil {
+		return x.WebauthnAvailable
+	}
+	return false
+}
+
+type BeginTOTPEnrollmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginTOTPEnrollmentRequest) Reset() {
+	*x = BeginTOTPEnrollmentRequest{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginTOTPEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginTOTPEnrollmentRequest) ProtoMessage() {}
+
+func (x *BeginTOTPEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginTOTPEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*BeginTOTPEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{3}
+}
+
+type BeginTOTPEnrollmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtpauthUrl    string                 `protobuf:"bytes,2,opt,name=otpauth_url,json=otpauthUrl,proto3" json:"otpauth_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginTOTPEnrollmentResponse) Reset() {
+	*x = BeginTOTPEnrollmentResponse{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginTOTPEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginTOTPEnrollmentResponse) ProtoMessage() {}
+
+func (x *BeginTOTPEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginTOTPEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*BeginTOTPEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BeginTOTPEnrollmentResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *BeginTOTPEnrollmentResponse) GetOtpauthUrl() string {
+	if x != nil {
+		return x.OtpauthUrl
+	}
+	return ""
+}
+
+type ConfirmTOTPEnrollmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPEnrollmentRequest) Reset() {
+	*x = ConfirmTOTPEnrollmentRequest{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPEnrollmentRequest) ProtoMessage() {}
+
+func (x *ConfirmTOTPEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ConfirmTOTPEnrollmentRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmTOTPEnrollmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPEnrollmentResponse) Reset() {
+	*x = ConfirmTOTPEnrollmentResponse{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPEnrollmentResponse) ProtoMessage() {}
+
+func (x *ConfirmTOTPEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ConfirmTOTPEnrollmentResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type DisableTOTPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTOTPRequest) Reset() {
+	*x = DisableTOTPRequest{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTOTPRequest) ProtoMessage() {}
+
+func (x *DisableTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTOTPRequest.ProtoReflect.Descriptor instead.
+func (*DisableTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{7}
+}
+
+type DisableTOTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTOTPResponse) Reset() {
+	*x = DisableTOTPResponse{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTOTPResponse) ProtoMessage() {}
+
+func (x *DisableTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTOTPResponse.ProtoReflect.Descriptor instead.
+func (*DisableTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{8}
+}
+
+type BeginWebAuthnRegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginWebAuthnRegistrationRequest) Reset() {
+	*x = BeginWebAuthnRegistrationRequest{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginWebAuthnRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginWebAuthnRegistrationRequest) ProtoMessage() {}
+
+func (x *BeginWebAuthnRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginWebAuthnRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*BeginWebAuthnRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{9}
+}
+
+type BeginWebAuthnRegistrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OptionsJson   string                 `protobuf:"bytes,1,opt,name=options_json,json=optionsJson,proto3" json:"options_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginWebAuthnRegistrationResponse) Reset() {
+	*x = BeginWebAuthnRegistrationResponse{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginWebAuthnRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginWebAuthnRegistrationResponse) ProtoMessage() {}
+
+func (x *BeginWebAuthnRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginWebAuthnRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*BeginWebAuthnRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BeginWebAuthnRegistrationResponse) GetOptionsJson() string {
+	if x != nil {
+		return x.OptionsJson
+	}
+	return ""
+}
+
+type FinishWebAuthnRegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ResponseJson  string                 `protobuf:"bytes,2,opt,name=response_json,json=responseJson,proto3" json:"response_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishWebAuthnRegistrationRequest) Reset() {
+	*x = FinishWebAuthnRegistrationRequest{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishWebAuthnRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishWebAuthnRegistrationRequest) ProtoMessage() {}
+
+func (x *FinishWebAuthnRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishWebAuthnRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*FinishWebAuthnRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FinishWebAuthnRegistrationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FinishWebAuthnRegistrationRequest) GetResponseJson() string {
+	if x != nil {
+		return x.ResponseJson
+	}
+	return ""
+}
+
+type FinishWebAuthnRegistrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Credential    *WebAuthnCredential    `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+	RecoveryCodes []string               `protobuf:"bytes,2,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishWebAuthnRegistrationResponse) Reset() {
+	*x = FinishWebAuthnRegistrationResponse{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishWebAuthnRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishWebAuthnRegistrationResponse) ProtoMessage() {}
+
+func (x *FinishWebAuthnRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishWebAuthnRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*FinishWebAuthnRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FinishWebAuthnRegistrationResponse) GetCredential() *WebAuthnCredential {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+func (x *FinishWebAuthnRegistrationResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type DeleteWebAuthnCredentialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebAuthnCredentialRequest) Reset() {
+	*x = DeleteWebAuthnCredentialRequest{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebAuthnCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebAuthnCredentialRequest) ProtoMessage() {}
+
+func (x *DeleteWebAuthnCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebAuthnCredentialRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebAuthnCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteWebAuthnCredentialRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteWebAuthnCredentialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebAuthnCredentialResponse) Reset() {
+	*x = DeleteWebAuthnCredentialResponse{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebAuthnCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebAuthnCredentialResponse) ProtoMessage() {}
+
+func (x *DeleteWebAuthnCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebAuthnCredentialResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebAuthnCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{14}
+}
+
+type RegenerateRecoveryCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateRecoveryCodesRequest) Reset() {
+	*x = RegenerateRecoveryCodesRequest{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateRecoveryCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesRequest) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesRequest) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{15}
+}
+
+type RegenerateRecoveryCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateRecoveryCodesResponse) Reset() {
+	*x = RegenerateRecoveryCodesResponse{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateRecoveryCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesResponse) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesResponse) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RegenerateRecoveryCodesResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type BeginStepUpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginStepUpRequest) Reset() {
+	*x = BeginStepUpRequest{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginStepUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginStepUpRequest) ProtoMessage() {}
+
+func (x *BeginStepUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginStepUpRequest.ProtoReflect.Descriptor instead.
+func (*BeginStepUpRequest) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{17}
+}
+
+type BeginStepUpResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	WebauthnOptionsJson string                 `protobuf:"bytes,1,opt,name=webauthn_options_json,json=webauthnOptionsJson,proto3" json:"webauthn_options_json,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *BeginStepUpResponse) Reset() {
+	*x = BeginStepUpResponse{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginStepUpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginStepUpResponse) ProtoMessage() {}
+
+func (x *BeginStepUpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginStepUpResponse.ProtoReflect.Descriptor instead.
+func (*BeginStepUpResponse) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BeginStepUpResponse) GetWebauthnOptionsJson() string {
+	if x != nil {
+		return x.WebauthnOptionsJson
+	}
+	return ""
+}
+
+type StepUpRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TotpCode             string                 `protobuf:"bytes,1,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	RecoveryCode         string                 `protobuf:"bytes,2,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
+	WebauthnResponseJson string                 `protobuf:"bytes,3,opt,name=webauthn_response_json,json=webauthnResponseJson,proto3" json:"webauthn_response_json,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *StepUpRequest) Reset() {
+	*x = StepUpRequest{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepUpRequest) ProtoMessage() {}
+
+func (x *StepUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepUpRequest.ProtoReflect.Descriptor instead.
+func (*StepUpRequest) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StepUpRequest) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+func (x *StepUpRequest) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+func (x *StepUpRequest) GetWebauthnResponseJson() string {
+	if x != nil {
+		return x.WebauthnResponseJson
+	}
+	return ""
+}
+
+type StepUpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StepUpResponse) Reset() {
+	*x = StepUpResponse{}
+	mi := &file_mfa_v1_mfa_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepUpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepUpResponse) ProtoMessage() {}
+
+func (x *StepUpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mfa_v1_mfa_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepUpResponse.ProtoReflect.Descriptor instead.
+func (*StepUpResponse) Descriptor() ([]byte, []int) {
+	return file_mfa_v1_mfa_proto_rawDescGZIP(), []int{20}
+}
+
+var File_mfa_v1_mfa_proto protoreflect.FileDescriptor
+
+const file_mfa_v1_mfa_proto_rawDesc = "" +
+	"\n" +
+	"\x10mfa/v1/mfa.proto\x12\x06mfa.v1\"y\n" +
+	"\x12WebAuthnCredential\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12 \n" +
+	"\flast_used_at\x18\x04 \x01(\tR\n" +
+	"lastUsedAt\"\x15\n" +
+	"\x13GetMFAStatusRequest\"\xf1\x01\n" +
+	"\x14GetMFAStatusResponse\x12!\n" +
+	"\ftotp_enabled\x18\x01 \x01(\bR\vtotpEnabled\x12M\n" +
+	"\x14webauthn_credentials\x18\x02 \x03(\v2\x1a.mfa.v1.WebAuthnCredentialR\x13webauthnCredentials\x128\n" +
+	"\x18recovery_codes_remaining\x18\x03 \x01(\x05R\x16recoveryCodesRemaining\x12-\n" +
+	"\x12webauthn_available\x18\x04 \x01(\bR\x11webauthnAvailable\"\x1c\n" +
+	"\x1aBeginTOTPEnrollmentRequest\"V\n" +
+	"\x1bBeginTOTPEnrollmentResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1f\n" +
+	"\votpauth_url\x18\x02 \x01(\tR\n" +
+	"otpauthUrl\"2\n" +
+	"\x1cConfirmTOTPEnrollmentRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"F\n" +
+	"\x1dConfirmTOTPEnrollmentResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"\x14\n" +
+	"\x12DisableTOTPRequest\"\x15\n" +
+	"\x13DisableTOTPResponse\"\"\n" +
+	" BeginWebAuthnRegistrationRequest\"F\n" +
+	"!BeginWebAuthnRegistrationResponse\x12!\n" +
+	"\foptions_json\x18\x01 \x01(\tR\voptionsJson\"\\\n" +
+	"!FinishWebAuthnRegistrationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rresponse_json\x18\x02 \x01(\tR\fresponseJson\"\x87\x01\n" +
+	"\"FinishWebAuthnRegistrationResponse\x12:\n" +
+	"\n" +
+	"credential\x18\x01 \x01(\v2\x1a.mfa.v1.WebAuthnCredentialR\n" +
+	"credential\x12%\n" +
+	"\x0erecovery_codes\x18\x02 \x03(\tR\rrecoveryCodes\"1\n" +
+	"\x1fDeleteWebAuthnCredentialRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\"\n" +
+	" DeleteWebAuthnCredentialResponse\" \n" +
+	"\x1eRegenerateRecoveryCodesRequest\"H\n" +
+	"\x1fRegenerateRecoveryCodesResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"\x14\n" +
+	"\x12BeginStepUpRequest\"I\n" +
+	"\x13BeginStepUpResponse\x122\n" +
+	"\x15webauthn_options_json\x18\x01 \x01(\tR\x13webauthnOptionsJson\"\x87\x01\n" +
+	"\rStepUpRequest\x12\x1b\n" +
+	"\ttotp_code\x18\x01 \x01(\tR\btotpCode\x12#\n" +
+	"\rrecovery_code\x18\x02 \x01(\tR\frecoveryCode\x124\n" +
+	"\x16webauthn_response_json\x18\x03 \x01(\tR\x14webauthnResponseJson\"\x10\n" +
+	"\x0eStepUpResponse2\xbc\a\n" +
+	"\n" +
+	"MFAService\x12K\n" +
+	"\fGetMFAStatus\x12\x1b.mfa.v1.GetMFAStatusRequest\x1a\x1c.mfa.v1.GetMFAStatusResponse\"\x00\x12`\n" +
+	"\x13BeginTOTPEnrollment\x12\".mfa.v1.BeginTOTPEnrollmentRequest\x1a#.mfa.v1.BeginTOTPEnrollmentResponse\"\x00\x12f\n" +
+	"\x15ConfirmTOTPEnrollment\x12$.mfa.v1.ConfirmTOTPEnrollmentRequest\x1a%.mfa.v1.ConfirmTOTPEnrollmentResponse\"\x00\x12H\n" +
+	"\vDisableTOTP\x12\x1a.mfa.v1.DisableTOTPRequest\x1a\x1b.mfa.v1.DisableTOTPResponse\"\x00\x12r\n" +
+	"\x19BeginWebAuthnRegistration\x12(.mfa.v1.BeginWebAuthnRegistrationRequest\x1a).mfa.v1.BeginWebAuthnRegistrationResponse\"\x00\x12u\n" +
+	"\x1aFinishWebAuthnRegistration\x12).mfa.v1.FinishWebAuthnRegistrationRequest\x1a*.mfa.v1.FinishWebAuthnRegistrationResponse\"\x00\x12o\n" +
+	"\x18DeleteWebAuthnCredential\x12'.mfa.v1.DeleteWebAuthnCredentialRequest\x1a(.mfa.v1.DeleteWebAuthnCredentialResponse\"\x00\x12l\n" +
+	"\x17RegenerateRecoveryCodes\x12&.mfa.v1.RegenerateRecoveryCodesRequest\x1a'.mfa.v1.RegenerateRecoveryCodesResponse\"\x00\x12H\n" +
+	"\vBeginStepUp\x12\x1a.mfa.v1.BeginStepUpRequest\x1a\x1b.mfa.v1.BeginStepUpResponse\"\x00\x129\n" +
+	"\x06StepUp\x12\x15.mfa.v1.StepUpRequest\x1a\x16.mfa.v1.StepUpResponse\"\x00B\x19Z\x17dnsarc/gen/mfa/v1;mfav1b\x06proto3"
+
+var (
+	file_mfa_v1_mfa_proto_rawDescOnce sync.Once
+	file_mfa_v1_mfa_proto_rawDescData []byte
+)
+
+func file_mfa_v1_mfa_proto_rawDescGZIP() []byte {
+	file_mfa_v1_mfa_proto_rawDescOnce.Do(func() {
+		file_mfa_v1_mfa_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mfa_v1_mfa_proto_rawDesc), len(file_mfa_v1_mfa_proto_rawDesc)))
+	})
+	return file_mfa_v1_mfa_proto_rawDescData
+}
+
+var file_mfa_v1_mfa_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_mfa_v1_mfa_proto_goTypes = []any{
+	(*WebAuthnCredential)(nil),                 // 0: mfa.v1.WebAuthnCredential
+	(*GetMFAStatusRequest)(nil),                // 1: mfa.v1.GetMFAStatusRequest
+	(*GetMFAStatusResponse)(nil),               // 2: mfa.v1.GetMFAStatusResponse
+	(*BeginTOTPEnrollmentRequest)(nil),         // 3: mfa.v1.BeginTOTPEnrollmentRequest
+	(*BeginTOTPEnrollmentResponse)(nil),        // 4: mfa.v1.BeginTOTPEnrollmentResponse
+	(*ConfirmTOTPEnrollmentRequest)(nil),       // 5: mfa.v1.ConfirmTOTPEnrollmentRequest
+	(*ConfirmTOTPEnrollmentResponse)(nil),      // 6: mfa.v1.ConfirmTOTPEnrollmentResponse
+	(*DisableTOTPRequest)(nil),                 // 7: mfa.v1.DisableTOTPRequest
+	(*DisableTOTPResponse)(nil),                // 8: mfa.v1.DisableTOTPResponse
+	(*BeginWebAuthnRegistrationRequest)(nil),   // 9: mfa.v1.BeginWebAuthnRegistrationRequest
+	(*BeginWebAuthnRegistrationResponse)(nil),  // 10: mfa.v1.BeginWebAuthnRegistrationResponse
+	(*FinishWebAuthnRegistrationRequest)(nil),  // 11: mfa.v1.FinishWebAuthnRegistrationRequest
+	(*FinishWebAuthnRegistrationResponse)(nil), // 12: mfa.v1.FinishWebAuthnRegistrationResponse
+	(*DeleteWebAuthnCredentialRequest)(nil),    // 13: mfa.v1.DeleteWebAuthnCredentialRequest
+	(*DeleteWebAuthnCredentialResponse)(nil),   // 14: mfa.v1.DeleteWebAuthnCredentialResponse
+	(*RegenerateRecoveryCodesRequest)(nil),     // 15: mfa.v1.RegenerateRecoveryCodesRequest
+	(*RegenerateRecoveryCodesResponse)(nil),    // 16: mfa.v1.RegenerateRecoveryCodesResponse
+	(*BeginStepUpRequest)(nil),                 // 17: mfa.v1.BeginStepUpRequest
+	(*BeginStepUpResponse)(nil),                // 18: mfa.v1.BeginStepUpResponse
+	(*StepUpRequest)(nil),                      // 19: mfa.v1.StepUpRequest
+	(*StepUpResponse)(nil),                     // 20: mfa.v1.StepUpResponse
+}
+var file_mfa_v1_mfa_proto_depIdxs = []int32{
+	0,  // 0: mfa.v1.GetMFAStatusResponse.webauthn_credentials:type_name -> mfa.v1.WebAuthnCredential
+	0,  // 1: mfa.v1.FinishWebAuthnRegistrationResponse.credential:type_name -> mfa.v1.WebAuthnCredential
+	1,  // 2: mfa.v1.MFAService.GetMFAStatus:input_type -> mfa.v1.GetMFAStatusRequest
+	3,  // 3: mfa.v1.MFAService.BeginTOTPEnrollment:input_type -> mfa.v1.BeginTOTPEnrollmentRequest
+	5,  // 4: mfa.v1.MFAService.ConfirmTOTPEnrollment:input_type -> mfa.v1.ConfirmTOTPEnrollmentRequest
+	7,  // 5: mfa.v1.MFAService.DisableTOTP:input_type -> mfa.v1.DisableTOTPRequest
+	9,  // 6: mfa.v1.MFAService.BeginWebAuthnRegistration:input_type -> mfa.v1.BeginWebAuthnRegistrationRequest
+	11, // 7: mfa.v1.MFAService.FinishWebAuthnRegistration:input_type -> mfa.v1.FinishWebAuthnRegistrationRequest
+	13, // 8: mfa.v1.MFAService.DeleteWebAuthnCredential:input_type -> mfa.v1.DeleteWebAuthnCredentialRequest
+	15, // 9: mfa.v1.MFAService.RegenerateRecoveryCodes:input_type -> mfa.v1.RegenerateRecoveryCodesRequest
+	17, // 10: mfa.v1.MFAService.BeginStepUp:input_type -> mfa.v1.BeginStepUpRequest
+	19, // 11: mfa.v1.MFAService.StepUp:input_type -> mfa.v1.StepUpRequest
+	2,  // 12: mfa.v1.MFAService.GetMFAStatus:output_type -> mfa.v1.GetMFAStatusResponse
+	4,  // 13: mfa.v1.MFAService.BeginTOTPEnrollment:output_type -> mfa.v1.BeginTOTPEnrollmentResponse
+	6,  // 14: mfa.v1.MFAService.ConfirmTOTPEnrollment:output_type -> mfa.v1.ConfirmTOTPEnrollmentResponse
+	8,  // 15: mfa.v1.MFAService.DisableTOTP:output_type -> mfa.v1.DisableTOTPResponse
+	10, // 16: mfa.v1.MFAService.BeginWebAuthnRegistration:output_type -> mfa.v1.BeginWebAuthnRegistrationResponse
+	12, // 17: mfa.v1.MFAService.FinishWebAuthnRegistration:output_type -> mfa.v1.FinishWebAuthnRegistrationResponse
+	14, // 18: mfa.v1.MFAService.DeleteWebAuthnCredential:output_type -> mfa.v1.DeleteWebAuthnCredentialResponse
+	16, // 19: mfa.v1.MFAService.RegenerateRecoveryCodes:output_type -> mfa.v1.RegenerateRecoveryCodesResponse
+	18, // 20: mfa.v1.MFAService.BeginStepUp:output_type -> mfa.v1.BeginStepUpResponse
+	20, // 21: mfa.v1.MFAService.StepUp:output_type -> mfa.v1.StepUpResponse
+	12, // [12:22] is the sub-list for method output_type
+	2,  // [2:12] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_mfa_v1_mfa_proto_init() }
+func file_mfa_v1_mfa_proto_init() {
+	if File_mfa_v1_mfa_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mfa_v1_mfa_proto_rawDesc), len(file_mfa_v1_mfa_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_mfa_v1_mfa_proto_goTypes,
+		DependencyIndexes: file_mfa_v1_mfa_proto_depIdxs,
+		MessageInfos:      file_mfa_v1_mfa_proto_msgTypes,
+	}.Build()
+	File_mfa_v1_mfa_proto = out.File
+	file_mfa_v1_mfa_proto_goTypes = nil
+	file_mfa_v1_mfa_proto_depIdxs = nil
+}
@@ -0,0 +1,366 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: mfa/v1/mfa.proto
+
+package mfav1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	v1 "dnsarc/gen/mfa/v1"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// MFAServiceName is the fully-qualified name of the MFAService service.
+	MFAServiceName = "mfa.v1.MFAService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// MFAServiceGetMFAStatusProcedure is the fully-qualified name of the MFAService's GetMFAStatus RPC.
+	MFAServiceGetMFAStatusProcedure = "/mfa.v1.MFAService/GetMFAStatus"
+	// MFAServiceBeginTOTPEnrollmentProcedure is the fully-qualified name of the MFAService's
+	// BeginTOTPEnrollment RPC.
+	MFAServiceBeginTOTPEnrollmentProcedure = "/mfa.v1.MFAService/BeginTOTPEnrollment"
+	// MFAServiceConfirmTOTPEnrollmentProcedure is the fully-qualified name of the MFAService's
+	// ConfirmTOTPEnrollment RPC.
+	MFAServiceConfirmTOTPEnrollmentProcedure = "/mfa.v1.MFAService/ConfirmTOTPEnrollment"
+	// MFAServiceDisableTOTPProcedure is the fully-qualified name of the MFAService's DisableTOTP RPC.
+	MFAServiceDisableTOTPProcedure = "/mfa.v1.MFAService/DisableTOTP"
+	// MFAServiceBeginWebAuthnRegistrationProcedure is the fully-qualified name of the MFAService's
+	// BeginWebAuthnRegistration RPC.
+	MFAServiceBeginWebAuthnRegistrationProcedure = "/mfa.v1.MFAService/BeginWebAuthnRegistration"
+	// MFAServiceFinishWebAuthnRegistrationProcedure is the fully-qualified name of the MFAService's
+	// FinishWebAuthnRegistration RPC.
+	MFAServiceFinishWebAuthnRegistrationProcedure = "/mfa.v1.MFAService/FinishWebAuthnRegistration"
+	// MFAServiceDeleteWebAuthnCredentialProcedure is the fully-qualified name of the MFAService's
+	// DeleteWebAuthnCredential RPC.
+	MFAServiceDeleteWebAuthnCredentialProcedure = "/mfa.v1.MFAService/DeleteWebAuthnCredential"
+	// MFAServiceRegenerateRecoveryCodesProcedure is the fully-qualified name of the MFAService's
+	// RegenerateRecoveryCodes RPC.
+	MFAServiceRegenerateRecoveryCodesProcedure = "/mfa.v1.MFAService/RegenerateRecoveryCodes"
+	// MFAServiceBeginStepUpProcedure is the fully-qualified name of the MFAService's BeginStepUp RPC.
+	MFAServiceBeginStepUpProcedure = "/mfa.v1.MFAService/BeginStepUp"
+	// MFAServiceStepUpProcedure is the fully-qualified name of the MFAService's StepUp RPC.
+	MFAServiceStepUpProcedure = "/mfa.v1.MFAService/StepUp"
+)
+
+// MFAServiceClient is a client for the mfa.v1.MFAService service.
+type MFAServiceClient interface {
+	GetMFAStatus(context.Context, *connect.Request[v1.GetMFAStatusRequest]) (*connect.Response[v1.GetMFAStatusResponse], error)
+	BeginTOTPEnrollment(context.Context, *connect.Request[v1.BeginTOTPEnrollmentRequest]) (*connect.Response[v1.BeginTOTPEnrollmentResponse], error)
+	ConfirmTOTPEnrollment(context.Context, *connect.Request[v1.ConfirmTOTPEnrollmentRequest]) (*connect.Response[v1.ConfirmTOTPEnrollmentResponse], error)
+	DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error)
+	BeginWebAuthnRegistration(context.Context, *connect.Request[v1.BeginWebAuthnRegistrationRequest]) (*connect.Response[v1.BeginWebAuthnRegistrationResponse], error)
+	FinishWebAuthnRegistration(context.Context, *connect.Request[v1.FinishWebAuthnRegistrationRequest]) (*connect.Response[v1.FinishWebAuthnRegistrationResponse], error)
+	DeleteWebAuthnCredential(context.Context, *connect.Request[v1.DeleteWebAuthnCredentialRequest]) (*connect.Response[v1.DeleteWebAuthnCredentialResponse], error)
+	RegenerateRecoveryCodes(context.Context, *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error)
+	BeginStepUp(context.Context, *connect.Request[v1.BeginStepUpRequest]) (*connect.Response[v1.BeginStepUpResponse], error)
+	StepUp(context.Context, *connect.Request[v1.StepUpRequest]) (*connect.Response[v1.StepUpResponse], error)
+}
+
+// NewMFAServiceClient constructs a client for the mfa.v1.MFAService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMFAServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MFAServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	mFAServiceMethods := v1.File_mfa_v1_mfa_proto.Services().ByName("MFAService").Methods()
+	return &mFAServiceClient{
+		getMFAStatus: connect.NewClient[v1.GetMFAStatusRequest, v1.GetMFAStatusResponse](
+			httpClient,
+			baseURL+MFAServiceGetMFAStatusProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("GetMFAStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		beginTOTPEnrollment: connect.NewClient[v1.BeginTOTPEnrollmentRequest, v1.BeginTOTPEnrollmentResponse](
+			httpClient,
+			baseURL+MFAServiceBeginTOTPEnrollmentProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("BeginTOTPEnrollment")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmTOTPEnrollment: connect.NewClient[v1.ConfirmTOTPEnrollmentRequest, v1.ConfirmTOTPEnrollmentResponse](
+			httpClient,
+			baseURL+MFAServiceConfirmTOTPEnrollmentProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("ConfirmTOTPEnrollment")),
+			connect.WithClientOptions(opts...),
+		),
+		disableTOTP: connect.NewClient[v1.DisableTOTPRequest, v1.DisableTOTPResponse](
+			httpClient,
+			baseURL+MFAServiceDisableTOTPProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("DisableTOTP")),
+			connect.WithClientOptions(opts...),
+		),
+		beginWebAuthnRegistration: connect.NewClient[v1.BeginWebAuthnRegistrationRequest, v1.BeginWebAuthnRegistrationResponse](
+			httpClient,
+			baseURL+MFAServiceBeginWebAuthnRegistrationProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("BeginWebAuthnRegistration")),
+			connect.WithClientOptions(opts...),
+		),
+		finishWebAuthnRegistration: connect.NewClient[v1.FinishWebAuthnRegistrationRequest, v1.FinishWebAuthnRegistrationResponse](
+			httpClient,
+			baseURL+MFAServiceFinishWebAuthnRegistrationProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("FinishWebAuthnRegistration")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebAuthnCredential: connect.NewClient[v1.DeleteWebAuthnCredentialRequest, v1.DeleteWebAuthnCredentialResponse](
+			httpClient,
+			baseURL+MFAServiceDeleteWebAuthnCredentialProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("DeleteWebAuthnCredential")),
+			connect.WithClientOptions(opts...),
+		),
+		regenerateRecoveryCodes: connect.NewClient[v1.RegenerateRecoveryCodesRequest, v1.RegenerateRecoveryCodesResponse](
+			httpClient,
+			baseURL+MFAServiceRegenerateRecoveryCodesProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("RegenerateRecoveryCodes")),
+			connect.WithClientOptions(opts...),
+		),
+		beginStepUp: connect.NewClient[v1.BeginStepUpRequest, v1.BeginStepUpResponse](
+			httpClient,
+			baseURL+MFAServiceBeginStepUpProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("BeginStepUp")),
+			connect.WithClientOptions(opts...),
+		),
+		stepUp: connect.NewClient[v1.StepUpRequest, v1.StepUpResponse](
+			httpClient,
+			baseURL+MFAServiceStepUpProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("StepUp")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// mFAServiceClient implements MFAServiceClient.
+type mFAServiceClient struct {
+	getMFAStatus               *connect.Client[v1.GetMFAStatusRequest, v1.GetMFAStatusResponse]
+	beginTOTPEnrollment        *connect.Client[v1.BeginTOTPEnrollmentRequest, v1.BeginTOTPEnrollmentResponse]
+	confirmTOTPEnrollment      *connect.Client[v1.ConfirmTOTPEnrollmentRequest, v1.ConfirmTOTPEnrollmentResponse]
+	disableTOTP                *connect.Client[v1.DisableTOTPRequest, v1.DisableTOTPResponse]
+	beginWebAuthnRegistration  *connect.Client[v1.BeginWebAuthnRegistrationRequest, v1.BeginWebAuthnRegistrationResponse]
+	finishWebAuthnRegistration *connect.Client[v1.FinishWebAuthnRegistrationRequest, v1.FinishWebAuthnRegistrationResponse]
+	deleteWebAuthnCredential   *connect.Client[v1.DeleteWebAuthnCredentialRequest, v1.DeleteWebAuthnCredentialResponse]
+	regenerateRecoveryCodes    *connect.Client[v1.RegenerateRecoveryCodesRequest, v1.RegenerateRecoveryCodesResponse]
+	beginStepUp                *connect.Client[v1.BeginStepUpRequest, v1.BeginStepUpResponse]
+	stepUp                     *connect.Client[v1.StepUpRequest, v1.StepUpResponse]
+}
+
+// GetMFAStatus calls mfa.v1.MFAService.GetMFAStatus.
+func (c *mFAServiceClient) GetMFAStatus(ctx context.Context, req *connect.Request[v1.GetMFAStatusRequest]) (*connect.Response[v1.GetMFAStatusResponse], error) {
+	return c.getMFAStatus.CallUnary(ctx, req)
+}
+
+// BeginTOTPEnrollment calls mfa.v1.MFAService.BeginTOTPEnrollment.
+func (c *mFAServiceClient) BeginTOTPEnrollment(ctx context.Context, req *connect.Request[v1.BeginTOTPEnrollmentRequest]) (*connect.Response[v1.BeginTOTPEnrollmentResponse], error) {
+	return c.beginTOTPEnrollment.CallUnary(ctx, req)
+}
+
+// ConfirmTOTPEnrollment calls mfa.v1.MFAService.ConfirmTOTPEnrollment.
+func (c *mFAServiceClient) ConfirmTOTPEnrollment(ctx context.Context, req *connect.Request[v1.ConfirmTOTPEnrollmentRequest]) (*connect.Response[v1.ConfirmTOTPEnrollmentResponse], error) {
+	return c.confirmTOTPEnrollment.CallUnary(ctx, req)
+}
+
+// DisableTOTP calls mfa.v1.MFAService.DisableTOTP.
+func (c *mFAServiceClient) DisableTOTP(ctx context.Context, req *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error) {
+	return c.disableTOTP.CallUnary(ctx, req)
+}
+
+// BeginWebAuthnRegistration calls mfa.v1.MFAService.BeginWebAuthnRegistration.
+func (c *mFAServiceClient) BeginWebAuthnRegistration(ctx context.Context, req *connect.Request[v1.BeginWebAuthnRegistrationRequest]) (*connect.Response[v1.BeginWebAuthnRegistrationResponse], error) {
+	return c.beginWebAuthnRegistration.CallUnary(ctx, req)
+}
+
+// FinishWebAuthnRegistration calls mfa.v1.MFAService.FinishWebAuthnRegistration.
+func (c *mFAServiceClient) FinishWebAuthnRegistration(ctx context.Context, req *connect.Request[v1.FinishWebAuthnRegistrationRequest]) (*connect.Response[v1.FinishWebAuthnRegistrationResponse], error) {
+	return c.finishWebAuthnRegistration.CallUnary(ctx, req)
+}
+
+// DeleteWebAuthnCredential calls mfa.v1.MFAService.DeleteWebAuthnCredential.
+func (c *mFAServiceClient) DeleteWebAuthnCredential(ctx context.Context, req *connect.Request[v1.DeleteWebAuthnCredentialRequest]) (*connect.Response[v1.DeleteWebAuthnCredentialResponse], error) {
+	return c.deleteWebAuthnCredential.CallUnary(ctx, req)
+}
+
+// RegenerateRecoveryCodes calls mfa.v1.MFAService.RegenerateRecoveryCodes.
+func (c *mFAServiceClient) RegenerateRecoveryCodes(ctx context.Context, req *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error) {
+	return c.regenerateRecoveryCodes.CallUnary(ctx, req)
+}
+
+// BeginStepUp calls mfa.v1.MFAService.BeginStepUp.
+func (c *mFAServiceClient) BeginStepUp(ctx context.Context, req *connect.Request[v1.BeginStepUpRequest]) (*connect.Response[v1.BeginStepUpResponse], error) {
+	return c.beginStepUp.CallUnary(ctx, req)
+}
+
+// StepUp calls mfa.v1.MFAService.StepUp.
+func (c *mFAServiceClient) StepUp(ctx context.Context, req *connect.Request[v1.StepUpRequest]) (*connect.Response[v1.StepUpResponse], error) {
+	return c.stepUp.CallUnary(ctx, req)
+}
+
+// MFAServiceHandler is an implementation of the mfa.v1.MFAService service.
+type MFAServiceHandler interface {
+	GetMFAStatus(context.Context, *connect.Request[v1.GetMFAStatusRequest]) (*connect.Response[v1.GetMFAStatusResponse], error)
+	BeginTOTPEnrollment(context.Context, *connect.Request[v1.BeginTOTPEnrollmentRequest]) (*connect.Response[v1.BeginTOTPEnrollmentResponse], error)
+	ConfirmTOTPEnrollment(context.Context, *connect.Request[v1.ConfirmTOTPEnrollmentRequest]) (*connect.Response[v1.ConfirmTOTPEnrollmentResponse], error)
+	DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error)
+	BeginWebAuthnRegistration(context.Context, *connect.Request[v1.BeginWebAuthnRegistrationRequest]) (*connect.Response[v1.BeginWebAuthnRegistrationResponse], error)
+	FinishWebAuthnRegistration(context.Context, *connect.Request[v1.FinishWebAuthnRegistrationRequest]) (*connect.Response[v1.FinishWebAuthnRegistrationResponse], error)
+	DeleteWebAuthnCredential(context.Context, *connect.Request[v1.DeleteWebAuthnCredentialRequest]) (*connect.Response[v1.DeleteWebAuthnCredentialResponse], error)
+	RegenerateRecoveryCodes(context.Context, *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error)
+	BeginStepUp(context.Context, *connect.Request[v1.BeginStepUpRequest]) (*connect.Response[v1.BeginStepUpResponse], error)
+	StepUp(context.Context, *connect.Request[v1.StepUpRequest]) (*connect.Response[v1.StepUpResponse], error)
+}
+
+// NewMFAServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMFAServiceHandler(svc MFAServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	mFAServiceMethods := v1.File_mfa_v1_mfa_proto.Services().ByName("MFAService").Methods()
+	mFAServiceGetMFAStatusHandler := connect.NewUnaryHandler(
+		MFAServiceGetMFAStatusProcedure,
+		svc.GetMFAStatus,
+		connect.WithSchema(mFAServiceMethods.ByName("GetMFAStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mFAServiceBeginTOTPEnrollmentHandler := connect.NewUnaryHandler(
+		MFAServiceBeginTOTPEnrollmentProcedure,
+		svc.BeginTOTPEnrollment,
+		connect.WithSchema(mFAServiceMethods.ByName("BeginTOTPEnrollment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mFAServiceConfirmTOTPEnrollmentHandler := connect.NewUnaryHandler(
+		MFAServiceConfirmTOTPEnrollmentProcedure,
+		svc.ConfirmTOTPEnrollment,
+		connect.WithSchema(mFAServiceMethods.ByName("ConfirmTOTPEnrollment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mFAServiceDisableTOTPHandler := connect.NewUnaryHandler(
+		MFAServiceDisableTOTPProcedure,
+		svc.DisableTOTP,
+		connect.WithSchema(mFAServiceMethods.ByName("DisableTOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mFAServiceBeginWebAuthnRegistrationHandler := connect.NewUnaryHandler(
+		MFAServiceBeginWebAuthnRegistrationProcedure,
+		svc.BeginWebAuthnRegistration,
+		connect.WithSchema(mFAServiceMethods.ByName("BeginWebAuthnRegistration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mFAServiceFinishWebAuthnRegistrationHandler := connect.NewUnaryHandler(
+		MFAServiceFinishWebAuthnRegistrationProcedure,
+		svc.FinishWebAuthnRegistration,
+		connect.WithSchema(mFAServiceMethods.ByName("FinishWebAuthnRegistration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mFAServiceDeleteWebAuthnCredentialHandler := connect.NewUnaryHandler(
+		MFAServiceDeleteWebAuthnCredentialProcedure,
+		svc.DeleteWebAuthnCredential,
+		connect.WithSchema(mFAServiceMethods.ByName("DeleteWebAuthnCredential")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mFAServiceRegenerateRecoveryCodesHandler := connect.NewUnaryHandler(
+		MFAServiceRegenerateRecoveryCodesProcedure,
+		svc.RegenerateRecoveryCodes,
+		connect.WithSchema(mFAServiceMethods.ByName("RegenerateRecoveryCodes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mFAServiceBeginStepUpHandler := connect.NewUnaryHandler(
+		MFAServiceBeginStepUpProcedure,
+		svc.BeginStepUp,
+		connect.WithSchema(mFAServiceMethods.ByName("BeginStepUp")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mFAServiceStepUpHandler := connect.NewUnaryHandler(
+		MFAServiceStepUpProcedure,
+		svc.StepUp,
+		connect.WithSchema(mFAServiceMethods.ByName("StepUp")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mfa.v1.MFAService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MFAServiceGetMFAStatusProcedure:
+			mFAServiceGetMFAStatusHandler.ServeHTTP(w, r)
+		case MFAServiceBeginTOTPEnrollmentProcedure:
+			mFAServiceBeginTOTPEnrollmentHandler.ServeHTTP(w, r)
+		case MFAServiceConfirmTOTPEnrollmentProcedure:
+			mFAServiceConfirmTOTPEnrollmentHandler.ServeHTTP(w, r)
+		case MFAServiceDisableTOTPProcedure:
+			mFAServiceDisableTOTPHandler.ServeHTTP(w, r)
+		case MFAServiceBeginWebAuthnRegistrationProcedure:
+			mFAServiceBeginWebAuthnRegistrationHandler.ServeHTTP(w, r)
+		case MFAServiceFinishWebAuthnRegistrationProcedure:
+			mFAServiceFinishWebAuthnRegistrationHandler.ServeHTTP(w, r)
+		case MFAServiceDeleteWebAuthnCredentialProcedure:
+			mFAServiceDeleteWebAuthnCredentialHandler.ServeHTTP(w, r)
+		case MFAServiceRegenerateRecoveryCodesProcedure:
+			mFAServiceRegenerateRecoveryCodesHandler.ServeHTTP(w, r)
+		case MFAServiceBeginStepUpProcedure:
+			mFAServiceBeginStepUpHandler.ServeHTTP(w, r)
+		case MFAServiceStepUpProcedure:
+			mFAServiceStepUpHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMFAServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMFAServiceHandler struct{}
+
+func (UnimplementedMFAServiceHandler) GetMFAStatus(context.Context, *connect.Request[v1.GetMFAStatusRequest]) (*connect.Response[v1.GetMFAStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mfa.v1.MFAService.GetMFAStatus is not implemented"))
+}
+
+func (UnimplementedMFAServiceHandler) BeginTOTPEnrollment(context.Context, *connect.Request[v1.BeginTOTPEnrollmentRequest]) (*connect.Response[v1.BeginTOTPEnrollmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mfa.v1.MFAService.BeginTOTPEnrollment is not implemented"))
+}
+
+func (UnimplementedMFAServiceHandler) ConfirmTOTPEnrollment(context.Context, *connect.Request[v1.ConfirmTOTPEnrollmentRequest]) (*connect.Response[v1.ConfirmTOTPEnrollmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mfa.v1.MFAService.ConfirmTOTPEnrollment is not implemented"))
+}
+
+func (UnimplementedMFAServiceHandler) DisableTOTP(context.Context, *connect.Request[v1.DisableTOTPRequest]) (*connect.Response[v1.DisableTOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mfa.v1.MFAService.DisableTOTP is not implemented"))
+}
+
+func (UnimplementedMFAServiceHandler) BeginWebAuthnRegistration(context.Context, *connect.Request[v1.BeginWebAuthnRegistrationRequest]) (*connect.Response[v1.BeginWebAuthnRegistrationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mfa.v1.MFAService.BeginWebAuthnRegistration is not implemented"))
+}
+
+func (UnimplementedMFAServiceHandler) FinishWebAuthnRegistration(context.Context, *connect.Request[v1.FinishWebAuthnRegistrationRequest]) (*connect.Response[v1.FinishWebAuthnRegistrationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mfa.v1.MFAService.FinishWebAuthnRegistration is not implemented"))
+}
+
+func (UnimplementedMFAServiceHandler) DeleteWebAuthnCredential(context.Context, *connect.Request[v1.DeleteWebAuthnCredentialRequest]) (*connect.Response[v1.DeleteWebAuthnCredentialResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mfa.v1.MFAService.DeleteWebAuthnCredential is not implemented"))
+}
+
+func (UnimplementedMFAServiceHandler) RegenerateRecoveryCodes(context.Context, *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mfa.v1.MFAService.RegenerateRecoveryCodes is not implemented"))
+}
+
+func (UnimplementedMFAServiceHandler) BeginStepUp(context.Context, *connect.Request[v1.BeginStepUpRequest]) (*connect.Response[v1.BeginStepUpResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mfa.v1.MFAService.BeginStepUp is not implemented"))
+}
+
+func (UnimplementedMFAServiceHandler) StepUp(context.Context, *connect.Request[v1.StepUpRequest]) (*connect.Response[v1.StepUpResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mfa.v1.MFAService.StepUp is not implemented"))
+}
@@ -21,11 +21,70 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ZoneStatus int32
+
+const (
+	ZoneStatus_ZONE_STATUS_UNSPECIFIED ZoneStatus = 0
+	ZoneStatus_ZONE_STATUS_PENDING     ZoneStatus = 1
+	ZoneStatus_ZONE_STATUS_ACTIVE      ZoneStatus = 2
+	ZoneStatus_ZONE_STATUS_NS_MISMATCH ZoneStatus = 3
+	ZoneStatus_ZONE_STATUS_DEACTIVATED ZoneStatus = 4
+	ZoneStatus_ZONE_STATUS_SUSPENDED   ZoneStatus = 5
+)
+
+// Enum value maps for ZoneStatus.
+var (
+	ZoneStatus_name = map[int32]string{
+		0: "ZONE_STATUS_UNSPECIFIED",
+		1: "ZONE_STATUS_PENDING",
+		2: "ZONE_STATUS_ACTIVE",
+		3: "ZONE_STATUS_NS_MISMATCH",
+		4: "ZONE_STATUS_DEACTIVATED",
+		5: "ZONE_STATUS_SUSPENDED",
+	}
+	ZoneStatus_value = map[string]int32{
+		"ZONE_STATUS_UNSPECIFIED": 0,
+		"ZONE_STATUS_PENDING":     1,
+		"ZONE_STATUS_ACTIVE":      2,
+		"ZONE_STATUS_NS_MISMATCH": 3,
+		"ZONE_STATUS_DEACTIVATED": 4,
+		"ZONE_STATUS_SUSPENDED":   5,
+	}
+)
+
+func (x ZoneStatus) Enum() *ZoneStatus {
+	p := new(ZoneStatus)
+	*p = x
+	return p
+}
+
+func (x ZoneStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ZoneStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_zone_v1_zone_proto_enumTypes[0].Descriptor()
+}
+
+func (ZoneStatus) Type() protoreflect.EnumType {
+	return &file_zone_v1_zone_proto_enumTypes[0]
+}
+
+func (x ZoneStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ZoneStatus.Descriptor instead.
+func (ZoneStatus) EnumDescriptor() ([]byte, []int) {
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{0}
+}
+
 type CreateZoneRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ZoneName      string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ZoneName       string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateZoneRequest) Reset() {
@@ -65,15 +124,33 @@ func (x *CreateZoneRequest) GetZoneName() string {
 	return ""
 }
 
+func (x *CreateZoneRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
 type Zone struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ZoneName      string                 `protobuf:"bytes,2,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
-	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ZoneName            string                 `protobuf:"bytes,2,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	IsActive            bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt           string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Nameservers         []string               `protobuf:"bytes,6,rep,name=nameservers,proto3" json:"nameservers,omitempty"`
+	SoaMbox             string                 `protobuf:"bytes,7,opt,name=soa_mbox,json=soaMbox,proto3" json:"soa_mbox,omitempty"`
+	VerificationToken   string                 `protobuf:"bytes,8,opt,name=verification_token,json=verificationToken,proto3" json:"verification_token,omitempty"`
+	VerificationRecord  string                 `protobuf:"bytes,9,opt,name=verification_record,json=verificationRecord,proto3" json:"verification_record,omitempty"`
+	VerifiedAt          string                 `protobuf:"bytes,10,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	Status              ZoneStatus             `protobuf:"varint,11,opt,name=status,proto3,enum=zone.v1.ZoneStatus" json:"status,omitempty"`
+	ObservedNameservers []string               `protobuf:"bytes,12,rep,name=observed_nameservers,json=observedNameservers,proto3" json:"observed_nameservers,omitempty"`
+	LastCheckedAt       string                 `protobuf:"bytes,13,opt,name=last_checked_at,json=lastCheckedAt,proto3" json:"last_checked_at,omitempty"`
+	LastCheckError      string                 `protobuf:"bytes,14,opt,name=last_check_error,json=lastCheckError,proto3" json:"last_check_error,omitempty"`
+	OrganizationId      string                 `protobuf:"bytes,15,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PendingNameservers  []string               `protobuf:"bytes,16,rep,name=pending_nameservers,json=pendingNameservers,proto3" json:"pending_nameservers,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Zone) Reset() {
@@ -141,6 +218,159 @@ func (x *Zone) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Zone) GetNameservers() []string {
+	if x != nil {
+		return x.Nameservers
+	}
+	return nil
+}
+
+func (x *Zone) GetSoaMbox() string {
+	if x != nil {
+		return x.SoaMbox
+	}
+	return ""
+}
+
+func (x *Zone) GetVerificationToken() string {
+	if x != nil {
+		return x.VerificationToken
+	}
+	return ""
+}
+
+func (x *Zone) GetVerificationRecord() string {
+	if x != nil {
+		return x.VerificationRecord
+	}
+	return ""
+}
+
+func (x *Zone) GetVerifiedAt() string {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return ""
+}
+
+func (x *Zone) GetStatus() ZoneStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ZoneStatus_ZONE_STATUS_UNSPECIFIED
+}
+
+func (x *Zone) GetObservedNameservers() []string {
+	if x != nil {
+		return x.ObservedNameservers
+	}
+	return nil
+}
+
+func (x *Zone) GetLastCheckedAt() string {
+	if x != nil {
+		return x.LastCheckedAt
+	}
+	return ""
+}
+
+func (x *Zone) GetLastCheckError() string {
+	if x != nil {
+		return x.LastCheckError
+	}
+	return ""
+}
+
+func (x *Zone) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *Zone) GetPendingNameservers() []string {
+	if x != nil {
+		return x.PendingNameservers
+	}
+	return nil
+}
+
+type ZoneCheck struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status              ZoneStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=zone.v1.ZoneStatus" json:"status,omitempty"`
+	ObservedNameservers []string               `protobuf:"bytes,3,rep,name=observed_nameservers,json=observedNameservers,proto3" json:"observed_nameservers,omitempty"`
+	Error               string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	CheckedAt           string                 `protobuf:"bytes,5,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ZoneCheck) Reset() {
+	*x = ZoneCheck{}
+	mi := &file_zone_v1_zone_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneCheck) ProtoMessage() {}
+
+func (x *ZoneCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_zone_v1_zone_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneCheck.ProtoReflect.Descriptor instead.
+func (*ZoneCheck) Descriptor() ([]byte, []int) {
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ZoneCheck) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ZoneCheck) GetStatus() ZoneStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ZoneStatus_ZONE_STATUS_UNSPECIFIED
+}
+
+func (x *ZoneCheck) GetObservedNameservers() []string {
+	if x != nil {
+		return x.ObservedNameservers
+	}
+	return nil
+}
+
+func (x *ZoneCheck) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ZoneCheck) GetCheckedAt() string {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return ""
+}
+
 type CreateZoneResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Zone          *Zone                  `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
@@ -150,7 +380,7 @@ type CreateZoneResponse struct {
 
 func (x *CreateZoneResponse) Reset() {
 	*x = CreateZoneResponse{}
-	mi := &file_zone_v1_zone_proto_msgTypes[2]
+	mi := &file_zone_v1_zone_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -162,7 +392,7 @@ func (x *CreateZoneResponse) String() string {
 func (*CreateZoneResponse) ProtoMessage() {}
 
 func (x *CreateZoneResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zone_v1_zone_proto_msgTypes[2]
+	mi := &file_zone_v1_zone_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -175,7 +405,7 @@ func (x *CreateZoneResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateZoneResponse.ProtoReflect.Descriptor instead.
 func (*CreateZoneResponse) Descriptor() ([]byte, []int) {
-	return file_zone_v1_zone_proto_rawDescGZIP(), []int{2}
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateZoneResponse) GetZone() *Zone {
@@ -186,14 +416,15 @@ func (x *CreateZoneResponse) GetZone() *Zone {
 }
 
 type ListZonesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ListZonesRequest) Reset() {
 	*x = ListZonesRequest{}
-	mi := &file_zone_v1_zone_proto_msgTypes[3]
+	mi := &file_zone_v1_zone_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -205,7 +436,7 @@ func (x *ListZonesRequest) String() string {
 func (*ListZonesRequest) ProtoMessage() {}
 
 func (x *ListZonesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zone_v1_zone_proto_msgTypes[3]
+	mi := &file_zone_v1_zone_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -218,7 +449,14 @@ func (x *ListZonesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListZonesRequest.ProtoReflect.Descriptor instead.
 func (*ListZonesRequest) Descriptor() ([]byte, []int) {
-	return file_zone_v1_zone_proto_rawDescGZIP(), []int{3}
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListZonesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
 }
 
 type ListZonesResponse struct {
@@ -230,7 +468,7 @@ type ListZonesResponse struct {
 
 func (x *ListZonesResponse) Reset() {
 	*x = ListZonesResponse{}
-	mi := &file_zone_v1_zone_proto_msgTypes[4]
+	mi := &file_zone_v1_zone_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -242,7 +480,7 @@ func (x *ListZonesResponse) String() string {
 func (*ListZonesResponse) ProtoMessage() {}
 
 func (x *ListZonesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zone_v1_zone_proto_msgTypes[4]
+	mi := &file_zone_v1_zone_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -255,7 +493,7 @@ func (x *ListZonesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListZonesResponse.ProtoReflect.Descriptor instead.
 func (*ListZonesResponse) Descriptor() ([]byte, []int) {
-	return file_zone_v1_zone_proto_rawDescGZIP(), []int{4}
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListZonesResponse) GetZones() []*Zone {
@@ -274,7 +512,7 @@ type GetZoneRequest struct {
 
 func (x *GetZoneRequest) Reset() {
 	*x = GetZoneRequest{}
-	mi := &file_zone_v1_zone_proto_msgTypes[5]
+	mi := &file_zone_v1_zone_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -286,7 +524,7 @@ func (x *GetZoneRequest) String() string {
 func (*GetZoneRequest) ProtoMessage() {}
 
 func (x *GetZoneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zone_v1_zone_proto_msgTypes[5]
+	mi := &file_zone_v1_zone_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,7 +537,7 @@ func (x *GetZoneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetZoneRequest.ProtoReflect.Descriptor instead.
 func (*GetZoneRequest) Descriptor() ([]byte, []int) {
-	return file_zone_v1_zone_proto_rawDescGZIP(), []int{5}
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetZoneRequest) GetId() string {
@@ -318,7 +556,7 @@ type GetZoneResponse struct {
 
 func (x *GetZoneResponse) Reset() {
 	*x = GetZoneResponse{}
-	mi := &file_zone_v1_zone_proto_msgTypes[6]
+	mi := &file_zone_v1_zone_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -330,7 +568,7 @@ func (x *GetZoneResponse) String() string {
 func (*GetZoneResponse) ProtoMessage() {}
 
 func (x *GetZoneResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zone_v1_zone_proto_msgTypes[6]
+	mi := &file_zone_v1_zone_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +581,7 @@ func (x *GetZoneResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetZoneResponse.ProtoReflect.Descriptor instead.
 func (*GetZoneResponse) Descriptor() ([]byte, []int) {
-	return file_zone_v1_zone_proto_rawDescGZIP(), []int{6}
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetZoneResponse) GetZone() *Zone {
@@ -354,15 +592,16 @@ func (x *GetZoneResponse) GetZone() *Zone {
 }
 
 type GetZoneByNameRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ZoneName      string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ZoneName       string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetZoneByNameRequest) Reset() {
 	*x = GetZoneByNameRequest{}
-	mi := &file_zone_v1_zone_proto_msgTypes[7]
+	mi := &file_zone_v1_zone_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -374,7 +613,7 @@ func (x *GetZoneByNameRequest) String() string {
 func (*GetZoneByNameRequest) ProtoMessage() {}
 
 func (x *GetZoneByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zone_v1_zone_proto_msgTypes[7]
+	mi := &file_zone_v1_zone_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -387,7 +626,7 @@ func (x *GetZoneByNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetZoneByNameRequest.ProtoReflect.Descriptor instead.
 func (*GetZoneByNameRequest) Descriptor() ([]byte, []int) {
-	return file_zone_v1_zone_proto_rawDescGZIP(), []int{7}
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetZoneByNameRequest) GetZoneName() string {
@@ -397,6 +636,13 @@ func (x *GetZoneByNameRequest) GetZoneName() string {
 	return ""
 }
 
+func (x *GetZoneByNameRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
 type GetZoneByNameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Zone          *Zone                  `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
@@ -406,7 +652,7 @@ type GetZoneByNameResponse struct {
 
 func (x *GetZoneByNameResponse) Reset() {
 	*x = GetZoneByNameResponse{}
-	mi := &file_zone_v1_zone_proto_msgTypes[8]
+	mi := &file_zone_v1_zone_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -418,7 +664,7 @@ func (x *GetZoneByNameResponse) String() string {
 func (*GetZoneByNameResponse) ProtoMessage() {}
 
 func (x *GetZoneByNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zone_v1_zone_proto_msgTypes[8]
+	mi := &file_zone_v1_zone_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -431,7 +677,7 @@ func (x *GetZoneByNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetZoneByNameResponse.ProtoReflect.Descriptor instead.
 func (*GetZoneByNameResponse) Descriptor() ([]byte, []int) {
-	return file_zone_v1_zone_proto_rawDescGZIP(), []int{8}
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetZoneByNameResponse) GetZone() *Zone {
@@ -450,7 +696,7 @@ type DeleteZoneRequest struct {
 
 func (x *DeleteZoneRequest) Reset() {
 	*x = DeleteZoneRequest{}
-	mi := &file_zone_v1_zone_proto_msgTypes[9]
+	mi := &file_zone_v1_zone_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -462,7 +708,7 @@ func (x *DeleteZoneRequest) String() string {
 func (*DeleteZoneRequest) ProtoMessage() {}
 
 func (x *DeleteZoneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zone_v1_zone_proto_msgTypes[9]
+	mi := &file_zone_v1_zone_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -475,7 +721,7 @@ func (x *DeleteZoneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteZoneRequest.ProtoReflect.Descriptor instead.
 func (*DeleteZoneRequest) Descriptor() ([]byte, []int) {
-	return file_zone_v1_zone_proto_rawDescGZIP(), []int{9}
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteZoneRequest) GetId() string {
@@ -493,7 +739,7 @@ type DeleteZoneResponse struct {
 
 func (x *DeleteZoneResponse) Reset() {
 	*x = DeleteZoneResponse{}
-	mi := &file_zone_v1_zone_proto_msgTypes[10]
+	mi := &file_zone_v1_zone_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -505,7 +751,7 @@ func (x *DeleteZoneResponse) String() string {
 func (*DeleteZoneResponse) ProtoMessage() {}
 
 func (x *DeleteZoneResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zone_v1_zone_proto_msgTypes[10]
+	mi := &file_zone_v1_zone_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,48 +764,572 @@ func (x *DeleteZoneResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteZoneResponse.ProtoReflect.Descriptor instead.
 func (*DeleteZoneResponse) Descriptor() ([]byte, []int) {
-	return file_zone_v1_zone_proto_rawDescGZIP(), []int{10}
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{11}
 }
 
-var File_zone_v1_zone_proto protoreflect.FileDescriptor
+type UpdateZoneNameserversRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nameservers   []string               `protobuf:"bytes,2,rep,name=nameservers,proto3" json:"nameservers,omitempty"`
+	SoaMbox       string                 `protobuf:"bytes,3,opt,name=soa_mbox,json=soaMbox,proto3" json:"soa_mbox,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_zone_v1_zone_proto_rawDesc = "" +
-	"\n" +
-	"\x12zone/v1/zone.proto\x12\azone.v1\"0\n" +
-	"\x11CreateZoneRequest\x12\x1b\n" +
-	"\tzone_name\x18\x01 \x01(\tR\bzoneName\"\x8e\x01\n" +
-	"\x04Zone\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
-	"\tzone_name\x18\x02 \x01(\tR\bzoneName\x12\x1b\n" +
-	"\tis_active\x18\x03 \x01(\bR\bisActive\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\x05 \x01(\tR\tupdatedAt\"7\n" +
-	"\x12CreateZoneResponse\x12!\n" +
-	"\x04zone\x18\x01 \x01(\v2\r.zone.v1.ZoneR\x04zone\"\x12\n" +
-	"\x10ListZonesRequest\"8\n" +
-	"\x11ListZonesResponse\x12#\n" +
-	"\x05zones\x18\x01 \x03(\v2\r.zone.v1.ZoneR\x05zones\" \n" +
-	"\x0eGetZoneRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
-	"\x0fGetZoneResponse\x12!\n" +
-	"\x04zone\x18\x01 \x01(\v2\r.zone.v1.ZoneR\x04zone\"3\n" +
-	"\x14GetZoneByNameRequest\x12\x1b\n" +
-	"\tzone_name\x18\x01 \x01(\tR\bzoneName\":\n" +
-	"\x15GetZoneByNameResponse\x12!\n" +
-	"\x04zone\x18\x01 \x01(\v2\r.zone.v1.ZoneR\x04zone\"#\n" +
-	"\x11DeleteZoneRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
-	"\x12DeleteZoneResponse2\xf7\x02\n" +
-	"\vZoneService\x12G\n" +
-	"\n" +
-	"CreateZone\x12\x1a.zone.v1.CreateZoneRequest\x1a\x1b.zone.v1.CreateZoneResponse\"\x00\x12D\n" +
-	"\tListZones\x12\x19.zone.v1.ListZonesRequest\x1a\x1a.zone.v1.ListZonesResponse\"\x00\x12>\n" +
-	"\aGetZone\x12\x17.zone.v1.GetZoneRequest\x1a\x18.zone.v1.GetZoneResponse\"\x00\x12P\n" +
-	"\rGetZoneByName\x12\x1d.zone.v1.GetZoneByNameRequest\x1a\x1e.zone.v1.GetZoneByNameResponse\"\x00\x12G\n" +
+func (x *UpdateZoneNameserversRequest) Reset() {
+	*x = UpdateZoneNameserversRequest{}
+	mi := &file_zone_v1_zone_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateZoneNameserversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateZoneNameserversRequest) ProtoMessage() {}
+
+func (x *UpdateZoneNameserversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zone_v1_zone_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateZoneNameserversRequest.ProtoReflect.Descriptor instead.
+func (*UpdateZoneNameserversRequest) Descriptor() ([]byte, []int) {
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateZoneNameserversRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateZoneNameserversRequest) GetNameservers() []string {
+	if x != nil {
+		return x.Nameservers
+	}
+	return nil
+}
+
+func (x *UpdateZoneNameserversRequest) GetSoaMbox() string {
+	if x != nil {
+		return x.SoaMbox
+	}
+	return ""
+}
+
+type UpdateZoneNameserversResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zone          *Zone                  `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateZoneNameserversResponse) Reset() {
+	*x = UpdateZoneNameserversResponse{}
+	mi := &file_zone_v1_zone_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateZoneNameserversResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateZoneNameserversResponse) ProtoMessage() {}
+
+func (x *UpdateZoneNameserversResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zone_v1_zone_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateZoneNameserversResponse.ProtoReflect.Descriptor instead.
+func (*UpdateZoneNameserversResponse) Descriptor() ([]byte, []int) {
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateZoneNameserversResponse) GetZone() *Zone {
+	if x != nil {
+		return x.Zone
+	}
+	return nil
+}
+
+type VerifyZoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyZoneRequest) Reset() {
+	*x = VerifyZoneRequest{}
+	mi := &file_zone_v1_zone_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyZoneRequest) ProtoMessage() {}
+
+func (x *VerifyZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zone_v1_zone_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyZoneRequest.ProtoReflect.Descriptor instead.
+func (*VerifyZoneRequest) Descriptor() ([]byte, []int) {
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *VerifyZoneRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type VerifyZoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zone          *Zone                  `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyZoneResponse) Reset() {
+	*x = VerifyZoneResponse{}
+	mi := &file_zone_v1_zone_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyZoneResponse) ProtoMessage() {}
+
+func (x *VerifyZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zone_v1_zone_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyZoneResponse.ProtoReflect.Descriptor instead.
+func (*VerifyZoneResponse) Descriptor() ([]byte, []int) {
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *VerifyZoneResponse) GetZone() *Zone {
+	if x != nil {
+		return x.Zone
+	}
+	return nil
+}
+
+type TakeoverZoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TakeoverZoneRequest) Reset() {
+	*x = TakeoverZoneRequest{}
+	mi := &file_zone_v1_zone_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakeoverZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakeoverZoneRequest) ProtoMessage() {}
+
+func (x *TakeoverZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zone_v1_zone_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakeoverZoneRequest.ProtoReflect.Descriptor instead.
+func (*TakeoverZoneRequest) Descriptor() ([]byte, []int) {
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TakeoverZoneRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type TakeoverZoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zone          *Zone                  `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TakeoverZoneResponse) Reset() {
+	*x = TakeoverZoneResponse{}
+	mi := &file_zone_v1_zone_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakeoverZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakeoverZoneResponse) ProtoMessage() {}
+
+func (x *TakeoverZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zone_v1_zone_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakeoverZoneResponse.ProtoReflect.Descriptor instead.
+func (*TakeoverZoneResponse) Descriptor() ([]byte, []int) {
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TakeoverZoneResponse) GetZone() *Zone {
+	if x != nil {
+		return x.Zone
+	}
+	return nil
+}
+
+type CheckZoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckZoneRequest) Reset() {
+	*x = CheckZoneRequest{}
+	mi := &file_zone_v1_zone_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckZoneRequest) ProtoMessage() {}
+
+func (x *CheckZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zone_v1_zone_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckZoneRequest.ProtoReflect.Descriptor instead.
+func (*CheckZoneRequest) Descriptor() ([]byte, []int) {
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CheckZoneRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CheckZoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zone          *Zone                  `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	Check         *ZoneCheck             `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckZoneResponse) Reset() {
+	*x = CheckZoneResponse{}
+	mi := &file_zone_v1_zone_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckZoneResponse) ProtoMessage() {}
+
+func (x *CheckZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zone_v1_zone_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckZoneResponse.ProtoReflect.Descriptor instead.
+func (*CheckZoneResponse) Descriptor() ([]byte, []int) {
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CheckZoneResponse) GetZone() *Zone {
+	if x != nil {
+		return x.Zone
+	}
+	return nil
+}
+
+func (x *CheckZoneResponse) GetCheck() *ZoneCheck {
+	if x != nil {
+		return x.Check
+	}
+	return nil
+}
+
+type ListZoneChecksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneId        string                 `protobuf:"bytes,1,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListZoneChecksRequest) Reset() {
+	*x = ListZoneChecksRequest{}
+	mi := &file_zone_v1_zone_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListZoneChecksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListZoneChecksRequest) ProtoMessage() {}
+
+func (x *ListZoneChecksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zone_v1_zone_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListZoneChecksRequest.ProtoReflect.Descriptor instead.
+func (*ListZoneChecksRequest) Descriptor() ([]byte, []int) {
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListZoneChecksRequest) GetZoneId() string {
+	if x != nil {
+		return x.ZoneId
+	}
+	return ""
+}
+
+type ListZoneChecksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checks        []*ZoneCheck           `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListZoneChecksResponse) Reset() {
+	*x = ListZoneChecksResponse{}
+	mi := &file_zone_v1_zone_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListZoneChecksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListZoneChecksResponse) ProtoMessage() {}
+
+func (x *ListZoneChecksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zone_v1_zone_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListZoneChecksResponse.ProtoReflect.Descriptor instead.
+func (*ListZoneChecksResponse) Descriptor() ([]byte, []int) {
+	return file_zone_v1_zone_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListZoneChecksResponse) GetChecks() []*ZoneCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+var File_zone_v1_zone_proto protoreflect.FileDescriptor
+
+const file_zone_v1_zone_proto_rawDesc = "" +
+	"\n" +
+	"\x12zone/v1/zone.proto\x12\azone.v1\"Y\n" +
+	"\x11CreateZoneRequest\x12\x1b\n" +
+	"\tzone_name\x18\x01 \x01(\tR\bzoneName\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\"\xd8\x04\n" +
+	"\x04Zone\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tzone_name\x18\x02 \x01(\tR\bzoneName\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\x12 \n" +
+	"\vnameservers\x18\x06 \x03(\tR\vnameservers\x12\x19\n" +
+	"\bsoa_mbox\x18\a \x01(\tR\asoaMbox\x12-\n" +
+	"\x12verification_token\x18\b \x01(\tR\x11verificationToken\x12/\n" +
+	"\x13verification_record\x18\t \x01(\tR\x12verificationRecord\x12\x1f\n" +
+	"\vverified_at\x18\n" +
+	" \x01(\tR\n" +
+	"verifiedAt\x12+\n" +
+	"\x06status\x18\v \x01(\x0e2\x13.zone.v1.ZoneStatusR\x06status\x121\n" +
+	"\x14observed_nameservers\x18\f \x03(\tR\x13observedNameservers\x12&\n" +
+	"\x0flast_checked_at\x18\r \x01(\tR\rlastCheckedAt\x12(\n" +
+	"\x10last_check_error\x18\x0e \x01(\tR\x0elastCheckError\x12'\n" +
+	"\x0forganization_id\x18\x0f \x01(\tR\x0eorganizationId\x12/\n" +
+	"\x13pending_nameservers\x18\x10 \x03(\tR\x12pendingNameservers\"\xb0\x01\n" +
+	"\tZoneCheck\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x13.zone.v1.ZoneStatusR\x06status\x121\n" +
+	"\x14observed_nameservers\x18\x03 \x03(\tR\x13observedNameservers\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"checked_at\x18\x05 \x01(\tR\tcheckedAt\"7\n" +
+	"\x12CreateZoneResponse\x12!\n" +
+	"\x04zone\x18\x01 \x01(\v2\r.zone.v1.ZoneR\x04zone\";\n" +
+	"\x10ListZonesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"8\n" +
+	"\x11ListZonesResponse\x12#\n" +
+	"\x05zones\x18\x01 \x03(\v2\r.zone.v1.ZoneR\x05zones\" \n" +
+	"\x0eGetZoneRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
+	"\x0fGetZoneResponse\x12!\n" +
+	"\x04zone\x18\x01 \x01(\v2\r.zone.v1.ZoneR\x04zone\"\\\n" +
+	"\x14GetZoneByNameRequest\x12\x1b\n" +
+	"\tzone_name\x18\x01 \x01(\tR\bzoneName\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\":\n" +
+	"\x15GetZoneByNameResponse\x12!\n" +
+	"\x04zone\x18\x01 \x01(\v2\r.zone.v1.ZoneR\x04zone\"#\n" +
+	"\x11DeleteZoneRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12DeleteZoneResponse\"k\n" +
+	"\x1cUpdateZoneNameserversRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\vnameservers\x18\x02 \x03(\tR\vnameservers\x12\x19\n" +
+	"\bsoa_mbox\x18\x03 \x01(\tR\asoaMbox\"B\n" +
+	"\x1dUpdateZoneNameserversResponse\x12!\n" +
+	"\x04zone\x18\x01 \x01(\v2\r.zone.v1.ZoneR\x04zone\"#\n" +
+	"\x11VerifyZoneRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x12VerifyZoneResponse\x12!\n" +
+	"\x04zone\x18\x01 \x01(\v2\r.zone.v1.ZoneR\x04zone\"%\n" +
+	"\x13TakeoverZoneRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
+	"\x14TakeoverZoneResponse\x12!\n" +
+	"\x04zone\x18\x01 \x01(\v2\r.zone.v1.ZoneR\x04zone\"\"\n" +
+	"\x10CheckZoneRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"`\n" +
+	"\x11CheckZoneResponse\x12!\n" +
+	"\x04zone\x18\x01 \x01(\v2\r.zone.v1.ZoneR\x04zone\x12(\n" +
+	"\x05check\x18\x02 \x01(\v2\x12.zone.v1.ZoneCheckR\x05check\"0\n" +
+	"\x15ListZoneChecksRequest\x12\x17\n" +
+	"\azone_id\x18\x01 \x01(\tR\x06zoneId\"D\n" +
+	"\x16ListZoneChecksResponse\x12*\n" +
+	"\x06checks\x18\x01 \x03(\v2\x12.zone.v1.ZoneCheckR\x06checks*\xaf\x01\n" +
+	"\n" +
+	"ZoneStatus\x12\x1b\n" +
+	"\x17ZONE_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13ZONE_STATUS_PENDING\x10\x01\x12\x16\n" +
+	"\x12ZONE_STATUS_ACTIVE\x10\x02\x12\x1b\n" +
+	"\x17ZONE_STATUS_NS_MISMATCH\x10\x03\x12\x1b\n" +
+	"\x17ZONE_STATUS_DEACTIVATED\x10\x04\x12\x19\n" +
+	"\x15ZONE_STATUS_SUSPENDED\x10\x052\x94\x06\n" +
+	"\vZoneService\x12G\n" +
+	"\n" +
+	"CreateZone\x12\x1a.zone.v1.CreateZoneRequest\x1a\x1b.zone.v1.CreateZoneResponse\"\x00\x12D\n" +
+	"\tListZones\x12\x19.zone.v1.ListZonesRequest\x1a\x1a.zone.v1.ListZonesResponse\"\x00\x12>\n" +
+	"\aGetZone\x12\x17.zone.v1.GetZoneRequest\x1a\x18.zone.v1.GetZoneResponse\"\x00\x12P\n" +
+	"\rGetZoneByName\x12\x1d.zone.v1.GetZoneByNameRequest\x1a\x1e.zone.v1.GetZoneByNameResponse\"\x00\x12G\n" +
+	"\n" +
+	"DeleteZone\x12\x1a.zone.v1.DeleteZoneRequest\x1a\x1b.zone.v1.DeleteZoneResponse\"\x00\x12h\n" +
+	"\x15UpdateZoneNameservers\x12%.zone.v1.UpdateZoneNameserversRequest\x1a&.zone.v1.UpdateZoneNameserversResponse\"\x00\x12G\n" +
 	"\n" +
-	"DeleteZone\x12\x1a.zone.v1.DeleteZoneRequest\x1a\x1b.zone.v1.DeleteZoneResponse\"\x00B\x1bZ\x19dnsarc/gen/zone/v1;zonev1b\x06proto3"
+	"VerifyZone\x12\x1a.zone.v1.VerifyZoneRequest\x1a\x1b.zone.v1.VerifyZoneResponse\"\x00\x12M\n" +
+	"\fTakeoverZone\x12\x1c.zone.v1.TakeoverZoneRequest\x1a\x1d.zone.v1.TakeoverZoneResponse\"\x00\x12D\n" +
+	"\tCheckZone\x12\x19.zone.v1.CheckZoneRequest\x1a\x1a.zone.v1.CheckZoneResponse\"\x00\x12S\n" +
+	"\x0eListZoneChecks\x12\x1e.zone.v1.ListZoneChecksRequest\x1a\x1f.zone.v1.ListZoneChecksResponse\"\x00B\x1bZ\x19dnsarc/gen/zone/v1;zonev1b\x06proto3"
 
 var (
 	file_zone_v1_zone_proto_rawDescOnce sync.Once
@@ -573,40 +1343,71 @@ func file_zone_v1_zone_proto_rawDescGZIP() []byte {
 	return file_zone_v1_zone_proto_rawDescData
 }
 
-var file_zone_v1_zone_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_zone_v1_zone_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_zone_v1_zone_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_zone_v1_zone_proto_goTypes = []any{
-	(*CreateZoneRequest)(nil),     // 0: zone.v1.CreateZoneRequest
-	(*Zone)(nil),                  // 1: zone.v1.Zone
-	(*CreateZoneResponse)(nil),    // 2: zone.v1.CreateZoneResponse
-	(*ListZonesRequest)(nil),      // 3: zone.v1.ListZonesRequest
-	(*ListZonesResponse)(nil),     // 4: zone.v1.ListZonesResponse
-	(*GetZoneRequest)(nil),        // 5: zone.v1.GetZoneRequest
-	(*GetZoneResponse)(nil),       // 6: zone.v1.GetZoneResponse
-	(*GetZoneByNameRequest)(nil),  // 7: zone.v1.GetZoneByNameRequest
-	(*GetZoneByNameResponse)(nil), // 8: zone.v1.GetZoneByNameResponse
-	(*DeleteZoneRequest)(nil),     // 9: zone.v1.DeleteZoneRequest
-	(*DeleteZoneResponse)(nil),    // 10: zone.v1.DeleteZoneResponse
+	(ZoneStatus)(0),                       // 0: zone.v1.ZoneStatus
+	(*CreateZoneRequest)(nil),             // 1: zone.v1.CreateZoneRequest
+	(*Zone)(nil),                          // 2: zone.v1.Zone
+	(*ZoneCheck)(nil),                     // 3: zone.v1.ZoneCheck
+	(*CreateZoneResponse)(nil),            // 4: zone.v1.CreateZoneResponse
+	(*ListZonesRequest)(nil),              // 5: zone.v1.ListZonesRequest
+	(*ListZonesResponse)(nil),             // 6: zone.v1.ListZonesResponse
+	(*GetZoneRequest)(nil),                // 7: zone.v1.GetZoneRequest
+	(*GetZoneResponse)(nil),               // 8: zone.v1.GetZoneResponse
+	(*GetZoneByNameRequest)(nil),          // 9: zone.v1.GetZoneByNameRequest
+	(*GetZoneByNameResponse)(nil),         // 10: zone.v1.GetZoneByNameResponse
+	(*DeleteZoneRequest)(nil),             // 11: zone.v1.DeleteZoneRequest
+	(*DeleteZoneResponse)(nil),            // 12: zone.v1.DeleteZoneResponse
+	(*UpdateZoneNameserversRequest)(nil),  // 13: zone.v1.UpdateZoneNameserversRequest
+	(*UpdateZoneNameserversResponse)(nil), // 14: zone.v1.UpdateZoneNameserversResponse
+	(*VerifyZoneRequest)(nil),             // 15: zone.v1.VerifyZoneRequest
+	(*VerifyZoneResponse)(nil),            // 16: zone.v1.VerifyZoneResponse
+	(*TakeoverZoneRequest)(nil),           // 17: zone.v1.TakeoverZoneRequest
+	(*TakeoverZoneResponse)(nil),          // 18: zone.v1.TakeoverZoneResponse
+	(*CheckZoneRequest)(nil),              // 19: zone.v1.CheckZoneRequest
+	(*CheckZoneResponse)(nil),             // 20: zone.v1.CheckZoneResponse
+	(*ListZoneChecksRequest)(nil),         // 21: zone.v1.ListZoneChecksRequest
+	(*ListZoneChecksResponse)(nil),        // 22: zone.v1.ListZoneChecksResponse
 }
 var file_zone_v1_zone_proto_depIdxs = []int32{
-	1,  // 0: zone.v1.CreateZoneResponse.zone:type_name -> zone.v1.Zone
-	1,  // 1: zone.v1.ListZonesResponse.zones:type_name -> zone.v1.Zone
-	1,  // 2: zone.v1.GetZoneResponse.zone:type_name -> zone.v1.Zone
-	1,  // 3: zone.v1.GetZoneByNameResponse.zone:type_name -> zone.v1.Zone
-	0,  // 4: zone.v1.ZoneService.CreateZone:input_type -> zone.v1.CreateZoneRequest
-	3,  // 5: zone.v1.ZoneService.ListZones:input_type -> zone.v1.ListZonesRequest
-	5,  // 6: zone.v1.ZoneService.GetZone:input_type -> zone.v1.GetZoneRequest
-	7,  // 7: zone.v1.ZoneService.GetZoneByName:input_type -> zone.v1.GetZoneByNameRequest
-	9,  // 8: zone.v1.ZoneService.DeleteZone:input_type -> zone.v1.DeleteZoneRequest
-	2,  // 9: zone.v1.ZoneService.CreateZone:output_type -> zone.v1.CreateZoneResponse
-	4,  // 10: zone.v1.ZoneService.ListZones:output_type -> zone.v1.ListZonesResponse
-	6,  // 11: zone.v1.ZoneService.GetZone:output_type -> zone.v1.GetZoneResponse
-	8,  // 12: zone.v1.ZoneService.GetZoneByName:output_type -> zone.v1.GetZoneByNameResponse
-	10, // 13: zone.v1.ZoneService.DeleteZone:output_type -> zone.v1.DeleteZoneResponse
-	9,  // [9:14] is the sub-list for method output_type
-	4,  // [4:9] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	0,  // 0: zone.v1.Zone.status:type_name -> zone.v1.ZoneStatus
+	0,  // 1: zone.v1.ZoneCheck.status:type_name -> zone.v1.ZoneStatus
+	2,  // 2: zone.v1.CreateZoneResponse.zone:type_name -> zone.v1.Zone
+	2,  // 3: zone.v1.ListZonesResponse.zones:type_name -> zone.v1.Zone
+	2,  // 4: zone.v1.GetZoneResponse.zone:type_name -> zone.v1.Zone
+	2,  // 5: zone.v1.GetZoneByNameResponse.zone:type_name -> zone.v1.Zone
+	2,  // 6: zone.v1.UpdateZoneNameserversResponse.zone:type_name -> zone.v1.Zone
+	2,  // 7: zone.v1.VerifyZoneResponse.zone:type_name -> zone.v1.Zone
+	2,  // 8: zone.v1.TakeoverZoneResponse.zone:type_name -> zone.v1.Zone
+	2,  // 9: zone.v1.CheckZoneResponse.zone:type_name -> zone.v1.Zone
+	3,  // 10: zone.v1.CheckZoneResponse.check:type_name -> zone.v1.ZoneCheck
+	3,  // 11: zone.v1.ListZoneChecksResponse.checks:type_name -> zone.v1.ZoneCheck
+	1,  // 12: zone.v1.ZoneService.CreateZone:input_type -> zone.v1.CreateZoneRequest
+	5,  // 13: zone.v1.ZoneService.ListZones:input_type -> zone.v1.ListZonesRequest
+	7,  // 14: zone.v1.ZoneService.GetZone:input_type -> zone.v1.GetZoneRequest
+	9,  // 15: zone.v1.ZoneService.GetZoneByName:input_type -> zone.v1.GetZoneByNameRequest
+	11, // 16: zone.v1.ZoneService.DeleteZone:input_type -> zone.v1.DeleteZoneRequest
+	13, // 17: zone.v1.ZoneService.UpdateZoneNameservers:input_type -> zone.v1.UpdateZoneNameserversRequest
+	15, // 18: zone.v1.ZoneService.VerifyZone:input_type -> zone.v1.VerifyZoneRequest
+	17, // 19: zone.v1.ZoneService.TakeoverZone:input_type -> zone.v1.TakeoverZoneRequest
+	19, // 20: zone.v1.ZoneService.CheckZone:input_type -> zone.v1.CheckZoneRequest
+	21, // 21: zone.v1.ZoneService.ListZoneChecks:input_type -> zone.v1.ListZoneChecksRequest
+	4,  // 22: zone.v1.ZoneService.CreateZone:output_type -> zone.v1.CreateZoneResponse
+	6,  // 23: zone.v1.ZoneService.ListZones:output_type -> zone.v1.ListZonesResponse
+	8,  // 24: zone.v1.ZoneService.GetZone:output_type -> zone.v1.GetZoneResponse
+	10, // 25: zone.v1.ZoneService.GetZoneByName:output_type -> zone.v1.GetZoneByNameResponse
+	12, // 26: zone.v1.ZoneService.DeleteZone:output_type -> zone.v1.DeleteZoneResponse
+	14, // 27: zone.v1.ZoneService.UpdateZoneNameservers:output_type -> zone.v1.UpdateZoneNameserversResponse
+	16, // 28: zone.v1.ZoneService.VerifyZone:output_type -> zone.v1.VerifyZoneResponse
+	18, // 29: zone.v1.ZoneService.TakeoverZone:output_type -> zone.v1.TakeoverZoneResponse
+	20, // 30: zone.v1.ZoneService.CheckZone:output_type -> zone.v1.CheckZoneResponse
+	22, // 31: zone.v1.ZoneService.ListZoneChecks:output_type -> zone.v1.ListZoneChecksResponse
+	22, // [22:32] is the sub-list for method output_type
+	12, // [12:22] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_zone_v1_zone_proto_init() }
@@ -619,13 +1420,14 @@ func file_zone_v1_zone_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_zone_v1_zone_proto_rawDesc), len(file_zone_v1_zone_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      1,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_zone_v1_zone_proto_goTypes,
 		DependencyIndexes: file_zone_v1_zone_proto_depIdxs,
+		EnumInfos:         file_zone_v1_zone_proto_enumTypes,
 		MessageInfos:      file_zone_v1_zone_proto_msgTypes,
 	}.Build()
 	File_zone_v1_zone_proto = out.File
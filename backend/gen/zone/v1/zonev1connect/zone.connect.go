@@ -44,6 +44,19 @@ const (
 	ZoneServiceGetZoneByNameProcedure = "/zone.v1.ZoneService/GetZoneByName"
 	// ZoneServiceDeleteZoneProcedure is the fully-qualified name of the ZoneService's DeleteZone RPC.
 	ZoneServiceDeleteZoneProcedure = "/zone.v1.ZoneService/DeleteZone"
+	// ZoneServiceUpdateZoneNameserversProcedure is the fully-qualified name of the ZoneService's
+	// UpdateZoneNameservers RPC.
+	ZoneServiceUpdateZoneNameserversProcedure = "/zone.v1.ZoneService/UpdateZoneNameservers"
+	// ZoneServiceVerifyZoneProcedure is the fully-qualified name of the ZoneService's VerifyZone RPC.
+	ZoneServiceVerifyZoneProcedure = "/zone.v1.ZoneService/VerifyZone"
+	// ZoneServiceTakeoverZoneProcedure is the fully-qualified name of the ZoneService's TakeoverZone
+	// RPC.
+	ZoneServiceTakeoverZoneProcedure = "/zone.v1.ZoneService/TakeoverZone"
+	// ZoneServiceCheckZoneProcedure is the fully-qualified name of the ZoneService's CheckZone RPC.
+	ZoneServiceCheckZoneProcedure = "/zone.v1.ZoneService/CheckZone"
+	// ZoneServiceListZoneChecksProcedure is the fully-qualified name of the ZoneService's
+	// ListZoneChecks RPC.
+	ZoneServiceListZoneChecksProcedure = "/zone.v1.ZoneService/ListZoneChecks"
 )
 
 // ZoneServiceClient is a client for the zone.v1.ZoneService service.
@@ -53,6 +66,11 @@ type ZoneServiceClient interface {
 	GetZone(context.Context, *connect.Request[v1.GetZoneRequest]) (*connect.Response[v1.GetZoneResponse], error)
 	GetZoneByName(context.Context, *connect.Request[v1.GetZoneByNameRequest]) (*connect.Response[v1.GetZoneByNameResponse], error)
 	DeleteZone(context.Context, *connect.Request[v1.DeleteZoneRequest]) (*connect.Response[v1.DeleteZoneResponse], error)
+	UpdateZoneNameservers(context.Context, *connect.Request[v1.UpdateZoneNameserversRequest]) (*connect.Response[v1.UpdateZoneNameserversResponse], error)
+	VerifyZone(context.Context, *connect.Request[v1.VerifyZoneRequest]) (*connect.Response[v1.VerifyZoneResponse], error)
+	TakeoverZone(context.Context, *connect.Request[v1.TakeoverZoneRequest]) (*connect.Response[v1.TakeoverZoneResponse], error)
+	CheckZone(context.Context, *connect.Request[v1.CheckZoneRequest]) (*connect.Response[v1.CheckZoneResponse], error)
+	ListZoneChecks(context.Context, *connect.Request[v1.ListZoneChecksRequest]) (*connect.Response[v1.ListZoneChecksResponse], error)
 }
 
 // NewZoneServiceClient constructs a client for the zone.v1.ZoneService service. By default, it uses
@@ -96,16 +114,51 @@ func NewZoneServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(zoneServiceMethods.ByName("DeleteZone")),
 			connect.WithClientOptions(opts...),
 		),
+		updateZoneNameservers: connect.NewClient[v1.UpdateZoneNameserversRequest, v1.UpdateZoneNameserversResponse](
+			httpClient,
+			baseURL+ZoneServiceUpdateZoneNameserversProcedure,
+			connect.WithSchema(zoneServiceMethods.ByName("UpdateZoneNameservers")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyZone: connect.NewClient[v1.VerifyZoneRequest, v1.VerifyZoneResponse](
+			httpClient,
+			baseURL+ZoneServiceVerifyZoneProcedure,
+			connect.WithSchema(zoneServiceMethods.ByName("VerifyZone")),
+			connect.WithClientOptions(opts...),
+		),
+		takeoverZone: connect.NewClient[v1.TakeoverZoneRequest, v1.TakeoverZoneResponse](
+			httpClient,
+			baseURL+ZoneServiceTakeoverZoneProcedure,
+			connect.WithSchema(zoneServiceMethods.ByName("TakeoverZone")),
+			connect.WithClientOptions(opts...),
+		),
+		checkZone: connect.NewClient[v1.CheckZoneRequest, v1.CheckZoneResponse](
+			httpClient,
+			baseURL+ZoneServiceCheckZoneProcedure,
+			connect.WithSchema(zoneServiceMethods.ByName("CheckZone")),
+			connect.WithClientOptions(opts...),
+		),
+		listZoneChecks: connect.NewClient[v1.ListZoneChecksRequest, v1.ListZoneChecksResponse](
+			httpClient,
+			baseURL+ZoneServiceListZoneChecksProcedure,
+			connect.WithSchema(zoneServiceMethods.ByName("ListZoneChecks")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // zoneServiceClient implements ZoneServiceClient.
 type zoneServiceClient struct {
-	createZone    *connect.Client[v1.CreateZoneRequest, v1.CreateZoneResponse]
-	listZones     *connect.Client[v1.ListZonesRequest, v1.ListZonesResponse]
-	getZone       *connect.Client[v1.GetZoneRequest, v1.GetZoneResponse]
-	getZoneByName *connect.Client[v1.GetZoneByNameRequest, v1.GetZoneByNameResponse]
-	deleteZone    *connect.Client[v1.DeleteZoneRequest, v1.DeleteZoneResponse]
+	createZone            *connect.Client[v1.CreateZoneRequest, v1.CreateZoneResponse]
+	listZones             *connect.Client[v1.ListZonesRequest, v1.ListZonesResponse]
+	getZone               *connect.Client[v1.GetZoneRequest, v1.GetZoneResponse]
+	getZoneByName         *connect.Client[v1.GetZoneByNameRequest, v1.GetZoneByNameResponse]
+	deleteZone            *connect.Client[v1.DeleteZoneRequest, v1.DeleteZoneResponse]
+	updateZoneNameservers *connect.Client[v1.UpdateZoneNameserversRequest, v1.UpdateZoneNameserversResponse]
+	verifyZone            *connect.Client[v1.VerifyZoneRequest, v1.VerifyZoneResponse]
+	takeoverZone          *connect.Client[v1.TakeoverZoneRequest, v1.TakeoverZoneResponse]
+	checkZone             *connect.Client[v1.CheckZoneRequest, v1.CheckZoneResponse]
+	listZoneChecks        *connect.Client[v1.ListZoneChecksRequest, v1.ListZoneChecksResponse]
 }
 
 // CreateZone calls zone.v1.ZoneService.CreateZone.
@@ -133,6 +186,31 @@ func (c *zoneServiceClient) DeleteZone(ctx context.Context, req *connect.Request
 	return c.deleteZone.CallUnary(ctx, req)
 }
 
+// UpdateZoneNameservers calls zone.v1.ZoneService.UpdateZoneNameservers.
+func (c *zoneServiceClient) UpdateZoneNameservers(ctx context.Context, req *connect.Request[v1.UpdateZoneNameserversRequest]) (*connect.Response[v1.UpdateZoneNameserversResponse], error) {
+	return c.updateZoneNameservers.CallUnary(ctx, req)
+}
+
+// VerifyZone calls zone.v1.ZoneService.VerifyZone.
+func (c *zoneServiceClient) VerifyZone(ctx context.Context, req *connect.Request[v1.VerifyZoneRequest]) (*connect.Response[v1.VerifyZoneResponse], error) {
+	return c.verifyZone.CallUnary(ctx, req)
+}
+
+// TakeoverZone calls zone.v1.ZoneService.TakeoverZone.
+func (c *zoneServiceClient) TakeoverZone(ctx context.Context, req *connect.Request[v1.TakeoverZoneRequest]) (*connect.Response[v1.TakeoverZoneResponse], error) {
+	return c.takeoverZone.CallUnary(ctx, req)
+}
+
+// CheckZone calls zone.v1.ZoneService.CheckZone.
+func (c *zoneServiceClient) CheckZone(ctx context.Context, req *connect.Request[v1.CheckZoneRequest]) (*connect.Response[v1.CheckZoneResponse], error) {
+	return c.checkZone.CallUnary(ctx, req)
+}
+
+// ListZoneChecks calls zone.v1.ZoneService.ListZoneChecks.
+func (c *zoneServiceClient) ListZoneChecks(ctx context.Context, req *connect.Request[v1.ListZoneChecksRequest]) (*connect.Response[v1.ListZoneChecksResponse], error) {
+	return c.listZoneChecks.CallUnary(ctx, req)
+}
+
 // ZoneServiceHandler is an implementation of the zone.v1.ZoneService service.
 type ZoneServiceHandler interface {
 	CreateZone(context.Context, *connect.Request[v1.CreateZoneRequest]) (*connect.Response[v1.CreateZoneResponse], error)
@@ -140,6 +218,11 @@ type ZoneServiceHandler interface {
 	GetZone(context.Context, *connect.Request[v1.GetZoneRequest]) (*connect.Response[v1.GetZoneResponse], error)
 	GetZoneByName(context.Context, *connect.Request[v1.GetZoneByNameRequest]) (*connect.Response[v1.GetZoneByNameResponse], error)
 	DeleteZone(context.Context, *connect.Request[v1.DeleteZoneRequest]) (*connect.Response[v1.DeleteZoneResponse], error)
+	UpdateZoneNameservers(context.Context, *connect.Request[v1.UpdateZoneNameserversRequest]) (*connect.Response[v1.UpdateZoneNameserversResponse], error)
+	VerifyZone(context.Context, *connect.Request[v1.VerifyZoneRequest]) (*connect.Response[v1.VerifyZoneResponse], error)
+	TakeoverZone(context.Context, *connect.Request[v1.TakeoverZoneRequest]) (*connect.Response[v1.TakeoverZoneResponse], error)
+	CheckZone(context.Context, *connect.Request[v1.CheckZoneRequest]) (*connect.Response[v1.CheckZoneResponse], error)
+	ListZoneChecks(context.Context, *connect.Request[v1.ListZoneChecksRequest]) (*connect.Response[v1.ListZoneChecksResponse], error)
 }
 
 // NewZoneServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -179,6 +262,36 @@ func NewZoneServiceHandler(svc ZoneServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(zoneServiceMethods.ByName("DeleteZone")),
 		connect.WithHandlerOptions(opts...),
 	)
+	zoneServiceUpdateZoneNameserversHandler := connect.NewUnaryHandler(
+		ZoneServiceUpdateZoneNameserversProcedure,
+		svc.UpdateZoneNameservers,
+		connect.WithSchema(zoneServiceMethods.ByName("UpdateZoneNameservers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zoneServiceVerifyZoneHandler := connect.NewUnaryHandler(
+		ZoneServiceVerifyZoneProcedure,
+		svc.VerifyZone,
+		connect.WithSchema(zoneServiceMethods.ByName("VerifyZone")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zoneServiceTakeoverZoneHandler := connect.NewUnaryHandler(
+		ZoneServiceTakeoverZoneProcedure,
+		svc.TakeoverZone,
+		connect.WithSchema(zoneServiceMethods.ByName("TakeoverZone")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zoneServiceCheckZoneHandler := connect.NewUnaryHandler(
+		ZoneServiceCheckZoneProcedure,
+		svc.CheckZone,
+		connect.WithSchema(zoneServiceMethods.ByName("CheckZone")),
+		connect.WithHandlerOptions(opts...),
+	)
+	zoneServiceListZoneChecksHandler := connect.NewUnaryHandler(
+		ZoneServiceListZoneChecksProcedure,
+		svc.ListZoneChecks,
+		connect.WithSchema(zoneServiceMethods.ByName("ListZoneChecks")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/zone.v1.ZoneService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ZoneServiceCreateZoneProcedure:
@@ -191,6 +304,16 @@ func NewZoneServiceHandler(svc ZoneServiceHandler, opts ...connect.HandlerOption
 			zoneServiceGetZoneByNameHandler.ServeHTTP(w, r)
 		case ZoneServiceDeleteZoneProcedure:
 			zoneServiceDeleteZoneHandler.ServeHTTP(w, r)
+		case ZoneServiceUpdateZoneNameserversProcedure:
+			zoneServiceUpdateZoneNameserversHandler.ServeHTTP(w, r)
+		case ZoneServiceVerifyZoneProcedure:
+			zoneServiceVerifyZoneHandler.ServeHTTP(w, r)
+		case ZoneServiceTakeoverZoneProcedure:
+			zoneServiceTakeoverZoneHandler.ServeHTTP(w, r)
+		case ZoneServiceCheckZoneProcedure:
+			zoneServiceCheckZoneHandler.ServeHTTP(w, r)
+		case ZoneServiceListZoneChecksProcedure:
+			zoneServiceListZoneChecksHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -219,3 +342,23 @@ func (UnimplementedZoneServiceHandler) GetZoneByName(context.Context, *connect.R
 func (UnimplementedZoneServiceHandler) DeleteZone(context.Context, *connect.Request[v1.DeleteZoneRequest]) (*connect.Response[v1.DeleteZoneResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zone.v1.ZoneService.DeleteZone is not implemented"))
 }
+
+func (UnimplementedZoneServiceHandler) UpdateZoneNameservers(context.Context, *connect.Request[v1.UpdateZoneNameserversRequest]) (*connect.Response[v1.UpdateZoneNameserversResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zone.v1.ZoneService.UpdateZoneNameservers is not implemented"))
+}
+
+func (UnimplementedZoneServiceHandler) VerifyZone(context.Context, *connect.Request[v1.VerifyZoneRequest]) (*connect.Response[v1.VerifyZoneResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zone.v1.ZoneService.VerifyZone is not implemented"))
+}
+
+func (UnimplementedZoneServiceHandler) TakeoverZone(context.Context, *connect.Request[v1.TakeoverZoneRequest]) (*connect.Response[v1.TakeoverZoneResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zone.v1.ZoneService.TakeoverZone is not implemented"))
+}
+
+func (UnimplementedZoneServiceHandler) CheckZone(context.Context, *connect.Request[v1.CheckZoneRequest]) (*connect.Response[v1.CheckZoneResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zone.v1.ZoneService.CheckZone is not implemented"))
+}
+
+func (UnimplementedZoneServiceHandler) ListZoneChecks(context.Context, *connect.Request[v1.ListZoneChecksRequest]) (*connect.Response[v1.ListZoneChecksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("zone.v1.ZoneService.ListZoneChecks is not implemented"))
+}
@@ -0,0 +1,139 @@
+package analytics
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"dnsarc/internal/models"
+)
+
+const (
+	// flushInterval 内存中的计数写入数据库的间隔
+	flushInterval = time.Minute
+	// maxNamesPerZone 每个 zone 在一个 flush 周期内最多单独统计的名称数, 避免随机子域名查询撑爆内存和数据表
+	maxNamesPerZone = 1000
+	// retention 统计数据保留的时间
+	retention = time.Hour * 24 * 30
+	// batchSize 每次 upsert 的行数
+	batchSize = 500
+)
+
+type key struct {
+	bucket  time.Time
+	zoneID  string
+	name    string
+	qtype   string
+	rcode   string
+	country string
+}
+
+// Collector 在内存中聚合 DNS 查询计数, 定期累加到 query_stats 表中
+type Collector struct {
+	db *gorm.DB
+
+	mu     sync.Mutex
+	counts map[key]int64
+	names  map[string]map[string]struct{} // zone id -> 本周期已经统计的名称
+}
+
+func NewCollector(db *gorm.DB) *Collector {
+	return &Collector{
+		db:     db,
+		counts: make(map[key]int64),
+		names:  make(map[string]map[string]struct{}),
+	}
+}
+
+// Record 记录一次查询, name 为小写且不带结尾的点
+func (c *Collector) Record(zoneID, name, qtype, rcode, country string) {
+	bucket := time.Now().UTC().Truncate(models.QueryStatBucket)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	names, ok := c.names[zoneID]
+	if !ok {
+		names = make(map[string]struct{})
+		c.names[zoneID] = names
+	}
+	if _, ok := names[name]; !ok {
+		if len(names) >= maxNamesPerZone {
+			name = models.QueryStatOtherName
+		} else {
+			names[name] = struct{}{}
+		}
+	}
+	c.counts[key{
+		bucket:  bucket,
+		zoneID:  zoneID,
+		name:    name,
+		qtype:   qtype,
+		rcode:   rcode,
+		country: country,
+	}]++
+}
+
+// Run 定期写入计数并清理过期数据, ctx 取消时写入剩余的计数后返回
+func (c *Collector) Run(ctx context.Context) {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	lastPrune := time.Time{}
+	for {
+		select {
+		case <-ctx.Done():
+			c.Flush(context.Background())
+			return
+		case <-ticker.C:
+			c.Flush(ctx)
+			if time.Since(lastPrune) > time.Hour {
+				c.prune(ctx)
+				lastPrune = time.Now()
+			}
+		}
+	}
+}
+
+// Flush 将内存中的计数累加到数据库, 写入失败的计数会被丢弃
+func (c *Collector) Flush(ctx context.Context) {
+	c.mu.Lock()
+	counts := c.counts
+	c.counts = make(map[key]int64)
+	c.names = make(map[string]map[string]struct{})
+	c.mu.Unlock()
+	if len(counts) == 0 {
+		return
+	}
+	stats := make([]models.QueryStat, 0, len(counts))
+	for k, count := range counts {
+		stats = append(stats, models.QueryStat{
+			Bucket:  k.bucket,
+			ZoneID:  k.zoneID,
+			Name:    k.name,
+			QType:   k.qtype,
+			RCode:   k.rcode,
+			Country: k.country,
+			Count:   count,
+		})
+	}
+	// 多个 DNS 实例写入同一个时间段, 冲突时累加
+	err := c.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "bucket"}, {Name: "zone_id"}, {Name: "name"}, {Name: "qtype"}, {Name: "rcode"}, {Name: "country"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"count": gorm.Expr("query_stats.count + excluded.count"),
+		}),
+	}).CreateInBatches(stats, batchSize).Error
+	if err != nil {
+		slog.Error("failed to flush query stats", "error", err, "rows", len(stats))
+		return
+	}
+	slog.Debug("query stats flushed", "rows", len(stats))
+}
+
+func (c *Collector) prune(ctx context.Context) {
+	if err := c.db.WithContext(ctx).Where("bucket < ?", time.Now().Add(-retention)).Delete(&models.QueryStat{}).Error; err != nil {
+		slog.Error("failed to prune query stats", "error", err)
+	}
+}
@@ -0,0 +1,77 @@
+package answerer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+const (
+	ProviderOpenAI     = "openai"
+	ProviderCompatible = "compatible" // OpenAI 兼容的本地服务, 如 Ollama, vLLM, llama.cpp
+	ProviderStub       = "stub"
+
+	defaultModel           = "gpt-4.1-mini"
+	defaultInstructions    = "You are a helpful assistant that can answer questions, and only output plain text. only output English text."
+	defaultMaxOutputTokens = 1000
+)
+
+var ErrEmptyAnswer = errors.New("empty answer")
+
+// Answerer 根据问题生成通过 TXT 记录返回的文本
+type Answerer interface {
+	Answer(ctx context.Context, question string) (string, error)
+}
+
+type Config struct {
+	// Provider openai, compatible 或 stub, 为空时关闭
+	Provider string
+	Model    string
+	// BaseURL OpenAI 兼容服务的地址, 如 http://localhost:11434/v1
+	BaseURL string
+	// APIKey 为空时 openai 使用 OPENAI_API_KEY 环境变量
+	APIKey          string
+	Instructions    string
+	MaxOutputTokens int64
+}
+
+// New 根据配置创建 Answerer, Provider 为空时返回 nil
+func New(config Config) (Answerer, error) {
+	if config.Model == "" {
+		config.Model = defaultModel
+	}
+	if config.Instructions == "" {
+		config.Instructions = defaultInstructions
+	}
+	if config.MaxOutputTokens == 0 {
+		config.MaxOutputTokens = defaultMaxOutputTokens
+	}
+	switch config.Provider {
+	case "":
+		return nil, nil
+	case ProviderOpenAI:
+		return NewOpenAI(config), nil
+	case ProviderCompatible:
+		if config.BaseURL == "" {
+			return nil, errors.New("compatible answerer requires a base URL")
+		}
+		return NewCompatible(config), nil
+	case ProviderStub:
+		return &Stub{Text: "stub answer"}, nil
+	default:
+		return nil, fmt.Errorf("unsupported answerer provider %q", config.Provider)
+	}
+}
+
+// Stub 返回固定内容的 Answerer, 用于测试和本地开发
+type Stub struct {
+	Text string
+	Err  error
+}
+
+func (s *Stub) Answer(ctx context.Context, question string) (string, error) {
+	if s.Err != nil {
+		return "", s.Err
+	}
+	return s.Text, nil
+}
@@ -0,0 +1,73 @@
+package answerer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+)
+
+// Cached 将回答缓存在 Redis 中, 同一个问题同时只会生成一次
+//
+// 生成在后台继续, 不受调用方 deadline 的影响: 调用方超时后, 递归解析器重试时可以直接命中缓存
+type Cached struct {
+	rdb             *redis.Client
+	next            Answerer
+	ttl             time.Duration
+	generateTimeout time.Duration
+	group           singleflight.Group
+}
+
+func NewCached(rdb *redis.Client, next Answerer, ttl, generateTimeout time.Duration) *Cached {
+	return &Cached{
+		rdb:             rdb,
+		next:            next,
+		ttl:             ttl,
+		generateTimeout: generateTimeout,
+	}
+}
+
+func (c *Cached) Answer(ctx context.Context, question string) (string, error) {
+	key := cacheKey(question)
+	text, err := c.rdb.Get(ctx, key).Result()
+	if err == nil {
+		return text, nil
+	}
+	if !errors.Is(err, redis.Nil) {
+		slog.Warn("failed to get cached answer", "error", err)
+	}
+	ch := c.group.DoChan(key, func() (any, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.generateTimeout)
+		defer cancel()
+		text, err := c.next.Answer(ctx, question)
+		if err != nil {
+			return "", err
+		}
+		if err := c.rdb.Set(ctx, key, text, c.ttl).Err(); err != nil {
+			slog.Warn("failed to cache answer", "error", err)
+		}
+		return text, nil
+	})
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case result := <-ch:
+		if result.Err != nil {
+			return "", result.Err
+		}
+		return result.Val.(string), nil
+	}
+}
+
+// cacheKey 忽略大小写和多余的空白, 相同的问题使用同一个缓存
+func cacheKey(question string) string {
+	normalized := strings.Join(strings.Fields(strings.ToLower(question)), " ")
+	sum := sha256.Sum256([]byte(normalized))
+	return "ask:answer:" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,87 @@
+package answerer
+
+import (
+	"context"
+	"strings"
+
+	"github.com/openai/openai-go"
+	"github.com/openai/openai-go/option"
+	"github.com/openai/openai-go/responses"
+	"github.com/samber/lo"
+)
+
+// OpenAI 使用 OpenAI Responses API 生成回答
+type OpenAI struct {
+	client openai.Client
+	config Config
+}
+
+func NewOpenAI(config Config) *OpenAI {
+	opts := make([]option.RequestOption, 0)
+	if config.APIKey != "" {
+		opts = append(opts, option.WithAPIKey(config.APIKey))
+	}
+	if config.BaseURL != "" {
+		opts = append(opts, option.WithBaseURL(config.BaseURL))
+	}
+	// 由调用方的 deadline 控制超时, 不在 SDK 内部重试
+	opts = append(opts, option.WithMaxRetries(0))
+	return &OpenAI{client: openai.NewClient(opts...), config: config}
+}
+
+func (a *OpenAI) Answer(ctx context.Context, question string) (string, error) {
+	response, err := a.client.Responses.New(ctx, responses.ResponseNewParams{
+		Model:        a.config.Model,
+		Instructions: openai.String(a.config.Instructions),
+		Input: responses.ResponseNewParamsInputUnion{
+			OfString: openai.String(question),
+		},
+		MaxOutputTokens: openai.Int(a.config.MaxOutputTokens),
+	})
+	if err != nil {
+		return "", err
+	}
+	text := strings.TrimSpace(response.OutputText())
+	if text == "" {
+		return "", ErrEmptyAnswer
+	}
+	return text, nil
+}
+
+// Compatible 使用 Chat Completions API 访问 OpenAI 兼容的服务, 大多数本地推理服务只实现了这个接口
+type Compatible struct {
+	client openai.Client
+	config Config
+}
+
+func NewCompatible(config Config) *Compatible {
+	// 本地服务通常不校验 key, 但 SDK 要求有值
+	opts := []option.RequestOption{
+		option.WithBaseURL(config.BaseURL),
+		option.WithAPIKey(lo.CoalesceOrEmpty(config.APIKey, "none")),
+		option.WithMaxRetries(0),
+	}
+	return &Compatible{client: openai.NewClient(opts...), config: config}
+}
+
+func (a *Compatible) Answer(ctx context.Context, question string) (string, error) {
+	completion, err := a.client.Chat.Completions.New(ctx, openai.ChatCompletionNewParams{
+		Model: a.config.Model,
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			openai.SystemMessage(a.config.Instructions),
+			openai.UserMessage(question),
+		},
+		MaxTokens: openai.Int(a.config.MaxOutputTokens),
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(completion.Choices) == 0 {
+		return "", ErrEmptyAnswer
+	}
+	text := strings.TrimSpace(completion.Choices[0].Message.Content)
+	if text == "" {
+		return "", ErrEmptyAnswer
+	}
+	return text, nil
+}
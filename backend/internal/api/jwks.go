@@ -0,0 +1,17 @@
+package api
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+)
+
+// jwks 公开 access token 的验证公钥, 只使用 JWT_SECRET 时为空
+func (s *Server) jwks(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/jwk-set+json")
+	// 轮换密钥时新密钥会先出现在这里, 缓存时间不需要太长
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	if err := json.NewEncoder(w).Encode(s.jwt.JWKS()); err != nil {
+		slog.Error("failed to write jwks", "error", err)
+	}
+}
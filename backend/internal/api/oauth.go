@@ -0,0 +1,129 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"dnsarc/internal/oauth"
+	"dnsarc/internal/services"
+)
+
+// oauthLogin 开始第三方登录, state 和 cookie 由 GetLoginURL / GetLinkURL 写入, 这里只检查 cookie 后跳转到 provider
+//
+// 不在这里写入 cookie, 否则把其他人生成的登录地址发给受害者就可以让受害者的浏览器完成攻击者的登录流程
+func (s *Server) oauthLogin(w http.ResponseWriter, r *http.Request) {
+	provider, ok := s.providers.Get(chi.URLParam(r, "provider"))
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	id := r.URL.Query().Get("state")
+	if !stateCookieMatches(r, id) {
+		slog.Warn("oauth state does not match cookie", "provider", provider.Name())
+		s.redirectAuthError(w, r, "login session expired, please try again")
+		return
+	}
+	state, err := oauth.NewStore(s.rdb).GetLogin(r.Context(), id)
+	if err != nil || state.Provider != provider.Name() {
+		slog.Warn("invalid oauth state", "provider", provider.Name(), "error", err)
+		s.redirectAuthError(w, r, "login session expired, please try again")
+		return
+	}
+	http.Redirect(w, r, provider.AuthCodeURL(id, state.Verifier, state.Nonce), http.StatusFound)
+}
+
+// oauthCallback 处理第三方登录的回调, 成功后跳转到前端并带上一次性 code, 由前端通过 RPC 换取 token
+func (s *Server) oauthCallback(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), time.Second*30)
+	defer cancel()
+	name := chi.URLParam(r, "provider")
+	provider, ok := s.providers.Get(name)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	query := r.URL.Query()
+	// state 必须和开始登录时写入的 cookie 一致, 回调地址被发给其他人时无法完成登录
+	match := stateCookieMatches(r, query.Get("state"))
+	http.SetCookie(w, oauth.StateCookie(provider, "", -1))
+	if !match {
+		slog.Warn("oauth state does not match cookie", "provider", name)
+		s.redirectAuthError(w, r, "login session expired, please try again")
+		return
+	}
+	store := oauth.NewStore(s.rdb)
+	state, err := store.TakeLogin(ctx, query.Get("state"))
+	if err != nil || state.Provider != provider.Name() {
+		slog.Warn("invalid oauth state", "provider", name, "error", err)
+		s.redirectAuthError(w, r, "login session expired, please try again")
+		return
+	}
+	// 用户在 provider 拒绝授权等情况
+	if e := query.Get("error"); e != "" {
+		slog.Info("oauth login failed", "provider", name, "error", e, "description", query.Get("error_description"))
+		s.redirectAuthError(w, r, "login was cancelled")
+		return
+	}
+	identity, err := provider.Exchange(ctx, query.Get("code"), state.Verifier, state.Nonce)
+	if err != nil {
+		slog.Error("failed to exchange oauth code", "provider", name, "error", err)
+		s.redirectAuthError(w, r, "failed to sign in with "+provider.DisplayName())
+		return
+	}
+
+	// 绑定需要已登录的用户在前端确认, 避免把其他人的身份绑定到自己的账号
+	if state.LinkUserID != "" {
+		code, err := store.IssueCode(ctx, &oauth.AuthCode{LinkUserID: state.LinkUserID, Identity: identity})
+		if err != nil {
+			slog.Error("failed to issue link code", "provider", name, "error", err)
+			s.redirect(w, r, "/dash/account", url.Values{"error": {"failed to link account"}})
+			return
+		}
+		s.redirect(w, r, "/dash/account", url.Values{"link_code": {code}})
+		return
+	}
+
+	user, err := services.NewIdentityService(s.db).UserForIdentity(ctx, identity)
+	if err != nil {
+		slog.Error("failed to sign in with identity", "provider", name, "error", err)
+		s.redirectAuthError(w, r, publicError(err, oauth.ErrEmailNotVerified, "failed to sign in with "+provider.DisplayName()))
+		return
+	}
+	code, err := store.IssueCode(ctx, &oauth.AuthCode{UserID: user.ID})
+	if err != nil {
+		slog.Error("failed to issue auth code", "error", err)
+		s.redirectAuthError(w, r, "failed to sign in")
+		return
+	}
+	s.redirect(w, r, "/auth/callback", url.Values{"code": {code}})
+}
+
+// stateCookieMatches 检查请求带有开始登录时写入的 state cookie
+func stateCookieMatches(r *http.Request, state string) bool {
+	cookie, err := r.Cookie(oauth.StateCookieName)
+	return err == nil && state != "" && subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(state)) == 1
+}
+
+func (s *Server) redirectAuthError(w http.ResponseWriter, r *http.Request, message string) {
+	s.redirect(w, r, "/auth", url.Values{"error": {message}})
+}
+
+func (s *Server) redirect(w http.ResponseWriter, r *http.Request, path string, query url.Values) {
+	http.Redirect(w, r, fmt.Sprintf("%s%s?%s", s.config.FrontendURL, path, query.Encode()), http.StatusSeeOther)
+}
+
+// publicError 只有预期的错误才展示给用户, 其他错误使用 fallback
+func publicError(err, expected error, fallback string) string {
+	if errors.Is(err, expected) {
+		return expected.Error()
+	}
+	return fallback
+}
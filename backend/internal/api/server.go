@@ -2,84 +2,180 @@ package api
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
 	"log/slog"
-	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"connectrpc.com/connect"
+	"connectrpc.com/otelconnect"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/cors"
+	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/redis/go-redis/v9"
+	"github.com/samber/lo"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
-	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/google"
 	"gorm.io/gorm"
 
+	"dnsarc/gen/analytics/v1/analyticsv1connect"
+	"dnsarc/gen/apitoken/v1/apitokenv1connect"
 	"dnsarc/gen/auth/v1/authv1connect"
 	"dnsarc/gen/dns_record/v1/dns_recordv1connect"
+	"dnsarc/gen/grant/v1/grantv1connect"
+	"dnsarc/gen/mfa/v1/mfav1connect"
+	"dnsarc/gen/organization/v1/organizationv1connect"
 	"dnsarc/gen/zone/v1/zonev1connect"
 	"dnsarc/internal/database"
-	"dnsarc/internal/event"
 	"dnsarc/internal/handlers"
 	"dnsarc/internal/interceptors"
+	"dnsarc/internal/mail"
+	"dnsarc/internal/metrics"
 	"dnsarc/internal/models"
+	"dnsarc/internal/oauth"
+	"dnsarc/internal/resolver"
 	"dnsarc/internal/services"
+	"dnsarc/internal/tracing"
 )
 
 type Server struct {
-	db     *gorm.DB
-	rdb    *redis.Client
-	config *Config
+	db       *gorm.DB
+	rdb      *redis.Client
+	resolver *resolver.Resolver
+	config   *Config
+	mailer   mail.Sender
+	jwt      *services.JwtService
+	// webAuthn 为空时不支持安全密钥
+	webAuthn *webauthn.WebAuthn
+	// 启用的第三方登录
+	providers *oauth.Registry
+
+	shutdownTracing func(context.Context) error
 }
 
 type Config struct {
 	DatabaseURL string
 	RedisURL    string
 	JwtSecret   string
-	Port        string
-	DNSCacheURL string
+	// JwtSigningKeys 逗号分隔的 PEM 私钥文件, 第一个用于签名 access token; 为空时使用 JwtSecret 签名 HS256
+	JwtSigningKeys string
+	Port           string
+	DNSCacheURL    string
 
-	GoogleClientID     string
-	GoogleClientSecret string
-	GoogleRedirectURL  string
-	FrontendURL        string
+	// AuthProviders 逗号分隔的第三方登录, 如 google,github,okta; 每个 provider 从 <NAME>_CLIENT_ID 等环境变量读取配置
+	AuthProviders string
+	// APIURL API 服务的外部地址, 用于生成默认的回调地址 <API_URL>/auth/<provider>/callback
+	APIURL      string
+	FrontendURL string
 
 	NS1 string
 	NS2 string
+
+	ResolverUpstreams   string
+	ResolverTimeout     string
+	ResolverRootServers string
+
+	OTLPEndpoint string // OTLP/HTTP collector 地址, 为空时不导出 trace
+
+	MailSender   string // smtp, file 或 log, 必须设置
+	MailFrom     string
+	MailDir      string // file sender 写入的目录
+	SMTPAddr     string // host:port
+	SMTPUsername string
+	SMTPPassword string
+}
+
+// LogValue 日志中不输出密钥和密码, 连接串只保留地址
+func (c *Config) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("database_url", redactURL(c.DatabaseURL)),
+		slog.String("redis_url", redactURL(c.RedisURL)),
+		slog.Bool("jwt_secret", c.JwtSecret != ""),
+		slog.String("jwt_signing_keys", c.JwtSigningKeys),
+		slog.String("port", c.Port),
+		slog.String("dns_cache_url", redactURL(c.DNSCacheURL)),
+		slog.String("auth_providers", c.AuthProviders),
+		slog.String("api_url", c.APIURL),
+		slog.String("frontend_url", c.FrontendURL),
+		slog.String("ns1", c.NS1),
+		slog.String("ns2", c.NS2),
+		slog.String("resolver_upstreams", c.ResolverUpstreams),
+		slog.String("resolver_timeout", c.ResolverTimeout),
+		slog.String("resolver_root_servers", c.ResolverRootServers),
+		slog.String("otlp_endpoint", c.OTLPEndpoint),
+		slog.String("mail_sender", c.MailSender),
+		slog.String("mail_from", c.MailFrom),
+		slog.String("mail_dir", c.MailDir),
+		slog.String("smtp_addr", c.SMTPAddr),
+		slog.String("smtp_username", c.SMTPUsername),
+		slog.Bool("smtp_password", c.SMTPPassword != ""),
+	)
+}
+
+// redactURL 隐藏 URL 中的密码, 不是 URL 的连接串 (如 key=value 形式的 DSN) 整个隐藏
+func redactURL(s string) string {
+	if s == "" {
+		return ""
+	}
+	if u, err := url.Parse(s); err == nil && u.Scheme != "" && u.Host != "" {
+		return u.Redacted()
+	}
+	return "[redacted]"
 }
 
 func NewServer() *Server {
 	config := &Config{
-		DatabaseURL: os.Getenv("DATABASE_URL"),
-		RedisURL:    os.Getenv("REDIS_URL"),
-		JwtSecret:   os.Getenv("JWT_SECRET"),
-		Port:        "8080",
-		DNSCacheURL: os.Getenv("DNS_CACHE_URL"), // 从环境变量读取
-
-		GoogleClientID:     os.Getenv("GOOGLE_CLIENT_ID"),
-		GoogleClientSecret: os.Getenv("GOOGLE_CLIENT_SECRET"),
-		GoogleRedirectURL:  os.Getenv("GOOGLE_REDIRECT_URL"),
-		FrontendURL:        os.Getenv("FRONTEND_URL"),
+		DatabaseURL:    os.Getenv("DATABASE_URL"),
+		RedisURL:       os.Getenv("REDIS_URL"),
+		JwtSecret:      os.Getenv("JWT_SECRET"),
+		JwtSigningKeys: os.Getenv("JWT_SIGNING_KEYS"),
+		Port:           "8080",
+		DNSCacheURL:    os.Getenv("DNS_CACHE_URL"), // 从环境变量读取
+
+		// 兼容只配置了 GOOGLE_CLIENT_ID 的部署
+		AuthProviders: lo.Ternary(os.Getenv("AUTH_PROVIDERS") == "" && os.Getenv("GOOGLE_CLIENT_ID") != "", oauth.ProviderGoogle, os.Getenv("AUTH_PROVIDERS")),
+		APIURL:        os.Getenv("API_URL"),
+		FrontendURL:   os.Getenv("FRONTEND_URL"),
 
 		NS1: os.Getenv("NS1"),
 		NS2: os.Getenv("NS2"),
+
+		ResolverUpstreams:   os.Getenv("RESOLVER_UPSTREAMS"),
+		ResolverTimeout:     os.Getenv("RESOLVER_TIMEOUT"),
+		ResolverRootServers: os.Getenv("RESOLVER_ROOT_SERVERS"),
+
+		OTLPEndpoint: os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"),
+
+		MailSender:   os.Getenv("MAIL_SENDER"),
+		MailFrom:     os.Getenv("MAIL_FROM"),
+		MailDir:      os.Getenv("MAIL_DIR"),
+		SMTPAddr:     os.Getenv("SMTP_ADDR"),
+		SMTPUsername: os.Getenv("SMTP_USERNAME"),
+		SMTPPassword: os.Getenv("SMTP_PASSWORD"),
 	}
 
 	slog.Info("config", "config", config)
 
+	shutdownTracing, err := tracing.Setup(context.Background(), "dnsarc-api", config.OTLPEndpoint)
+	if err != nil {
+		slog.Error("failed to set up tracing", "error", err)
+		os.Exit(1)
+	}
+
 	db, err := database.NewDatabase(config.DatabaseURL)
 	if err != nil {
 		slog.Error("failed to connect to database", "error", err)
 		os.Exit(1)
 	}
+	// 数据迁移只在 API 中执行, DNS 服务器只连接数据库
+	if err := database.Migrate(context.Background(), db); err != nil {
+		slog.Error("failed to migrate database", "error", err)
+		os.Exit(1)
+	}
 
 	rdb, err := database.NewRedis(config.RedisURL)
 	if err != nil {
@@ -87,27 +183,63 @@ func NewServer() *Server {
 		os.Exit(1)
 	}
 
-	return &Server{
-		db:     db,
-		rdb:    rdb,
-		config: config,
+	resolverConfig, err := resolver.ParseConfig(config.ResolverUpstreams, config.ResolverTimeout, config.ResolverRootServers)
+	if err != nil {
+		slog.Error("failed to parse resolver config", "error", err)
+		os.Exit(1)
+	}
+	res, err := resolver.New(resolverConfig)
+	if err != nil {
+		slog.Error("failed to create resolver", "error", err)
+		os.Exit(1)
 	}
-}
 
-func (s *Server) googleOauthConf() *oauth2.Config {
-	return &oauth2.Config{
-		ClientID:     s.config.GoogleClientID,
-		ClientSecret: s.config.GoogleClientSecret,
-		RedirectURL:  s.config.GoogleRedirectURL,
-		Scopes: []string{
-			"https://www.googleapis.com/auth/userinfo.email",
-		},
-		Endpoint: google.Endpoint,
+	mailer, err := mail.New(mail.Config{
+		Sender:       config.MailSender,
+		From:         config.MailFrom,
+		Dir:          config.MailDir,
+		SMTPAddr:     config.SMTPAddr,
+		SMTPUsername: config.SMTPUsername,
+		SMTPPassword: config.SMTPPassword,
+	})
+	if err != nil {
+		slog.Error("failed to create mail sender", "error", err)
+		os.Exit(1)
+	}
+
+	signingKeys, err := services.LoadSigningKeys(config.JwtSigningKeys)
+	if err != nil {
+		slog.Error("failed to load jwt signing keys", "error", err)
+		os.Exit(1)
+	}
+	if config.JwtSecret == "" && len(signingKeys) == 0 {
+		slog.Error("JWT_SECRET or JWT_SIGNING_KEYS is required")
+		os.Exit(1)
+	}
+
+	// 安全密钥绑定到前端的域名, 没有配置 FRONTEND_URL 时只能使用 TOTP
+	webAuthn, err := services.NewWebAuthn(config.FrontendURL)
+	if err != nil {
+		slog.Warn("security keys are disabled", "error", err)
 	}
-}
 
-func (s *Server) jwtService() *services.JwtService {
-	return services.NewJwtService(s.config.JwtSecret)
+	providers, err := oauth.NewRegistry(context.Background(), config.AuthProviders, os.Getenv, config.APIURL)
+	if err != nil {
+		slog.Error("failed to configure auth providers", "error", err)
+		os.Exit(1)
+	}
+
+	return &Server{
+		db:              db,
+		rdb:             rdb,
+		resolver:        res,
+		config:          config,
+		mailer:          mailer,
+		jwt:             services.NewJwtService(config.JwtSecret, signingKeys),
+		webAuthn:        webAuthn,
+		providers:       providers,
+		shutdownTracing: shutdownTracing,
+	}
 }
 
 func (s *Server) Start() error {
@@ -115,7 +247,7 @@ func (s *Server) Start() error {
 	r := chi.NewRouter()
 
 	// 添加 CORS 中间件
-	r.Use(cors.AllowAll().Handler)
+	r.Use(corsHandler(s.config.FrontendURL))
 
 	// 添加基本路由
 	s.setupRoutes(r)
@@ -158,6 +290,10 @@ func (s *Server) Start() error {
 			return err
 		}
 
+		if err := s.shutdownTracing(ctx); err != nil {
+			slog.Error("failed to flush traces", "error", err)
+		}
+
 		slog.Info("server exited")
 		return nil
 	}
@@ -171,107 +307,98 @@ func (s *Server) setupRoutes(r chi.Router) {
 			slog.Error("failed to write health response", "error", err)
 		}
 	})
-	// google oatuh auth/google/callback
-	r.Get("/auth/google/callback", func(w http.ResponseWriter, r *http.Request) {
-		slog.Info("google oauth callback", "url", r.URL)
-		tok, err := s.googleOauthConf().Exchange(context.Background(), r.URL.Query().Get("code"))
-		if err != nil {
-			slog.Error("failed to exchange code", "error", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-		client := s.googleOauthConf().Client(context.Background(), tok)
-		resp, err := client.Get("https://www.googleapis.com/oauth2/v3/userinfo")
-		if err != nil {
-			slog.Error("failed to get user info", "error", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-		defer func() {
-			if err := resp.Body.Close(); err != nil {
-				slog.Error("failed to close response body", "error", err)
-			}
-		}()
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			slog.Error("failed to read user info", "error", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-		type UserInfo struct {
-			Sub           string `json:"sub"`
-			Picture       string `json:"picture"`
-			Email         string `json:"email"`
-			EmailVerified bool   `json:"email_verified"`
-		}
-		var data UserInfo
-		if err := json.Unmarshal(body, &data); err != nil {
-			slog.Error("failed to unmarshal user info", "error", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-
-		if !data.EmailVerified {
-			slog.Error("email not verified", "email", data.Email)
-			w.WriteHeader(http.StatusUnauthorized)
-			return
-		}
+	// 第三方登录, 如 /auth/google/login 和 /auth/google/callback
+	r.Get("/auth/{provider}/login", s.oauthLogin)
+	r.Get("/auth/{provider}/callback", s.oauthCallback)
+	// access token 的验证公钥
+	r.Get("/.well-known/jwks.json", s.jwks)
+	// Prometheus 指标
+	r.Handle("/metrics", metrics.Handler())
+	metricsInterceptor := interceptors.NewMetricsInterceptor()
+	// 指标由 Prometheus 导出, 这里只需要 trace
+	traceInterceptor, err := otelconnect.NewInterceptor(otelconnect.WithoutMetrics())
+	if err != nil {
+		slog.Error("failed to create trace interceptor", "error", err)
+		os.Exit(1)
+	}
+	apiTokenService := services.NewAPITokenService(s.db)
+	sessionService := services.NewSessionService(s.db, s.rdb, s.jwt)
+	mfaService := services.NewMFAService(s.db, s.rdb, s.webAuthn)
+	authz := services.NewAuthorizer(s.db)
+	organizationService := services.NewOrganizationService(s.db)
+	authInterceptor := interceptors.NewAuthInterceptor(s.jwt, apiTokenService, sessionService, mfaService)
+	authHandler := handlers.NewAuthHandler(s.db, s.rdb, sessionService, mfaService, s.providers, oauth.NewStore(s.rdb), services.NewUserTokenService(s.db), s.mailer, s.config.FrontendURL)
+	r.Mount(authv1connect.NewAuthServiceHandler(authHandler, connect.WithInterceptors(traceInterceptor, metricsInterceptor, authInterceptor)))
+	zoneHandler := handlers.NewZoneHandler(s.db, s.rdb, authz, organizationService, services.NewZoneVerifier(s.resolver), s.zoneChecker())
+	r.Mount(zonev1connect.NewZoneServiceHandler(zoneHandler, connect.WithInterceptors(traceInterceptor, metricsInterceptor, authInterceptor)))
+	dnsRecordHandler := handlers.NewDNSRecordHandler(s.db, s.rdb, authz)
+	r.Mount(dns_recordv1connect.NewDNSRecordServiceHandler(dnsRecordHandler, connect.WithInterceptors(traceInterceptor, metricsInterceptor, authInterceptor)))
+	analyticsHandler := handlers.NewAnalyticsHandler(s.db, authz)
+	r.Mount(analyticsv1connect.NewAnalyticsServiceHandler(analyticsHandler, connect.WithInterceptors(traceInterceptor, metricsInterceptor, authInterceptor)))
+	apiTokenHandler := handlers.NewAPITokenHandler(s.db, authz, apiTokenService)
+	r.Mount(apitokenv1connect.NewApiTokenServiceHandler(apiTokenHandler, connect.WithInterceptors(traceInterceptor, metricsInterceptor, authInterceptor)))
+	mfaHandler := handlers.NewMFAHandler(s.db, mfaService)
+	r.Mount(mfav1connect.NewMFAServiceHandler(mfaHandler, connect.WithInterceptors(traceInterceptor, metricsInterceptor, authInterceptor)))
+	organizationHandler := handlers.NewOrganizationHandler(s.db, authz, organizationService, s.mailer, s.config.FrontendURL)
+	r.Mount(organizationv1connect.NewOrganizationServiceHandler(organizationHandler, connect.WithInterceptors(traceInterceptor, metricsInterceptor, authInterceptor)))
+	recordGrantHandler := handlers.NewRecordGrantHandler(s.db, authz)
+	r.Mount(grantv1connect.NewRecordGrantServiceHandler(recordGrantHandler, connect.WithInterceptors(traceInterceptor, metricsInterceptor, authInterceptor)))
+}
 
-		// 检查 email 是否存在
-		var user models.User
-		if err := s.db.Where("email = ?", data.Email).First(&user).Error; err != nil {
-			if err == gorm.ErrRecordNotFound {
-				// 用户不存在，创建新用户
-				user = models.User{
-					Email:     data.Email,
-					Avatar:    data.Picture,
-					CreatedAt: time.Now(),
-					UpdatedAt: time.Now(),
-				}
-				if err := s.db.Create(&user).Error; err != nil {
-					slog.Error("failed to create user", "error", err)
-					w.WriteHeader(http.StatusInternalServerError)
-					return
-				}
-			} else {
-				slog.Error("failed to get user", "error", err)
-				w.WriteHeader(http.StatusInternalServerError)
-				return
-			}
-		}
-		// 生成 token
-		jwtService := s.jwtService()
-		token, err := jwtService.GenerateToken(user.ID, time.Hour*24*30)
-		if err != nil {
-			slog.Error("failed to generate token", "error", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			return
-		}
-		redirectUrl := fmt.Sprintf("%s/auth?token=%s", s.config.FrontendURL, token)
-		w.Header().Set("Location", redirectUrl)
-		w.WriteHeader(http.StatusSeeOther)
-	})
-	authInterceptor := interceptors.NewAuthInterceptor(s.jwtService())
-	authHandler := handlers.NewAuthHandler(s.db, s.jwtService(), s.googleOauthConf())
-	r.Mount(authv1connect.NewAuthServiceHandler(authHandler, connect.WithInterceptors(authInterceptor)))
-	zoneHandler := handlers.NewZoneHandler(s.db, s.rdb)
-	r.Mount(zonev1connect.NewZoneServiceHandler(zoneHandler, connect.WithInterceptors(authInterceptor)))
-	dnsRecordHandler := handlers.NewDNSRecordHandler(s.db, s.rdb)
-	r.Mount(dns_recordv1connect.NewDNSRecordServiceHandler(dnsRecordHandler, connect.WithInterceptors(authInterceptor)))
+func (s *Server) zoneChecker() *services.ZoneChecker {
+	return services.NewZoneChecker(s.db, s.rdb, s.resolver, s.config.NS1, s.config.NS2)
 }
 
+// zoneCheckWorkers 同时检查的 zone 数量, 每次检查都会从根开始迭代查询
+const zoneCheckWorkers = 8
+
 func (s *Server) startZoneChecker() {
 	slog.Info("starting periodic zone checker")
+	checker := s.zoneChecker()
 
 	check := func() {
-		slog.Info("running check for inactive zones")
-		var inActiveZones []models.Zone
-		if err := s.db.Where("is_active = ?", false).Find(&inActiveZones).Error; err != nil {
-			slog.Error("failed to get inactive zones", "error", err)
+		slog.Info("running zone check")
+		// 未激活的 zone 每次都检查, active 的 zone 每小时重新检查一次委派
+		var zones []models.Zone
+		if err := s.db.Where("status IN ?", []models.ZoneStatus{models.ZoneStatusPending, models.ZoneStatusNSMismatch, models.ZoneStatusDeactivated}).
+			Or("status = ? AND (last_checked_at IS NULL OR last_checked_at < ?)", models.ZoneStatusActive, time.Now().Add(-time.Hour)).
+			Find(&zones).Error; err != nil {
+			slog.Error("failed to get zones to check", "error", err)
 			return
 		}
-		for _, zone := range inActiveZones {
-			go s.checkAndUpdateZone(zone)
+		// 固定数量的 worker, 等所有 zone 检查完再开始下一轮
+		jobs := make(chan *models.Zone)
+		var wg sync.WaitGroup
+		for range min(zoneCheckWorkers, len(zones)) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for zone := range jobs {
+					ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+					if _, err := checker.Check(ctx, zone); err != nil {
+						slog.Error("failed to check zone", "zone", zone.ZoneName, "error", err)
+					}
+					cancel()
+				}
+			}()
+		}
+		for i := range zones {
+			jobs <- &zones[i]
+		}
+		close(jobs)
+		wg.Wait()
+		// 检查历史只保留 30 天
+		if err := s.db.Where("checked_at < ?", time.Now().Add(-time.Hour*24*30)).Delete(&models.ZoneCheck{}).Error; err != nil {
+			slog.Error("failed to prune zone checks", "error", err)
+		}
+		if err := services.NewUserTokenService(s.db).Prune(context.Background()); err != nil {
+			slog.Error("failed to prune user tokens", "error", err)
+		}
+		if err := services.NewSessionService(s.db, s.rdb, s.jwt).Prune(context.Background()); err != nil {
+			slog.Error("failed to prune sessions", "error", err)
+		}
+		if err := services.NewOrganizationService(s.db).Prune(context.Background()); err != nil {
+			slog.Error("failed to prune invitations", "error", err)
 		}
 	}
 
@@ -285,32 +412,28 @@ func (s *Server) startZoneChecker() {
 	}
 }
 
-func (s *Server) checkAndUpdateZone(zone models.Zone) {
-	ns, err := net.LookupNS(zone.ZoneName)
-	if err != nil {
-		slog.Warn("failed to lookup ns", "zone", zone.ZoneName, "error", err)
-		return
+// corsHandler 允许所有来源调用 API, 只有前端的请求可以带 credentials, 用于写入 OAuth state cookie
+func corsHandler(frontendURL string) func(http.Handler) http.Handler {
+	frontendOrigin := ""
+	if u, err := url.Parse(frontendURL); err == nil && u.Host != "" {
+		frontendOrigin = u.Scheme + "://" + u.Host
 	}
-	if len(ns) > 0 {
-		valid := false
-		for _, n := range ns {
-			if n.Host == s.config.NS1 || n.Host == s.config.NS2 {
-				valid = true
-				break
-			}
-		}
-		if valid {
-			slog.Info("zone ns found, activating", "zone", zone.ZoneName, "ns", ns)
-			if err := s.db.Model(&zone).Update("is_active", true).Error; err != nil {
-				slog.Error("failed to update zone status", "zone", zone.ZoneName, "error", err)
+	frontend := cors.New(cors.Options{
+		AllowedOrigins:   []string{frontendOrigin},
+		AllowedMethods:   []string{http.MethodHead, http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete},
+		AllowedHeaders:   []string{"*"},
+		AllowCredentials: true,
+	})
+	others := cors.AllowAll()
+	return func(next http.Handler) http.Handler {
+		frontendNext := frontend.Handler(next)
+		othersNext := others.Handler(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if frontendOrigin != "" && r.Header.Get("Origin") == frontendOrigin {
+				frontendNext.ServeHTTP(w, r)
+				return
 			}
-			// active 的时候才发布事件
-			go func() {
-				event.PublishEvent(s.rdb, event.Event{
-					Type:     event.EventTypeZoneCreate,
-					ZoneName: zone.ZoneName,
-				})
-			}()
-		}
+			othersNext.ServeHTTP(w, r)
+		})
 	}
 }
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestConfigLogValue(t *testing.T) {
+	config := &Config{
+		DatabaseURL:  "postgres://dnsarc:db-secret@db:5432/dnsarc",
+		RedisURL:     "redis://:redis-secret@redis:6379/0",
+		JwtSecret:    "jwt-secret",
+		DNSCacheURL:  "host=db password=dsn-secret",
+		SMTPAddr:     "smtp.example.com:587",
+		SMTPUsername: "mailer",
+		SMTPPassword: "smtp-secret",
+	}
+	var buf bytes.Buffer
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("config", "config", config)
+	out := buf.String()
+	for _, secret := range []string{"db-secret", "redis-secret", "jwt-secret", "dsn-secret", "smtp-secret"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("log output contains %q: %s", secret, out)
+		}
+	}
+	for _, value := range []string{"db:5432", "redis:6379", "smtp.example.com:587", "mailer"} {
+		if !strings.Contains(out, value) {
+			t.Errorf("log output is missing %q: %s", value, out)
+		}
+	}
+}
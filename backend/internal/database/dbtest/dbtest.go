@@ -0,0 +1,120 @@
+// Package dbtest 提供不连接 PostgreSQL 的 gorm.DB, 用于测试通过 gorm 查询的服务
+package dbtest
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
+	"gorm.io/gorm/logger"
+)
+
+// DB 使用 DryRun 生成 SQL 但不执行, 查询按表名返回 Set 设置的数据, 不执行 WHERE 条件;
+// 测试只设置数据库对这次查询应该返回的行, 条件本身通过 Queries 中的 SQL 检查
+type DB struct {
+	*gorm.DB
+
+	mu      sync.Mutex
+	tables  map[string]any
+	errors  map[string]error
+	queries []string
+}
+
+func New(t testing.TB) *DB {
+	t.Helper()
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost dbname=dbtest"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+		Logger:               logger.Discard,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := &DB{DB: gormDB, tables: map[string]any{}, errors: map[string]error{}}
+	if err := gormDB.Callback().Query().Replace("gorm:query", db.query); err != nil {
+		t.Fatal(err)
+	}
+	for _, processor := range []interface {
+		Register(name string, fn func(*gorm.DB)) error
+	}{
+		gormDB.Callback().Create().After("gorm:create"),
+		gormDB.Callback().Update().After("gorm:update"),
+		gormDB.Callback().Delete().After("gorm:delete"),
+	} {
+		if err := processor.Register("dbtest:record", db.record); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return db
+}
+
+// Set 设置表的数据, rows 是模型的切片, 如 []models.Zone
+func (db *DB) Set(table string, rows any) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.tables[table] = rows
+}
+
+// SetError 查询表时返回 err, 模拟数据库不可用
+func (db *DB) SetError(table string, err error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.errors[table] = err
+}
+
+// Queries 返回执行过的 SQL, 参数已经替换到语句中
+func (db *DB) Queries() []string {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return append([]string(nil), db.queries...)
+}
+
+func (db *DB) record(tx *gorm.DB) {
+	if tx.Statement.SQL.Len() == 0 {
+		return
+	}
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.queries = append(db.queries, tx.Dialector.Explain(tx.Statement.SQL.String(), tx.Statement.Vars...))
+}
+
+func (db *DB) query(tx *gorm.DB) {
+	if tx.Error != nil {
+		return
+	}
+	callbacks.BuildQuerySQL(tx)
+	if tx.Error != nil {
+		return
+	}
+	db.record(tx)
+
+	db.mu.Lock()
+	rows := reflect.ValueOf(db.tables[tx.Statement.Table])
+	err := db.errors[tx.Statement.Table]
+	db.mu.Unlock()
+	if err != nil {
+		_ = tx.AddError(err)
+		return
+	}
+	n := 0
+	if rows.IsValid() {
+		n = rows.Len()
+	}
+	// 子查询和 Pluck 等目标类型和表的模型不同时只计数
+	if dest := reflect.ValueOf(tx.Statement.Dest); n > 0 && dest.Kind() == reflect.Pointer && !dest.IsNil() {
+		dest = dest.Elem()
+		switch {
+		case dest.Kind() == reflect.Slice && dest.Type() == rows.Type():
+			dest.Set(reflect.AppendSlice(dest.Slice(0, 0), rows))
+		case dest.Type() == rows.Type().Elem():
+			dest.Set(rows.Index(0))
+		}
+	}
+	tx.RowsAffected = int64(n)
+	if n == 0 && tx.Statement.RaiseErrorOnNotFound {
+		_ = tx.AddError(gorm.ErrRecordNotFound)
+	}
+}
@@ -0,0 +1,127 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+
+	"dnsarc/internal/models"
+)
+
+// migrationLockID 多个 API 实例同时启动时通过 advisory lock 保证迁移只执行一次
+const migrationLockID = 0x646e7361726300
+
+// migration 只执行一次的数据迁移, 版本号只增不改, 执行过的版本记录在 schema_migrations 中
+type migration struct {
+	version int
+	name    string
+	migrate func(tx *gorm.DB) error
+}
+
+type schemaMigration struct {
+	Version   int `gorm:"primaryKey;autoIncrement:false"`
+	Name      string
+	AppliedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+var migrations = []migration{
+	{1, "zone_status_from_is_active", migrateZoneStatus},
+	{2, "google_users_email_verified", migrateEmailVerified},
+	{3, "apex_cname_to_alias", migrateApexCNAME},
+	{4, "personal_organizations", migratePersonalOrganizations},
+	{5, "active_zone_name_index", migrateActiveZoneIndex},
+}
+
+// Migrate 按版本执行还没有执行过的数据迁移, 每个迁移和它的版本记录在同一个事务中; 只由 API 在启动时调用
+func Migrate(ctx context.Context, db *gorm.DB) error {
+	if err := db.WithContext(ctx).AutoMigrate(&schemaMigration{}); err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", migrationLockID).Error; err != nil {
+				return err
+			}
+			// 拿到锁之后再检查, 等待期间可能已经由其他实例执行
+			err := tx.Where("version = ?", m.version).First(&schemaMigration{}).Error
+			if err == nil {
+				return nil
+			}
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			slog.Info("running database migration", "version", m.version, "name", m.name)
+			if err := m.migrate(tx); err != nil {
+				return err
+			}
+			return tx.Create(&schemaMigration{Version: m.version, Name: m.name}).Error
+		})
+		if err != nil {
+			return fmt.Errorf("migration %d %s: %w", m.version, m.name, err)
+		}
+	}
+	return nil
+}
+
+// migrateZoneStatus 旧数据只有 is_active, 补上对应的 status
+func migrateZoneStatus(tx *gorm.DB) error {
+	return tx.Model(&models.Zone{}).Where("is_active = ? AND status <> ?", true, models.ZoneStatusActive).Update("status", models.ZoneStatusActive).Error
+}
+
+// migrateEmailVerified 没有密码的用户都是通过 Google 登录创建的, 邮箱已经由 Google 验证
+func migrateEmailVerified(tx *gorm.DB) error {
+	return tx.Model(&models.User{}).Where("password = ? AND email_verified = ?", "", false).Update("email_verified", true).Error
+}
+
+// migrateApexCNAME apex 上的 CNAME 以前会被展平, 现在由 ALIAS 承担这个语义
+func migrateApexCNAME(tx *gorm.DB) error {
+	return tx.Model(&models.DNSRecord{}).Where("type = ? AND name = zone_name", models.RecordTypeCNAME).Update("type", models.RecordTypeALIAS).Error
+}
+
+// migratePersonalOrganizations 以前 zone 直接属于用户, 为这些用户创建个人组织并把 zone 移进去
+func migratePersonalOrganizations(tx *gorm.DB) error {
+	var userIDs []string
+	if err := tx.Model(&models.Zone{}).Where("organization_id = ? OR organization_id IS NULL", "").Distinct().Pluck("user_id", &userIDs).Error; err != nil {
+		return err
+	}
+	for _, userID := range userIDs {
+		var organization models.Organization
+		err := tx.Where("personal_user_id = ?", userID).First(&organization).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			organization = models.Organization{Name: models.PersonalOrganizationName, PersonalUserID: &userID}
+			if err := tx.Create(&organization).Error; err != nil {
+				return err
+			}
+			err = tx.Create(&models.OrganizationMember{OrganizationID: organization.ID, UserID: userID, Role: models.OrgRoleOwner}).Error
+		}
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Zone{}).Where("user_id = ? AND (organization_id = ? OR organization_id IS NULL)", userID, "").Update("organization_id", organization.ID).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateActiveZoneIndex 同一个 zone_name 只能有一个 active zone
+//
+// 以前检查和激活不在同一个事务中, 可能已经有多个 active, 只保留最近更新的一个
+func migrateActiveZoneIndex(tx *gorm.DB) error {
+	latest := tx.Raw("SELECT DISTINCT ON (zone_name) id FROM zones WHERE is_active ORDER BY zone_name, updated_at DESC")
+	if err := tx.Model(&models.Zone{}).Where("is_active = ? AND id NOT IN (?)", true, latest).Updates(map[string]any{
+		"is_active": false,
+		"status":    models.ZoneStatusDeactivated,
+	}).Error; err != nil {
+		return err
+	}
+	return tx.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_zones_active_zone_name ON zones (zone_name) WHERE is_active").Error
+}
@@ -0,0 +1,17 @@
+package database
+
+import "testing"
+
+func TestMigrationVersions(t *testing.T) {
+	names := map[string]bool{}
+	for i, m := range migrations {
+		// 版本号连续递增, 已经发布的版本不能重排或者删除
+		if m.version != i+1 {
+			t.Errorf("migration %s has version %d, want %d", m.name, m.version, i+1)
+		}
+		if m.name == "" || names[m.name] {
+			t.Errorf("migration %d has an empty or duplicate name %q", m.version, m.name)
+		}
+		names[m.name] = true
+	}
+}
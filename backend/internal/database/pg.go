@@ -6,16 +6,22 @@ import (
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
+	"dnsarc/internal/metrics"
 	"dnsarc/internal/models"
 )
 
+// NewDatabase 连接数据库并同步表结构, 只执行一次的数据迁移由 API 调用 Migrate 完成
 func NewDatabase(databaseURL string) (*gorm.DB, error) {
 	db, err := gorm.Open(postgres.Open(databaseURL), &gorm.Config{})
 	if err != nil {
 		return nil, err
 	}
+	// 只有使用 WithContext(ctx) 的查询才会挂在请求的 span 下面, 不记录参数避免泄露 token 等敏感数据
+	if err := db.Use(tracingPlugin{}); err != nil {
+		return nil, err
+	}
 
-	if err := db.AutoMigrate(&models.User{}, &models.Zone{}, &models.DNSRecord{}); err != nil {
+	if err := db.AutoMigrate(&models.User{}, &models.Zone{}, &models.ZoneCheck{}, &models.DNSRecord{}, &models.QueryStat{}, &models.APIToken{}, &models.UserToken{}, &models.UserIdentity{}, &models.Session{}, &models.WebAuthnCredential{}, &models.Organization{}, &models.OrganizationMember{}, &models.OrganizationInvitation{}, &models.RecordGrant{}); err != nil {
 		return nil, err
 	}
 
@@ -26,6 +32,7 @@ func NewDatabase(databaseURL string) (*gorm.DB, error) {
 	sqlDB.SetMaxIdleConns(10)
 	sqlDB.SetMaxOpenConns(100)
 	sqlDB.SetConnMaxLifetime(time.Hour)
+	metrics.RegisterDB(sqlDB)
 
 	return db, nil
 }
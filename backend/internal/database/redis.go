@@ -1,6 +1,7 @@
 package database
 
 import (
+	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -9,5 +10,9 @@ func NewRedis(redisURL string) (*redis.Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return redis.NewClient(opt), nil
+	rdb := redis.NewClient(opt)
+	if err := redisotel.InstrumentTracing(rdb); err != nil {
+		return nil, err
+	}
+	return rdb, nil
 }
@@ -0,0 +1,67 @@
+package database
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"dnsarc/internal/tracing"
+)
+
+const spanKey = "dnsarc:span"
+
+// tracingPlugin 为每条 SQL 创建一个 client span, 只记录 SQL 语句, 不记录参数
+type tracingPlugin struct{}
+
+func (tracingPlugin) Name() string {
+	return "dnsarc:tracing"
+}
+
+func (p tracingPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	return errors.Join(
+		cb.Create().Before("gorm:create").Register("dnsarc:before_create", p.before("gorm.create")),
+		cb.Create().After("gorm:create").Register("dnsarc:after_create", p.after),
+		cb.Query().Before("gorm:query").Register("dnsarc:before_query", p.before("gorm.query")),
+		cb.Query().After("gorm:query").Register("dnsarc:after_query", p.after),
+		cb.Update().Before("gorm:update").Register("dnsarc:before_update", p.before("gorm.update")),
+		cb.Update().After("gorm:update").Register("dnsarc:after_update", p.after),
+		cb.Delete().Before("gorm:delete").Register("dnsarc:before_delete", p.before("gorm.delete")),
+		cb.Delete().After("gorm:delete").Register("dnsarc:after_delete", p.after),
+		cb.Row().Before("gorm:row").Register("dnsarc:before_row", p.before("gorm.row")),
+		cb.Row().After("gorm:row").Register("dnsarc:after_row", p.after),
+		cb.Raw().Before("gorm:raw").Register("dnsarc:before_raw", p.before("gorm.raw")),
+		cb.Raw().After("gorm:raw").Register("dnsarc:after_raw", p.after),
+	)
+}
+
+func (tracingPlugin) before(name string) func(*gorm.DB) {
+	return func(tx *gorm.DB) {
+		ctx, span := tracing.Start(tx.Statement.Context, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+			attribute.String("db.system.name", "postgresql"),
+		))
+		tx.Statement.Context = ctx
+		tx.InstanceSet(spanKey, span)
+	}
+}
+
+func (tracingPlugin) after(tx *gorm.DB) {
+	value, ok := tx.InstanceGet(spanKey)
+	if !ok {
+		return
+	}
+	span := value.(trace.Span)
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("db.collection.name", tx.Statement.Table),
+		attribute.String("db.query.text", tx.Statement.SQL.String()),
+		attribute.Int64("db.response.returned_rows", tx.Statement.RowsAffected),
+	)
+	if tx.Error != nil && !errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(tx.Error)
+		span.SetStatus(codes.Error, tx.Error.Error())
+	}
+}
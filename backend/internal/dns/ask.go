@@ -0,0 +1,171 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/samber/lo"
+	"golang.org/x/net/idna"
+
+	"dnsarc/internal/answerer"
+)
+
+// askSettings TXT 问答功能的配置, 只对 ASK_SUFFIX 下的名称生效
+type askSettings struct {
+	suffix    string // 如 ask.dnsarc.com., 问题为 suffix 前面的 label
+	timeout   time.Duration
+	rateLimit int // 每个客户端网段每分钟的问题数, 0 表示不限制
+}
+
+// newAnswerer 根据配置创建带有 Redis 缓存的 Answerer, 没有配置 ASK_PROVIDER 时返回 nil
+func newAnswerer(config *Config, s *Server) (answerer.Answerer, *askSettings, error) {
+	inner, err := answerer.New(answerer.Config{
+		Provider: config.AskProvider,
+		Model:    config.AskModel,
+		BaseURL:  config.AskBaseURL,
+		APIKey:   config.AskAPIKey,
+	})
+	if err != nil || inner == nil {
+		return nil, nil, err
+	}
+	if config.AskSuffix == "" {
+		return nil, nil, errors.New("ASK_SUFFIX is required when ASK_PROVIDER is set")
+	}
+	settings := &askSettings{
+		suffix:    dns.Fqdn(strings.ToLower(config.AskSuffix)),
+		timeout:   time.Second * 3,
+		rateLimit: 10,
+	}
+	cacheTTL := time.Hour * 24
+	if config.AskTimeout != "" {
+		if settings.timeout, err = time.ParseDuration(config.AskTimeout); err != nil {
+			return nil, nil, fmt.Errorf("invalid ask timeout %q: %w", config.AskTimeout, err)
+		}
+	}
+	if config.AskCacheTTL != "" {
+		if cacheTTL, err = time.ParseDuration(config.AskCacheTTL); err != nil {
+			return nil, nil, fmt.Errorf("invalid ask cache TTL %q: %w", config.AskCacheTTL, err)
+		}
+	}
+	if config.AskRateLimit != "" {
+		if settings.rateLimit, err = strconv.Atoi(config.AskRateLimit); err != nil || settings.rateLimit < 0 {
+			return nil, nil, fmt.Errorf("invalid ask rate limit %q", config.AskRateLimit)
+		}
+	}
+	// 生成的时间可以超过单次查询的超时, 结果写入缓存后由重试的查询返回
+	return answerer.NewCached(s.rdb, inner, cacheTTL, time.Second*30), settings, nil
+}
+
+// isAskName 判断 name 是否是 suffix 下的问题
+func (s *Server) isAskName(name string) bool {
+	if s.answerer == nil {
+		return false
+	}
+	name = strings.ToLower(name)
+	return name != s.ask.suffix && dns.IsSubDomain(s.ask.suffix, name)
+}
+
+// handleAsk 将 suffix 前面的 label 作为问题, 生成的回答通过 TXT 记录返回
+func (s *Server) handleAsk(w dns.ResponseWriter, m *dns.Msg, q dns.Question) {
+	// 按连接的对端地址限流, ECS 由客户端填写, 每次换一个地址就可以绕过限制
+	if ip := remoteIP(w); ip != nil && !s.allowAsk(ip) {
+		slog.Warn("ask rate limited", "ip", ip)
+		m.Rcode = dns.RcodeRefused
+		setEDE(m, dns.ExtendedErrorCodeProhibited, "too many questions, slow down")
+		return
+	}
+	question := askQuestion(q.Name[:len(q.Name)-len(s.ask.suffix)])
+	ctx, cancel := context.WithTimeout(queryContext(w), s.ask.timeout)
+	defer cancel()
+	text, err := s.answerer.Answer(ctx, question)
+	if err != nil {
+		m.Rcode = dns.RcodeServerFailure
+		if errors.Is(err, context.DeadlineExceeded) {
+			slog.Info("answer is still being generated", "question", question)
+			setEDE(m, dns.ExtendedErrorCodeOther, "answer is being generated, retry shortly")
+			return
+		}
+		slog.Error("failed to answer question", "question", question, "error", err)
+		setEDE(m, dns.ExtendedErrorCodeOther, "answer backend unavailable")
+		return
+	}
+	m.Answer = append(m.Answer, &dns.TXT{
+		Hdr: dns.RR_Header{
+			Name:   q.Name,
+			Rrtype: dns.TypeTXT,
+			Class:  dns.ClassINET,
+			Ttl:    3600,
+		},
+		Txt: splitTXTRecord(text),
+	})
+}
+
+// allowAsk 按照客户端网段做每分钟的计数, 多个实例通过 Redis 共享
+func (s *Server) allowAsk(ip net.IP) bool {
+	if s.ask.rateLimit == 0 {
+		return true
+	}
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return true
+	}
+	addr = addr.Unmap()
+	network, err := addr.Prefix(lo.Ternary(addr.Is4(), 24, 56))
+	if err != nil {
+		return true
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	key := fmt.Sprintf("ask:rl:%s:%d", network.String(), time.Now().Unix()/60)
+	count, err := s.rdb.Incr(ctx, key).Result()
+	if err != nil {
+		slog.Warn("failed to check ask rate limit", "error", err)
+		return true
+	}
+	if count == 1 {
+		s.rdb.Expire(ctx, key, time.Minute*2)
+	}
+	return count <= int64(s.ask.rateLimit)
+}
+
+// askQuestion 将 label 还原为问题, label 之间使用空格连接, 支持转义的空格 (\032) 和 IDN
+func askQuestion(prefix string) string {
+	labels := dns.SplitDomainName(prefix)
+	words := make([]string, 0, len(labels))
+	for _, label := range labels {
+		label = unescapeLabel(label)
+		if unicode, err := idna.ToUnicode(label); err == nil {
+			label = unicode
+		}
+		words = append(words, label)
+	}
+	return strings.Join(words, " ")
+}
+
+func unescapeLabel(label string) string {
+	var b strings.Builder
+	for i := 0; i < len(label); i++ {
+		if label[i] != '\\' || i+1 >= len(label) {
+			b.WriteByte(label[i])
+			continue
+		}
+		if i+3 < len(label) {
+			if n, err := strconv.Atoi(label[i+1 : i+4]); err == nil && n <= 255 {
+				b.WriteByte(byte(n))
+				i += 3
+				continue
+			}
+		}
+		i++
+		b.WriteByte(label[i])
+	}
+	return b.String()
+}
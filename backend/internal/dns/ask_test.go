@@ -0,0 +1,222 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/miekg/dns"
+	"github.com/redis/go-redis/v9"
+
+	"dnsarc/internal/answerer"
+)
+
+// testWriter 记录写出的应答, RemoteAddr 为查询的对端地址
+type testWriter struct {
+	remote net.Addr
+	msg    *dns.Msg
+}
+
+func (w *testWriter) LocalAddr() net.Addr {
+	return &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 53}
+}
+func (w *testWriter) RemoteAddr() net.Addr        { return w.remote }
+func (w *testWriter) Network() string             { return w.remote.Network() }
+func (w *testWriter) WriteMsg(m *dns.Msg) error   { w.msg = m; return nil }
+func (w *testWriter) Write(b []byte) (int, error) { return len(b), nil }
+func (w *testWriter) Close() error                { return nil }
+func (w *testWriter) TsigStatus() error           { return nil }
+func (w *testWriter) TsigTimersOnly(bool)         {}
+func (w *testWriter) Hijack()                     {}
+
+func newAskServer(t *testing.T, a answerer.Answerer, rateLimit int) *Server {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() {
+		_ = rdb.Close()
+	})
+	return &Server{
+		rdb:      rdb,
+		answerer: a,
+		ask: &askSettings{
+			suffix:    "ask.dnsarc.com.",
+			timeout:   time.Second,
+			rateLimit: rateLimit,
+		},
+	}
+}
+
+// ask 和 ServeDNS 一样只把 suffix 下的 TXT 查询交给 handleAsk
+func ask(s *Server, ip string, qname string) (*dns.Msg, bool) {
+	r := new(dns.Msg)
+	r.SetQuestion(qname, dns.TypeTXT)
+	m := new(dns.Msg)
+	m.SetReply(r)
+	if !s.isAskName(qname) {
+		return m, false
+	}
+	w := &testWriter{remote: &net.UDPAddr{IP: net.ParseIP(ip), Port: 40000}}
+	s.handleAsk(w, m, r.Question[0])
+	return m, true
+}
+
+func edeCode(m *dns.Msg) (uint16, bool) {
+	opt := m.IsEdns0()
+	if opt == nil {
+		return 0, false
+	}
+	for _, option := range opt.Option {
+		if ede, ok := option.(*dns.EDNS0_EDE); ok {
+			return ede.InfoCode, true
+		}
+	}
+	return 0, false
+}
+
+func TestIsAskName(t *testing.T) {
+	disabled := &Server{}
+	if disabled.isAskName("what.is.dns.ask.dnsarc.com.") {
+		t.Error("ask name handled without an answerer")
+	}
+	s := newAskServer(t, &answerer.Stub{Text: "ok"}, 0)
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"what.is.dns.ask.dnsarc.com.", true},
+		{"What.Is.DNS.ASK.dnsarc.COM.", true},
+		{"ask.dnsarc.com.", false},
+		{"www.dnsarc.com.", false},
+		{"ask.dnsarc.com.example.", false},
+		{"xask.dnsarc.com.", false},
+	}
+	for _, tt := range tests {
+		if got := s.isAskName(tt.name); got != tt.want {
+			t.Errorf("isAskName(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestHandleAsk(t *testing.T) {
+	tests := []struct {
+		name   string
+		stub   *answerer.Stub
+		rcode  int
+		chunks []int // 每段 TXT 字符串的字节数
+	}{
+		{"short answer", &answerer.Stub{Text: "hello"}, dns.RcodeSuccess, []int{5}},
+		{"exactly one string", &answerer.Stub{Text: strings.Repeat("a", 255)}, dns.RcodeSuccess, []int{255}},
+		{"long answer", &answerer.Stub{Text: strings.Repeat("a", 600)}, dns.RcodeSuccess, []int{255, 255, 90}},
+		// 中文每个字 3 字节, 不能在字符中间切开
+		{"multibyte answer", &answerer.Stub{Text: strings.Repeat("中", 100)}, dns.RcodeSuccess, []int{255, 45}},
+		{"backend error", &answerer.Stub{Err: errors.New("boom")}, dns.RcodeServerFailure, nil},
+		{"still generating", &answerer.Stub{Err: context.DeadlineExceeded}, dns.RcodeServerFailure, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newAskServer(t, tt.stub, 0)
+			m, handled := ask(s, "198.51.100.7", "what.is.dns.ask.dnsarc.com.")
+			if !handled {
+				t.Fatal("ask name not handled")
+			}
+			if m.Rcode != tt.rcode {
+				t.Fatalf("rcode = %s, want %s", dns.RcodeToString[m.Rcode], dns.RcodeToString[tt.rcode])
+			}
+			if tt.rcode != dns.RcodeSuccess {
+				if len(m.Answer) != 0 {
+					t.Errorf("unexpected answer %v", m.Answer)
+				}
+				if _, ok := edeCode(m); !ok {
+					t.Error("missing extended error")
+				}
+				return
+			}
+			if len(m.Answer) != 1 {
+				t.Fatalf("answer has %d records, want 1", len(m.Answer))
+			}
+			txt := m.Answer[0].(*dns.TXT)
+			if txt.Hdr.Name != "what.is.dns.ask.dnsarc.com." {
+				t.Errorf("owner = %s", txt.Hdr.Name)
+			}
+			lengths := make([]int, 0, len(txt.Txt))
+			for _, chunk := range txt.Txt {
+				lengths = append(lengths, len(chunk))
+				if !utf8.ValidString(chunk) {
+					t.Errorf("chunk %q is not valid UTF-8", chunk)
+				}
+			}
+			if strings.Join(txt.Txt, "") != tt.stub.Text {
+				t.Error("joined chunks differ from the answer")
+			}
+			if len(lengths) != len(tt.chunks) {
+				t.Fatalf("chunks = %v, want %v", lengths, tt.chunks)
+			}
+			for i := range lengths {
+				if lengths[i] != tt.chunks[i] {
+					t.Fatalf("chunks = %v, want %v", lengths, tt.chunks)
+				}
+			}
+			// 应答可以打包, 每段不超过 255 字节
+			if _, err := m.Pack(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestHandleAskRateLimit(t *testing.T) {
+	s := newAskServer(t, &answerer.Stub{Text: "ok"}, 2)
+	// 同一个 /24 共享计数
+	for _, ip := range []string{"198.51.100.7", "198.51.100.8"} {
+		if m, _ := ask(s, ip, "q.ask.dnsarc.com."); m.Rcode != dns.RcodeSuccess {
+			t.Fatalf("question from %s refused before the limit", ip)
+		}
+	}
+	m, _ := ask(s, "198.51.100.9", "q.ask.dnsarc.com.")
+	if m.Rcode != dns.RcodeRefused {
+		t.Fatalf("rcode = %s, want REFUSED", dns.RcodeToString[m.Rcode])
+	}
+	if code, _ := edeCode(m); code != dns.ExtendedErrorCodeProhibited {
+		t.Errorf("extended error = %d, want %d", code, dns.ExtendedErrorCodeProhibited)
+	}
+	if len(m.Answer) != 0 {
+		t.Error("refused response has an answer")
+	}
+	// 其他网段不受影响
+	if m, _ := ask(s, "203.0.113.1", "q.ask.dnsarc.com."); m.Rcode != dns.RcodeSuccess {
+		t.Error("question from another network refused")
+	}
+	if m, _ := ask(s, "2001:db8:1:ff::1", "q.ask.dnsarc.com."); m.Rcode != dns.RcodeSuccess {
+		t.Error("question from an IPv6 client refused")
+	}
+
+	unlimited := newAskServer(t, &answerer.Stub{Text: "ok"}, 0)
+	for range 5 {
+		if m, _ := ask(unlimited, "198.51.100.7", "q.ask.dnsarc.com."); m.Rcode != dns.RcodeSuccess {
+			t.Fatal("question refused without a rate limit")
+		}
+	}
+}
+
+func TestAskQuestion(t *testing.T) {
+	tests := []struct {
+		prefix string
+		want   string
+	}{
+		{"what.is.dns.", "what is dns"},
+		{`what\032is.dns.`, "what is dns"},
+		{`say\.hi.`, "say.hi"},
+		{"xn--fiq228c.", "中文"},
+	}
+	for _, tt := range tests {
+		if got := askQuestion(tt.prefix); got != tt.want {
+			t.Errorf("askQuestion(%q) = %q, want %q", tt.prefix, got, tt.want)
+		}
+	}
+}
@@ -2,18 +2,21 @@ package dns
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/hashicorp/golang-lru/v2/expirable"
 	"golang.org/x/sync/singleflight"
 	"gorm.io/gorm"
 
+	"dnsarc/internal/metrics"
 	"dnsarc/internal/models"
 )
 
 // DNSCache DNS缓存管理器
 type DNSCache struct {
 	cache *expirable.LRU[string, []models.DNSRecord]
+	zones *expirable.LRU[string, *models.Zone]
 	db    *gorm.DB
 	group singleflight.Group
 }
@@ -21,8 +24,10 @@ type DNSCache struct {
 // NewDNSCache 创建新的DNS缓存
 func NewDNSCache(db *gorm.DB, size int, ttl time.Duration) (*DNSCache, error) {
 	cache := expirable.NewLRU[string, []models.DNSRecord](size, nil, ttl)
+	zones := expirable.NewLRU[string, *models.Zone](size, nil, ttl)
 	return &DNSCache{
 		cache: cache,
+		zones: zones,
 		db:    db,
 	}, nil
 }
@@ -31,8 +36,10 @@ func NewDNSCache(db *gorm.DB, size int, ttl time.Duration) (*DNSCache, error) {
 func (dc *DNSCache) GetRecords(ctx context.Context, zoneName string) ([]models.DNSRecord, error) {
 	// 先从缓存获取
 	if records, found := dc.cache.Get(zoneName); found {
+		metrics.DNSCacheRequests.WithLabelValues("records", metrics.Result(true)).Inc()
 		return records, nil
 	}
+	metrics.DNSCacheRequests.WithLabelValues("records", metrics.Result(false)).Inc()
 
 	// 缓存未命中，使用singleflight防止缓存击穿
 	result, err, _ := dc.group.Do(zoneName, func() (any, error) {
@@ -41,9 +48,18 @@ func (dc *DNSCache) GetRecords(ctx context.Context, zoneName string) ([]models.D
 			return records, nil
 		}
 
+		// 同名 zone 可能属于多个用户, 只返回 active zone 的记录
+		zone, err := dc.GetZone(ctx, zoneName)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return []models.DNSRecord{}, nil
+			}
+			return nil, err
+		}
+
 		// 从数据库查询整个zone的记录
 		var records []models.DNSRecord
-		if err := dc.db.Where("zone_name = ?", zoneName).Find(&records).Error; err != nil {
+		if err := dc.db.WithContext(ctx).Where("zone_id = ?", zone.ID).Find(&records).Error; err != nil {
 			return nil, err
 		}
 
@@ -61,6 +77,35 @@ func (dc *DNSCache) GetRecords(ctx context.Context, zoneName string) ([]models.D
 	return result.([]models.DNSRecord), nil
 }
 
+// GetZone 获取 active 的 zone, 优先从缓存获取
+func (dc *DNSCache) GetZone(ctx context.Context, zoneName string) (*models.Zone, error) {
+	if zone, found := dc.zones.Get(zoneName); found {
+		metrics.DNSCacheRequests.WithLabelValues("zone", metrics.Result(true)).Inc()
+		return zone, nil
+	}
+	metrics.DNSCacheRequests.WithLabelValues("zone", metrics.Result(false)).Inc()
+
+	result, err, _ := dc.group.Do("zone:"+zoneName, func() (any, error) {
+		if zone, found := dc.zones.Get(zoneName); found {
+			return zone, nil
+		}
+
+		var zone models.Zone
+		if err := dc.db.WithContext(ctx).Where("zone_name = ? AND is_active = ?", zoneName, true).First(&zone).Error; err != nil {
+			return nil, err
+		}
+		dc.zones.Add(zoneName, &zone)
+		return &zone, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*models.Zone), nil
+}
+
 func (dc *DNSCache) InvalidateCache(zoneName string) {
 	dc.cache.Remove(zoneName)
+	dc.zones.Remove(zoneName)
 }
@@ -0,0 +1,41 @@
+package dns
+
+import (
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// serverVersion version.bind 返回的版本
+const serverVersion = "dnsarc"
+
+// handleChaos 处理 CHAOS class 的服务器标识查询, 其他名称返回 REFUSED
+//
+//	version.bind / version.server  服务器版本
+//	hostname.bind / id.server      服务器标识 (RFC 4892)
+func (s *Server) handleChaos(m *dns.Msg, q dns.Question) {
+	var value string
+	switch strings.ToLower(q.Name) {
+	case "version.bind.", "version.server.":
+		value = serverVersion
+	case "hostname.bind.", "id.server.":
+		value = s.config.ServerID
+	default:
+		m.Authoritative = false
+		m.Rcode = dns.RcodeRefused
+		setEDE(m, dns.ExtendedErrorCodeNotAuthoritative, "unknown CHAOS name")
+		return
+	}
+	if q.Qtype != dns.TypeTXT && q.Qtype != dns.TypeANY {
+		return
+	}
+	m.Answer = append(m.Answer, &dns.TXT{
+		Hdr: dns.RR_Header{
+			Name:   q.Name,
+			Rrtype: dns.TypeTXT,
+			Class:  dns.ClassCHAOS,
+			Ttl:    0,
+		},
+		Txt: []string{value},
+	})
+}
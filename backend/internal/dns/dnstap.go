@@ -0,0 +1,136 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/netip"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	dnstap "github.com/dnstap/golang-dnstap"
+	"github.com/miekg/dns"
+	"google.golang.org/protobuf/proto"
+)
+
+// Tapper 将查询和应答以 dnstap 格式 (AUTH_QUERY / AUTH_RESPONSE) 写入 frame stream
+//
+// 输出满时直接丢弃, 不能因为 dnstap 的消费方变慢而阻塞查询
+type Tapper struct {
+	output   dnstap.Output
+	identity []byte
+	version  []byte
+	dropped  atomic.Uint64
+}
+
+// NewTapper 根据 target 创建 dnstap 输出, 支持 unix:/path/to/socket 和 file:/path/to/file,
+// 文件在启动时会被截断
+func NewTapper(target, identity string) (*Tapper, error) {
+	var output dnstap.Output
+	switch {
+	case strings.HasPrefix(target, "unix:"):
+		path := strings.TrimPrefix(target, "unix:")
+		out, err := dnstap.NewFrameStreamSockOutput(&net.UnixAddr{Name: path, Net: "unix"})
+		if err != nil {
+			return nil, err
+		}
+		out.SetTimeout(time.Second)
+		output = out
+	case strings.HasPrefix(target, "file:"):
+		path := strings.TrimPrefix(target, "file:")
+		if path == "" {
+			return nil, errors.New("dnstap file path is empty")
+		}
+		out, err := dnstap.NewFrameStreamOutputFromFilename(path)
+		if err != nil {
+			return nil, err
+		}
+		output = out
+	default:
+		return nil, fmt.Errorf("unsupported dnstap target %q, use unix:<path> or file:<path>", target)
+	}
+	go output.RunOutputLoop()
+	return &Tapper{
+		output:   output,
+		identity: []byte(identity),
+		version:  []byte(serverVersion),
+	}, nil
+}
+
+// Tap 输出一次查询和对应的应答, response 为 nil 表示没有应答 (如被 RRL 丢弃)
+func (t *Tapper) Tap(w dns.ResponseWriter, query *dns.Msg, queryTime time.Time, response *dns.Msg, responseTime time.Time) {
+	base := t.message(w)
+	q := proto.Clone(base).(*dnstap.Message)
+	q.Type = dnstap.Message_AUTH_QUERY.Enum()
+	q.QueryTimeSec, q.QueryTimeNsec = tapTime(queryTime)
+	if packed, err := query.Pack(); err == nil {
+		q.QueryMessage = packed
+	}
+	t.send(q)
+	if response == nil {
+		return
+	}
+	base.Type = dnstap.Message_AUTH_RESPONSE.Enum()
+	base.QueryTimeSec, base.QueryTimeNsec = q.QueryTimeSec, q.QueryTimeNsec
+	base.ResponseTimeSec, base.ResponseTimeNsec = tapTime(responseTime)
+	if packed, err := response.Pack(); err == nil {
+		base.ResponseMessage = packed
+	}
+	t.send(base)
+}
+
+// message 填充查询和应答共用的地址信息
+func (t *Tapper) message(w dns.ResponseWriter) *dnstap.Message {
+	msg := &dnstap.Message{
+		SocketProtocol: dnstap.SocketProtocol_UDP.Enum(),
+	}
+	if w.LocalAddr().Network() == "tcp" {
+		msg.SocketProtocol = dnstap.SocketProtocol_TCP.Enum()
+	}
+	if remote, err := netip.ParseAddrPort(w.RemoteAddr().String()); err == nil {
+		addr := remote.Addr().Unmap()
+		msg.SocketFamily = dnstap.SocketFamily_INET6.Enum()
+		if addr.Is4() {
+			msg.SocketFamily = dnstap.SocketFamily_INET.Enum()
+		}
+		msg.QueryAddress = addr.AsSlice()
+		msg.QueryPort = proto.Uint32(uint32(remote.Port()))
+	}
+	if local, err := netip.ParseAddrPort(w.LocalAddr().String()); err == nil {
+		msg.ResponseAddress = local.Addr().Unmap().AsSlice()
+		msg.ResponsePort = proto.Uint32(uint32(local.Port()))
+	}
+	return msg
+}
+
+func (t *Tapper) send(msg *dnstap.Message) {
+	frame, err := proto.Marshal(&dnstap.Dnstap{
+		Identity: t.identity,
+		Version:  t.version,
+		Type:     dnstap.Dnstap_MESSAGE.Enum(),
+		Message:  msg,
+	})
+	if err != nil {
+		slog.Error("failed to marshal dnstap message", "error", err)
+		return
+	}
+	select {
+	case t.output.GetOutputChannel() <- frame:
+	default:
+		// 只在开始丢弃和每丢弃 10000 条时记录
+		if n := t.dropped.Add(1); n == 1 || n%10000 == 0 {
+			slog.Warn("dnstap output is full, dropping messages", "dropped", n)
+		}
+	}
+}
+
+// Close 写入剩余的消息并关闭输出
+func (t *Tapper) Close() {
+	t.output.Close()
+}
+
+func tapTime(t time.Time) (*uint64, *uint32) {
+	return proto.Uint64(uint64(t.Unix())), proto.Uint32(uint32(t.Nanosecond()))
+}
@@ -0,0 +1,231 @@
+package dns
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"log/slog"
+	"net"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	// serverUDPSize 通告给客户端的 UDP payload 大小 (DNS Flag Day 2020)
+	serverUDPSize = 1232
+	// 客户端 cookie 固定 8 字节, 服务器 cookie 为 8 到 32 字节 (RFC 7873 4)
+	clientCookieLen    = 8
+	minServerCookieLen = 8
+	maxServerCookieLen = 32
+	// 服务器 cookie 的有效期和允许的时钟偏差 (RFC 9018 4.3)
+	cookieMaxAge  = time.Hour
+	cookieMaxSkew = 5 * time.Minute
+)
+
+// requestEDNS 请求中和 EDNS0 相关的信息
+type requestEDNS struct {
+	opt          *dns.OPT
+	clientCookie []byte
+	serverCookie []byte
+	validCookie  bool // 服务器 cookie 是我们签发的, 且没有过期
+	nsid         bool
+}
+
+// parseEDNS 检查请求的 OPT 记录, 返回 false 时 m 已经是最终的错误应答
+//
+//   - 多个 OPT 或者 cookie 长度不对时返回 FORMERR
+//   - EDNS 版本不是 0 时返回 BADVERS (RFC 6891 6.1.3)
+//   - 没有 question 只有 cookie 的请求用于获取服务器 cookie, 返回 NOERROR (RFC 7873 5.4)
+func (s *Server) parseEDNS(w dns.ResponseWriter, r *dns.Msg, m *dns.Msg) (*requestEDNS, bool) {
+	req := &requestEDNS{}
+	for _, rr := range r.Extra {
+		opt, ok := rr.(*dns.OPT)
+		if !ok {
+			continue
+		}
+		if req.opt != nil {
+			m.Rcode = dns.RcodeFormatError
+			return req, false
+		}
+		req.opt = opt
+	}
+	if req.opt == nil {
+		return req, true
+	}
+	if req.opt.Version() != 0 {
+		m.Rcode = dns.RcodeBadVers
+		return req, false
+	}
+	for _, option := range req.opt.Option {
+		switch option := option.(type) {
+		case *dns.EDNS0_COOKIE:
+			cookie, err := hex.DecodeString(option.Cookie)
+			serverLen := len(cookie) - clientCookieLen
+			if err != nil || len(cookie) < clientCookieLen || (serverLen != 0 && (serverLen < minServerCookieLen || serverLen > maxServerCookieLen)) {
+				m.Rcode = dns.RcodeFormatError
+				return req, false
+			}
+			req.clientCookie = cookie[:clientCookieLen]
+			if serverLen > 0 {
+				req.serverCookie = cookie[clientCookieLen:]
+				req.validCookie = s.validServerCookie(req.clientCookie, req.serverCookie, remoteIP(w), time.Now())
+			}
+		case *dns.EDNS0_NSID:
+			req.nsid = true
+		}
+	}
+	if len(r.Question) == 0 && req.clientCookie != nil {
+		return req, false
+	}
+	return req, true
+}
+
+// writeMsg 按照 RRL 限速后写回应答
+func (s *Server) writeMsg(w dns.ResponseWriter, req *requestEDNS, m *dns.Msg) {
+	// 应答确定后按分类再限速一次, 查询阶段的限速见 handleQuery
+	if s.rateLimited(w, req) {
+		switch s.rrl.Check(remoteIP(w), m) {
+		case rrlDrop:
+			return
+		case rrlSlip:
+			slipMsg(m)
+		}
+	}
+	s.writeReply(w, req, m)
+}
+
+// rateLimited 只对可以伪造源地址的 UDP 限速, 带有有效 cookie 的客户端不限速
+func (s *Server) rateLimited(w dns.ResponseWriter, req *requestEDNS) bool {
+	return s.rrl != nil && (req == nil || !req.validCookie) && w.LocalAddr().Network() == "udp"
+}
+
+// slipMsg 改为带有服务器 cookie 的空应答, 客户端可以使用 TCP 或 cookie 重试
+func slipMsg(m *dns.Msg) {
+	m.Authoritative = false
+	m.Truncated = true
+	m.Answer, m.Ns, m.Extra = nil, nil, nil
+}
+
+// writeReply 补全 EDNS0 相关的内容后写回应答, 不经过 RRL
+//
+// 请求没有 EDNS0 时不返回 OPT, UDP 应答超过客户端的 payload 大小时截断并设置 TC
+func (s *Server) writeReply(w dns.ResponseWriter, req *requestEDNS, m *dns.Msg) {
+	// 处理过程中通过 setEDE 添加的 OPT
+	var pending *dns.OPT
+	m.Extra = dropOPT(m.Extra, &pending)
+	size := dns.MinMsgSize
+	if req != nil && req.opt != nil {
+		opt := &dns.OPT{
+			Hdr: dns.RR_Header{
+				Name:   ".",
+				Rrtype: dns.TypeOPT,
+			},
+		}
+		opt.SetUDPSize(serverUDPSize)
+		opt.SetDo(req.opt.Do())
+		if pending != nil {
+			opt.Option = append(opt.Option, pending.Option...)
+		}
+		if req.clientCookie != nil {
+			server := s.newServerCookie(req.clientCookie, remoteIP(w), time.Now())
+			opt.Option = append(opt.Option, &dns.EDNS0_COOKIE{
+				Code:   dns.EDNS0COOKIE,
+				Cookie: hex.EncodeToString(append(bytes.Clone(req.clientCookie), server...)),
+			})
+		}
+		if req.nsid {
+			opt.Option = append(opt.Option, &dns.EDNS0_NSID{
+				Code: dns.EDNS0NSID,
+				Nsid: hex.EncodeToString([]byte(s.config.ServerID)),
+			})
+		}
+		m.Extra = append(m.Extra, opt)
+		size = min(max(int(req.opt.UDPSize()), dns.MinMsgSize), serverUDPSize)
+	} else if m.Rcode > 0xF {
+		// 扩展 rcode 需要 OPT 才能表示
+		m.Rcode = dns.RcodeServerFailure
+	}
+	if w.LocalAddr().Network() == "udp" {
+		m.Truncate(size)
+	} else {
+		m.Compress = true
+	}
+	if err := w.WriteMsg(m); err != nil {
+		slog.Error("failed to write response", "error", err)
+	}
+}
+
+// setEDE 为应答添加 extended DNS error (RFC 8914), 解释 SERVFAIL/REFUSED 等错误的原因
+func setEDE(m *dns.Msg, code uint16, text string) {
+	opt := m.IsEdns0()
+	if opt == nil {
+		opt = &dns.OPT{Hdr: dns.RR_Header{Name: ".", Rrtype: dns.TypeOPT}}
+		m.Extra = append(m.Extra, opt)
+	}
+	opt.Option = append(opt.Option, &dns.EDNS0_EDE{
+		InfoCode:  code,
+		ExtraText: text,
+	})
+}
+
+func dropOPT(extra []dns.RR, opt **dns.OPT) []dns.RR {
+	result := extra[:0]
+	for _, rr := range extra {
+		if o, ok := rr.(*dns.OPT); ok {
+			*opt = o
+			continue
+		}
+		result = append(result, rr)
+	}
+	return result
+}
+
+// newServerCookie 按照 RFC 9018 的格式生成服务器 cookie:
+// version (1) | reserved (3) | timestamp (4) | hash (8)
+//
+// hash 使用 HMAC-SHA256 截断, 多个实例配置相同的 DNS_COOKIE_SECRET 时可以互相验证
+func (s *Server) newServerCookie(client []byte, ip net.IP, now time.Time) []byte {
+	cookie := make([]byte, 16)
+	cookie[0] = 1
+	binary.BigEndian.PutUint32(cookie[4:8], uint32(now.Unix()))
+	copy(cookie[8:], s.cookieHash(client, cookie[:8], ip))
+	return cookie
+}
+
+func (s *Server) validServerCookie(client, server []byte, ip net.IP, now time.Time) bool {
+	if len(server) != 16 || server[0] != 1 {
+		return false
+	}
+	issued := time.Unix(int64(binary.BigEndian.Uint32(server[4:8])), 0)
+	if now.Sub(issued) > cookieMaxAge || issued.Sub(now) > cookieMaxSkew {
+		return false
+	}
+	return hmac.Equal(server[8:], s.cookieHash(client, server[:8], ip))
+}
+
+func (s *Server) cookieHash(client, header []byte, ip net.IP) []byte {
+	mac := hmac.New(sha256.New, s.cookieSecret)
+	mac.Write(client)
+	mac.Write(header)
+	mac.Write(ip)
+	return mac.Sum(nil)[:8]
+}
+
+// newCookieSecret 解析配置的 cookie 密钥, 没有配置时随机生成
+func newCookieSecret(secret string) []byte {
+	if secret != "" {
+		if key, err := hex.DecodeString(secret); err == nil && len(key) >= 16 {
+			return key
+		}
+		slog.Warn("invalid DNS_COOKIE_SECRET, must be at least 16 hex encoded bytes, using a random secret")
+	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		slog.Error("failed to generate cookie secret", "error", err)
+	}
+	return key
+}
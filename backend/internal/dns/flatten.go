@@ -0,0 +1,310 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net"
+	"strings"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/miekg/dns"
+	"github.com/weppos/publicsuffix-go/publicsuffix"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"dnsarc/internal/metrics"
+	"dnsarc/internal/models"
+	"dnsarc/internal/tracing"
+)
+
+const (
+	// maxCNAMEChain CNAME 链的最大长度
+	maxCNAMEChain = 8
+	// upstream 结果的缓存时间上下限
+	minUpstreamCacheTTL = 5 * time.Second
+	maxUpstreamCacheTTL = time.Hour
+	// 否定应答没有 SOA 时的缓存时间
+	negativeUpstreamCacheTTL = 60 * time.Second
+)
+
+var (
+	errCNAMELoop     = errors.New("CNAME loop detected")
+	errCNAMETooDeep  = errors.New("CNAME chain too long")
+	errUpstreamRcode = errors.New("upstream returned error")
+)
+
+// upstreamEntry 缓存的 upstream 查询结果
+type upstreamEntry struct {
+	answer  []dns.RR
+	rcode   int
+	fetched time.Time
+	expires time.Time
+}
+
+// aged 返回 TTL 扣除了缓存时间的应答副本
+func (e upstreamEntry) aged(now time.Time) upstreamEntry {
+	elapsed := uint32(now.Sub(e.fetched) / time.Second)
+	answer := make([]dns.RR, 0, len(e.answer))
+	for _, rr := range e.answer {
+		rr = dns.Copy(rr)
+		rr.Header().Ttl -= min(elapsed, rr.Header().Ttl)
+		answer = append(answer, rr)
+	}
+	e.answer = answer
+	return e
+}
+
+// flattenResult CNAME 展平的结果
+type flattenResult struct {
+	ips   []net.IP
+	ttl   uint32 // 整条链上最小的 TTL
+	rcode int
+}
+
+func newUpstreamCache(size int) (*lru.Cache[string, upstreamEntry], error) {
+	return lru.New[string, upstreamEntry](size)
+}
+
+// flattenAlias 展平 ALIAS 记录指向的目标, 将 A/AAAA 结果以 name 的名义写入 m
+func (s *Server) flattenAlias(ctx context.Context, m *dns.Msg, name string, record models.DNSRecord, qtype uint16) {
+	ctx, span := tracing.Start(ctx, "dns.flatten", trace.WithAttributes(
+		attribute.String("dns.alias.target", record.Content),
+	))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+	result, err := s.resolveChain(ctx, record.Content, qtype)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		slog.Error("failed to resolve ALIAS target", "error", err, "target", record.Content)
+		m.Rcode = dns.RcodeServerFailure
+		setEDE(m, dns.ExtendedErrorCodeNoReachableAuthority, "failed to resolve ALIAS target "+record.Content)
+		return
+	}
+	// ALIAS 所在的名称本身是存在的, 目标不存在时由调用方返回 NODATA
+	if result.rcode != dns.RcodeSuccess {
+		slog.Warn("ALIAS target resolution failed", "target", record.Content, "rcode", dns.RcodeToString[result.rcode])
+		return
+	}
+	// 使用整条链上最小的 TTL, 避免缓存时间超过上游记录
+	ttl := min(uint32(record.TTL), result.ttl)
+	for _, ip := range result.ips {
+		hdr := dns.RR_Header{
+			Name:   name,
+			Rrtype: qtype,
+			Class:  dns.ClassINET,
+			Ttl:    ttl,
+		}
+		switch qtype {
+		case dns.TypeA:
+			m.Answer = append(m.Answer, &dns.A{Hdr: hdr, A: ip.To4()})
+		case dns.TypeAAAA:
+			m.Answer = append(m.Answer, &dns.AAAA{Hdr: hdr, AAAA: ip.To16()})
+		}
+	}
+}
+
+// resolveChain 沿着 CNAME 链解析 target 的 A/AAAA 地址
+//
+// 目标托管在 dnsarc 时直接从本地 zone 解析, 否则查询 upstream,
+// 链上的每一跳都会做环路检测, 超过 maxCNAMEChain 时返回错误
+func (s *Server) resolveChain(ctx context.Context, target string, qtype uint16) (*flattenResult, error) {
+	result := &flattenResult{ttl: math.MaxUint32, rcode: dns.RcodeSuccess}
+	seen := make(map[string]bool)
+	name := canonicalName(target)
+	for depth := 0; ; depth++ {
+		if depth >= maxCNAMEChain {
+			return nil, errCNAMETooDeep
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%w at %s", errCNAMELoop, name)
+		}
+		seen[name] = true
+
+		// 优先从本地 zone 解析
+		if records, ok := s.localRecords(ctx, name); ok {
+			next, done := resolveLocal(result, records, name, qtype)
+			if done {
+				return result, nil
+			}
+			name = next
+			continue
+		}
+
+		entry, err := s.lookupUpstream(ctx, name, qtype)
+		if err != nil {
+			return nil, err
+		}
+		if entry.rcode != dns.RcodeSuccess {
+			result.rcode = entry.rcode
+			return result, nil
+		}
+		next, done, err := resolveAnswer(result, entry.answer, name, qtype)
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			return result, nil
+		}
+		// upstream 只返回了 CNAME, 目标在其他 zone, 继续解析
+		name = next
+	}
+}
+
+// resolveLocal 从本地记录解析 name, 返回下一跳的名称或是否已经结束
+func resolveLocal(result *flattenResult, records []models.DNSRecord, name string, qtype uint16) (string, bool) {
+	recordType := dns.TypeToString[qtype]
+	found := false
+	for _, record := range records {
+		if record.Name != name {
+			continue
+		}
+		found = true
+		if record.Type == recordType {
+			if ip := net.ParseIP(record.Content); ip != nil {
+				result.ips = append(result.ips, ip)
+				result.ttl = min(result.ttl, uint32(record.TTL))
+			}
+		}
+	}
+	if len(result.ips) > 0 {
+		return "", true
+	}
+	for _, record := range records {
+		// 本地的 ALIAS 和 CNAME 都继续沿着链解析
+		if record.Name == name && (record.Type == models.RecordTypeCNAME || record.Type == models.RecordTypeALIAS) {
+			result.ttl = min(result.ttl, uint32(record.TTL))
+			return canonicalName(record.Content), false
+		}
+	}
+	if !found {
+		result.rcode = dns.RcodeNameError
+	}
+	return "", true
+}
+
+// resolveAnswer 沿着 upstream 应答中的 CNAME 链收集地址, 返回下一跳的名称或是否已经结束
+func resolveAnswer(result *flattenResult, answer []dns.RR, name string, qtype uint16) (string, bool, error) {
+	for range maxCNAMEChain {
+		var cname string
+		for _, rr := range answer {
+			if canonicalName(rr.Header().Name) != name {
+				continue
+			}
+			switch record := rr.(type) {
+			case *dns.A:
+				if qtype == dns.TypeA {
+					result.ips = append(result.ips, record.A)
+					result.ttl = min(result.ttl, record.Hdr.Ttl)
+				}
+			case *dns.AAAA:
+				if qtype == dns.TypeAAAA {
+					result.ips = append(result.ips, record.AAAA)
+					result.ttl = min(result.ttl, record.Hdr.Ttl)
+				}
+			case *dns.CNAME:
+				cname = canonicalName(record.Target)
+				result.ttl = min(result.ttl, record.Hdr.Ttl)
+			}
+		}
+		if len(result.ips) > 0 || cname == "" {
+			return "", true, nil
+		}
+		// 应答中没有 CNAME 目标的记录时, 交给调用方继续解析
+		if !hasOwner(answer, cname) {
+			return cname, false, nil
+		}
+		name = cname
+	}
+	return "", false, errCNAMETooDeep
+}
+
+// localRecords 当 name 属于 dnsarc 托管的 active zone 时返回该 zone 的记录
+func (s *Server) localRecords(ctx context.Context, name string) ([]models.DNSRecord, bool) {
+	_, records, ok := s.localZone(ctx, name)
+	return records, ok
+}
+
+// localZone 当 name 属于 dnsarc 托管的 active zone 时返回该 zone 和它的记录
+func (s *Server) localZone(ctx context.Context, name string) (*models.Zone, []models.DNSRecord, bool) {
+	zoneName, err := publicsuffix.Domain(name)
+	if err != nil || !s.bloomFilter.TestString(zoneName) {
+		return nil, nil, false
+	}
+	zone, err := s.cache.GetZone(ctx, zoneName)
+	if err != nil {
+		return nil, nil, false
+	}
+	records, err := s.cache.GetRecords(ctx, zoneName)
+	if err != nil {
+		slog.Error("failed to get records", "zone", zoneName, "error", err)
+		return nil, nil, false
+	}
+	return zone, records, true
+}
+
+// lookupUpstream 查询 upstream, 按照应答中最小的 TTL 缓存结果
+func (s *Server) lookupUpstream(ctx context.Context, name string, qtype uint16) (upstreamEntry, error) {
+	key := name + "/" + dns.TypeToString[qtype]
+	now := time.Now()
+	if entry, ok := s.upstreamCache.Get(key); ok && now.Before(entry.expires) {
+		metrics.DNSFlattenCache.WithLabelValues(metrics.Result(true)).Inc()
+		return entry.aged(now), nil
+	}
+	metrics.DNSFlattenCache.WithLabelValues(metrics.Result(false)).Inc()
+	resp, err := s.resolver.Lookup(ctx, name, qtype)
+	if err != nil {
+		metrics.DNSFlattenUpstreamDuration.WithLabelValues("error").Observe(time.Since(now).Seconds())
+		return upstreamEntry{}, err
+	}
+	metrics.DNSFlattenUpstreamDuration.WithLabelValues(dns.RcodeToString[resp.Rcode]).Observe(time.Since(now).Seconds())
+	if resp.Rcode != dns.RcodeSuccess && resp.Rcode != dns.RcodeNameError {
+		return upstreamEntry{}, fmt.Errorf("%w: %s", errUpstreamRcode, dns.RcodeToString[resp.Rcode])
+	}
+	entry := upstreamEntry{
+		answer:  resp.Answer,
+		rcode:   resp.Rcode,
+		fetched: now,
+		expires: now.Add(upstreamCacheTTL(resp)),
+	}
+	s.upstreamCache.Add(key, entry)
+	return entry, nil
+}
+
+// upstreamCacheTTL 肯定应答使用最小的记录 TTL, 否定应答使用 SOA 的 minimum (RFC 2308)
+func upstreamCacheTTL(resp *dns.Msg) time.Duration {
+	ttl := time.Duration(-1)
+	for _, rr := range resp.Answer {
+		if d := time.Duration(rr.Header().Ttl) * time.Second; ttl < 0 || d < ttl {
+			ttl = d
+		}
+	}
+	if ttl < 0 {
+		ttl = negativeUpstreamCacheTTL
+		for _, rr := range resp.Ns {
+			if soa, ok := rr.(*dns.SOA); ok {
+				ttl = time.Duration(min(soa.Hdr.Ttl, soa.Minttl)) * time.Second
+			}
+		}
+	}
+	return min(max(ttl, minUpstreamCacheTTL), maxUpstreamCacheTTL)
+}
+
+func hasOwner(answer []dns.RR, name string) bool {
+	for _, rr := range answer {
+		if canonicalName(rr.Header().Name) == name {
+			return true
+		}
+	}
+	return false
+}
+
+func canonicalName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
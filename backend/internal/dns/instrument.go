@@ -0,0 +1,79 @@
+package dns
+
+import (
+	"context"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"dnsarc/internal/metrics"
+	"dnsarc/internal/tracing"
+)
+
+// recordingWriter 记录写出的应答, 用于指标, dnstap 和查询日志; 同时携带查询的 ctx (trace span)
+type recordingWriter struct {
+	dns.ResponseWriter
+	ctx          context.Context
+	response     *dns.Msg
+	responseTime time.Time
+}
+
+func (w *recordingWriter) WriteMsg(m *dns.Msg) error {
+	w.response = m
+	w.responseTime = time.Now()
+	return w.ResponseWriter.WriteMsg(m)
+}
+
+// instrument 在处理查询之后统计指标, 输出 dnstap 和采样的查询日志; 被 RRL 丢弃的查询 rcode 为 DROPPED
+func (s *Server) instrument(next dns.HandlerFunc) dns.HandlerFunc {
+	return func(w dns.ResponseWriter, r *dns.Msg) {
+		start := time.Now()
+		transport := w.LocalAddr().Network()
+		qtype := "NONE"
+		if len(r.Question) == 1 {
+			qtype = lo.CoalesceOrEmpty(dns.TypeToString[r.Question[0].Qtype], "OTHER")
+		}
+		ctx, span := tracing.Start(context.Background(), "dns.query", trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(
+			attribute.String("dns.question.type", qtype),
+			attribute.String("network.transport", transport),
+		))
+		if len(r.Question) == 1 {
+			span.SetAttributes(attribute.String("dns.question.name", r.Question[0].Name))
+		}
+		rw := &recordingWriter{ResponseWriter: w, ctx: ctx}
+		next(rw, r)
+		duration := time.Since(start)
+
+		rcode := "DROPPED"
+		if rw.response != nil {
+			rcode = lo.CoalesceOrEmpty(dns.RcodeToString[rw.response.Rcode], "OTHER")
+		}
+		span.SetAttributes(attribute.String("dns.response_code", rcode))
+		if rw.response != nil && rw.response.Rcode == dns.RcodeServerFailure {
+			span.SetStatus(codes.Error, rcode)
+		}
+		span.End()
+		metrics.DNSQueries.WithLabelValues(qtype, rcode, transport).Inc()
+		metrics.DNSQueryDuration.WithLabelValues(transport).Observe(duration.Seconds())
+
+		if s.tapper != nil {
+			s.tapper.Tap(w, r, start, rw.response, rw.responseTime)
+		}
+		if s.queryLog != nil && s.queryLog.sampled() {
+			ip, _, _ := clientIP(w, r)
+			s.queryLog.Log(w, r, rw.response, duration, s.country(ctx, ip))
+		}
+	}
+}
+
+// queryContext 返回 instrument 为查询创建的 ctx
+func queryContext(w dns.ResponseWriter) context.Context {
+	if rw, ok := w.(*recordingWriter); ok {
+		return rw.ctx
+	}
+	return context.Background()
+}
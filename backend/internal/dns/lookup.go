@@ -0,0 +1,217 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/samber/lo"
+
+	"dnsarc/internal/models"
+	"dnsarc/internal/records"
+)
+
+// lookup 按照 RFC 1034 4.3.2 在 zone 中查找 q 的记录, 所有查询类型共用同一套 CNAME 语义
+//
+//   - name 有 CNAME 时, 除了 CNAME 查询以外都返回 CNAME, 目标在本地 zone 时继续解析
+//   - A/AAAA 没有地址记录时展平 ALIAS
+//   - ANY 返回 RFC 8482 的最小应答, 未知的类型和其他没有数据的类型一样返回 NODATA
+//   - name 存在但没有对应类型的记录时返回 NODATA, 不存在时返回 NXDOMAIN, 都在 authority 中带上 SOA
+func (s *Server) lookup(ctx context.Context, m *dns.Msg, q dns.Question, zone *models.Zone, records []models.DNSRecord) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+	seen := make(map[string]bool)
+	name := canonicalName(q.Name)
+	owner := q.Name
+	for range maxCNAMEChain {
+		seen[name] = true
+		target, ok := s.answer(ctx, m, owner, q.Qtype, name, zone, records)
+		if !ok {
+			return
+		}
+		// 目标不在本地 zone 时交给客户端的递归解析器继续解析
+		next := canonicalName(target)
+		if seen[next] {
+			slog.Warn("CNAME loop detected", "name", q.Name, "target", next)
+			return
+		}
+		nextZone, nextRecords, ok := s.localZone(ctx, next)
+		if !ok {
+			return
+		}
+		name, owner, zone, records = next, dns.Fqdn(next), nextZone, nextRecords
+	}
+	slog.Warn("CNAME chain too long", "name", q.Name)
+}
+
+// answer 将 name 的 qtype 记录写入 m, name 是 CNAME 时返回 CNAME 的目标
+func (s *Server) answer(ctx context.Context, m *dns.Msg, owner string, qtype uint16, name string, zone *models.Zone, zoneRecords []models.DNSRecord) (string, bool) {
+	owned := lo.Filter(zoneRecords, func(record models.DNSRecord, _ int) bool {
+		return record.Name == name
+	})
+	byType := lo.GroupBy(owned, func(record models.DNSRecord) string {
+		return record.Type
+	})
+	// apex 的 SOA/NS 由服务器生成
+	if name == zone.ZoneName {
+		switch qtype {
+		case dns.TypeSOA:
+			s.handleSOA(m, dns.Question{Name: owner, Qtype: qtype, Qclass: dns.ClassINET}, zone)
+			return "", false
+		case dns.TypeNS:
+			s.handleNS(m, dns.Question{Name: owner, Qtype: qtype, Qclass: dns.ClassINET}, zone, zoneRecords)
+			return "", false
+		}
+	}
+	// ANY 只返回一条合成的 HINFO (RFC 8482 4.2)
+	if qtype == dns.TypeANY {
+		if len(owned) == 0 && name != zone.ZoneName {
+			s.negative(m, zone, name, zoneRecords, false)
+			return "", false
+		}
+		m.Answer = append(m.Answer, &dns.HINFO{
+			Hdr: dns.RR_Header{
+				Name:   owner,
+				Rrtype: dns.TypeHINFO,
+				Class:  dns.ClassINET,
+				Ttl:    3600,
+			},
+			Cpu: "RFC8482",
+		})
+		return "", false
+	}
+	if cnames := byType[models.RecordTypeCNAME]; len(cnames) > 0 {
+		record := s.selectRecordWithWeight(cnames)
+		m.Answer = append(m.Answer, cnameRR(owner, record))
+		return record.Content, qtype != dns.TypeCNAME
+	}
+
+	answered := len(m.Answer)
+	recordType := dns.TypeToString[qtype]
+	switch qtype {
+	case dns.TypeA, dns.TypeAAAA:
+		// 地址记录按照权重只返回一条
+		if matched := byType[recordType]; len(matched) > 0 {
+			if rr, err := recordRR(owner, s.selectRecordWithWeight(matched)); err != nil {
+				slog.Error("invalid record", "name", name, "type", recordType, "error", err)
+			} else {
+				m.Answer = append(m.Answer, rr)
+			}
+		} else if aliases := byType[models.RecordTypeALIAS]; len(aliases) > 0 {
+			s.flattenAlias(ctx, m, owner, s.selectRecordWithWeight(aliases), qtype)
+		}
+	default:
+		for _, record := range byType[recordType] {
+			rr, err := recordRR(owner, record)
+			if err != nil {
+				slog.Error("invalid record", "name", name, "type", recordType, "error", err)
+				continue
+			}
+			m.Answer = append(m.Answer, rr)
+			if records.IsSVCB(recordType) {
+				m.Extra = append(m.Extra, svcbAdditionals(rr, zoneRecords)...)
+			}
+		}
+	}
+	if len(m.Answer) == answered && m.Rcode == dns.RcodeSuccess {
+		s.negative(m, zone, name, zoneRecords, len(owned) > 0)
+	}
+	return "", false
+}
+
+// negative 返回 NODATA 或 NXDOMAIN, authority 中的 SOA 用于否定缓存 (RFC 2308)
+func (s *Server) negative(m *dns.Msg, zone *models.Zone, name string, records []models.DNSRecord, exists bool) {
+	// apex 和 empty non-terminal (只有子域名有记录) 也是存在的名称
+	if !exists && name != zone.ZoneName && !hasDescendant(records, name) {
+		m.Rcode = dns.RcodeNameError
+	}
+	if soa, ok := s.soaRecord(zone); ok {
+		soa.Hdr.Ttl = min(soa.Hdr.Ttl, soa.Minttl)
+		m.Ns = append(m.Ns, soa)
+	}
+}
+
+func hasDescendant(records []models.DNSRecord, name string) bool {
+	return lo.ContainsBy(records, func(record models.DNSRecord) bool {
+		return strings.HasSuffix(record.Name, "."+name)
+	})
+}
+
+// recordRR 将记录转换为 owner 名下的 RR, 记录的 content 使用 RFC 1035 的文本格式
+func recordRR(owner string, record models.DNSRecord) (dns.RR, error) {
+	hdr := dns.RR_Header{
+		Name:  owner,
+		Class: dns.ClassINET,
+		Ttl:   uint32(record.TTL),
+	}
+	switch record.Type {
+	case "A", "AAAA":
+		ip := net.ParseIP(record.Content)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid address %q", record.Content)
+		}
+		if record.Type == "A" {
+			if ip.To4() == nil {
+				return nil, fmt.Errorf("invalid IPv4 address %q", record.Content)
+			}
+			hdr.Rrtype = dns.TypeA
+			return &dns.A{Hdr: hdr, A: ip.To4()}, nil
+		}
+		hdr.Rrtype = dns.TypeAAAA
+		return &dns.AAAA{Hdr: hdr, AAAA: ip.To16()}, nil
+	case models.RecordTypeCNAME:
+		return cnameRR(owner, record), nil
+	case records.TypeSVCB, records.TypeHTTPS:
+		if record.SVCB != nil {
+			return records.SVCBRR(owner, uint32(record.TTL), record.Type, record.SVCB)
+		}
+	case "TXT":
+		// 没有引号的内容作为一整段文本, 超过 255 字节时拆分
+		if !strings.HasPrefix(record.Content, `"`) {
+			hdr.Rrtype = dns.TypeTXT
+			return &dns.TXT{Hdr: hdr, Txt: splitTXTRecord(record.Content)}, nil
+		}
+	}
+	if _, ok := dns.StringToType[record.Type]; !ok {
+		return nil, fmt.Errorf("unsupported record type %q", record.Type)
+	}
+	return dns.NewRR(fmt.Sprintf("%s %d IN %s %s", owner, record.TTL, record.Type, record.Content))
+}
+
+// svcbAdditionals 返回 SVCB/HTTPS 目标在本 zone 内的地址记录 (RFC 9460 4.2)
+func svcbAdditionals(rr dns.RR, zoneRecords []models.DNSRecord) []dns.RR {
+	var svcb *dns.SVCB
+	switch rr := rr.(type) {
+	case *dns.SVCB:
+		svcb = rr
+	case *dns.HTTPS:
+		svcb = &rr.SVCB
+	default:
+		return nil
+	}
+	// ServiceMode 的 target 为 "." 时表示 owner 本身
+	target := svcb.Target
+	if target == "." {
+		if svcb.Priority == 0 {
+			return nil
+		}
+		target = svcb.Hdr.Name
+	}
+	return glueRecords(canonicalName(target), zoneRecords)
+}
+
+func cnameRR(owner string, record models.DNSRecord) dns.RR {
+	return &dns.CNAME{
+		Hdr: dns.RR_Header{
+			Name:   owner,
+			Rrtype: dns.TypeCNAME,
+			Class:  dns.ClassINET,
+			Ttl:    uint32(record.TTL),
+		},
+		Target: dns.Fqdn(record.Content),
+	}
+}
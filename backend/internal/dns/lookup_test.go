@@ -0,0 +1,198 @@
+package dns
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/bits-and-blooms/bloom/v3"
+	"github.com/miekg/dns"
+
+	"dnsarc/internal/models"
+)
+
+// newTestServer 返回只有本地 zone 的 Server, 记录直接写入缓存, 不需要数据库
+func newTestServer(t *testing.T, zones map[string][]models.DNSRecord) *Server {
+	t.Helper()
+	cache, err := NewDNSCache(nil, 100, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filter := bloom.NewWithEstimates(1000, 0.01)
+	for zoneName, records := range zones {
+		cache.zones.Add(zoneName, &models.Zone{ID: zoneName, ZoneName: zoneName, IsActive: true})
+		cache.cache.Add(zoneName, records)
+		filter.AddString(zoneName)
+	}
+	return &Server{
+		config:      &Config{NS1: "ns1.dnsarc.com", NS2: "ns2.dnsarc.com", MBox: "hostmaster.dnsarc.com"},
+		cache:       cache,
+		bloomFilter: filter,
+	}
+}
+
+func testRecord(name, recordType, content string) models.DNSRecord {
+	return models.DNSRecord{Name: name, Type: recordType, Content: content, TTL: 300}
+}
+
+// testLookup 和 ServeDNS 一样找到 qname 所在的 zone 后调用 lookup
+func testLookup(t *testing.T, s *Server, qname string, qtype uint16) *dns.Msg {
+	t.Helper()
+	ctx := context.Background()
+	zone, records, ok := s.localZone(ctx, canonicalName(qname))
+	if !ok {
+		t.Fatalf("no local zone for %s", qname)
+	}
+	m := new(dns.Msg)
+	m.SetQuestion(dns.Fqdn(qname), qtype)
+	m.Response = true
+	s.lookup(ctx, m, m.Question[0], zone, records)
+	return m
+}
+
+func rrSummary(rrs []dns.RR) []string {
+	summary := make([]string, 0, len(rrs))
+	for _, rr := range rrs {
+		summary = append(summary, rr.Header().Name+" "+dns.TypeToString[rr.Header().Rrtype])
+	}
+	return summary
+}
+
+func TestLookup(t *testing.T) {
+	s := newTestServer(t, map[string][]models.DNSRecord{
+		"example.com": {
+			testRecord("example.com", "MX", "10 mx.example.net."),
+			testRecord("www.example.com", "CNAME", "web.example.com"),
+			testRecord("web.example.com", "A", "192.0.2.1"),
+			testRecord("web.example.com", "TXT", "hello"),
+			testRecord("mail.example.com", "CNAME", "mx.example.net"),
+			testRecord("ext.example.com", "CNAME", "cdn.provider.org"),
+			testRecord("loop-a.example.com", "CNAME", "loop-b.example.com"),
+			testRecord("loop-b.example.com", "CNAME", "loop-a.example.com"),
+			testRecord("self.example.com", "CNAME", "self.example.com"),
+			testRecord("dangling.example.com", "CNAME", "missing.example.com"),
+			testRecord("deep.ent.example.com", "TXT", "leaf"),
+		},
+		"example.net": {
+			testRecord("mx.example.net", "A", "192.0.2.25"),
+			testRecord("mx.example.net", "AAAA", "2001:db8::25"),
+			testRecord("mx.example.net", "TXT", "v=spf1 -all"),
+		},
+	})
+	tests := []struct {
+		name   string
+		qname  string
+		qtype  uint16
+		rcode  int
+		answer []string
+		soa    string // authority 中 SOA 的 owner, 为空时没有 SOA
+	}{
+		// 除了 CNAME 查询以外都返回 CNAME, 目标在同一个 zone 时继续解析
+		{"cname at a", "www.example.com", dns.TypeA, dns.RcodeSuccess,
+			[]string{"www.example.com. CNAME", "web.example.com. A"}, ""},
+		{"cname at txt", "www.example.com", dns.TypeTXT, dns.RcodeSuccess,
+			[]string{"www.example.com. CNAME", "web.example.com. TXT"}, ""},
+		{"cname at mx without target data", "www.example.com", dns.TypeMX, dns.RcodeSuccess,
+			[]string{"www.example.com. CNAME"}, "example.com."},
+		{"cname at aaaa without target data", "www.example.com", dns.TypeAAAA, dns.RcodeSuccess,
+			[]string{"www.example.com. CNAME"}, "example.com."},
+		{"cname query is not chased", "www.example.com", dns.TypeCNAME, dns.RcodeSuccess,
+			[]string{"www.example.com. CNAME"}, ""},
+		{"cname names are case insensitive", "WWW.Example.COM", dns.TypeA, dns.RcodeSuccess,
+			[]string{"WWW.Example.COM. CNAME", "web.example.com. A"}, ""},
+
+		// 目标在另一个本地 zone 时继续解析, 否定应答带上目标 zone 的 SOA
+		{"chase into other local zone", "mail.example.com", dns.TypeAAAA, dns.RcodeSuccess,
+			[]string{"mail.example.com. CNAME", "mx.example.net. AAAA"}, ""},
+		{"chase into other local zone txt", "mail.example.com", dns.TypeTXT, dns.RcodeSuccess,
+			[]string{"mail.example.com. CNAME", "mx.example.net. TXT"}, ""},
+		{"chase into other local zone nodata", "mail.example.com", dns.TypeMX, dns.RcodeSuccess,
+			[]string{"mail.example.com. CNAME"}, "example.net."},
+		{"target outside local zones", "ext.example.com", dns.TypeA, dns.RcodeSuccess,
+			[]string{"ext.example.com. CNAME"}, ""},
+		{"dangling cname", "dangling.example.com", dns.TypeA, dns.RcodeNameError,
+			[]string{"dangling.example.com. CNAME"}, "example.com."},
+
+		// 环路在第二次遇到同一个名称时停止
+		{"cname loop", "loop-a.example.com", dns.TypeA, dns.RcodeSuccess,
+			[]string{"loop-a.example.com. CNAME", "loop-b.example.com. CNAME"}, ""},
+		{"cname to itself", "self.example.com", dns.TypeTXT, dns.RcodeSuccess,
+			[]string{"self.example.com. CNAME"}, ""},
+
+		// 名称存在但没有对应类型时返回 NODATA, 不存在时返回 NXDOMAIN
+		{"nodata", "web.example.com", dns.TypeMX, dns.RcodeSuccess, nil, "example.com."},
+		{"nodata for unknown type", "web.example.com", dns.TypeNAPTR, dns.RcodeSuccess, nil, "example.com."},
+		{"nxdomain", "nothing.example.com", dns.TypeA, dns.RcodeNameError, nil, "example.com."},
+		{"nxdomain below existing name", "x.web.example.com", dns.TypeA, dns.RcodeNameError, nil, "example.com."},
+
+		// empty non-terminal 是存在的名称
+		{"empty non-terminal", "ent.example.com", dns.TypeTXT, dns.RcodeSuccess, nil, "example.com."},
+		{"empty non-terminal a", "ent.example.com", dns.TypeA, dns.RcodeSuccess, nil, "example.com."},
+		{"below empty non-terminal", "deep.ent.example.com", dns.TypeTXT, dns.RcodeSuccess,
+			[]string{"deep.ent.example.com. TXT"}, ""},
+		{"nxdomain below leaf", "x.deep.ent.example.com", dns.TypeTXT, dns.RcodeNameError, nil, "example.com."},
+
+		// apex
+		{"apex mx", "example.com", dns.TypeMX, dns.RcodeSuccess, []string{"example.com. MX"}, ""},
+		{"apex nodata", "example.com", dns.TypeTXT, dns.RcodeSuccess, nil, "example.com."},
+		{"apex soa", "example.com", dns.TypeSOA, dns.RcodeSuccess, []string{"example.com. SOA"}, ""},
+		{"apex ns", "example.com", dns.TypeNS, dns.RcodeSuccess,
+			[]string{"example.com. NS", "example.com. NS"}, ""},
+		{"apex with empty zone", "example.net", dns.TypeA, dns.RcodeSuccess, nil, "example.net."},
+
+		// ANY 返回 RFC 8482 的 HINFO
+		{"any", "web.example.com", dns.TypeANY, dns.RcodeSuccess, []string{"web.example.com. HINFO"}, ""},
+		{"any nxdomain", "nothing.example.com", dns.TypeANY, dns.RcodeNameError, nil, "example.com."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := testLookup(t, s, tt.qname, tt.qtype)
+			if m.Rcode != tt.rcode {
+				t.Errorf("rcode = %s, want %s", dns.RcodeToString[m.Rcode], dns.RcodeToString[tt.rcode])
+			}
+			if got := rrSummary(m.Answer); !slices.Equal(got, tt.answer) && (len(got) != 0 || len(tt.answer) != 0) {
+				t.Errorf("answer = %v, want %v", got, tt.answer)
+			}
+			var soa *dns.SOA
+			for _, rr := range m.Ns {
+				if rr, ok := rr.(*dns.SOA); ok {
+					soa = rr
+				}
+			}
+			switch {
+			case tt.soa == "" && soa != nil:
+				t.Errorf("unexpected SOA %s in authority", soa.Hdr.Name)
+			case tt.soa != "" && soa == nil:
+				t.Errorf("missing SOA %s in authority", tt.soa)
+			case soa != nil:
+				if soa.Hdr.Name != tt.soa {
+					t.Errorf("SOA owner = %s, want %s", soa.Hdr.Name, tt.soa)
+				}
+				// 否定缓存时间取 SOA TTL 和 MINIMUM 的较小值 (RFC 2308 5)
+				if soa.Hdr.Ttl != soa.Minttl {
+					t.Errorf("SOA TTL = %d, want %d", soa.Hdr.Ttl, soa.Minttl)
+				}
+			}
+		})
+	}
+}
+
+func TestLookupCNAMEChainLimit(t *testing.T) {
+	var records []models.DNSRecord
+	names := []string{"c0", "c1", "c2", "c3", "c4", "c5", "c6", "c7", "c8", "c9", "c10"}
+	for i := range len(names) - 1 {
+		records = append(records, testRecord(names[i]+".example.com", "CNAME", names[i+1]+".example.com"))
+	}
+	records = append(records, testRecord("c10.example.com", "A", "192.0.2.1"))
+	s := newTestServer(t, map[string][]models.DNSRecord{"example.com": records})
+	m := testLookup(t, s, "c0.example.com", dns.TypeA)
+	if len(m.Answer) != maxCNAMEChain {
+		t.Errorf("answer has %d records, want the chain cut at %d", len(m.Answer), maxCNAMEChain)
+	}
+	for _, rr := range m.Answer {
+		if rr.Header().Rrtype != dns.TypeCNAME {
+			t.Errorf("unexpected %s past the chain limit", dns.TypeToString[rr.Header().Rrtype])
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// QueryLogger 按照采样率输出 JSON 格式的查询日志, 每个查询一行
+type QueryLogger struct {
+	logger *slog.Logger
+	rate   float64
+	file   *os.File // sink 为文件时需要关闭
+}
+
+// NewQueryLogger sink 为 stdout, stderr 或文件路径 (追加写入), rate 为 0 到 1 之间的采样率
+func NewQueryLogger(sink string, rate float64) (*QueryLogger, error) {
+	var w io.Writer
+	var file *os.File
+	switch sink {
+	case "", "stdout":
+		w = os.Stdout
+	case "stderr":
+		w = os.Stderr
+	default:
+		f, err := os.OpenFile(sink, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		w, file = f, f
+	}
+	return &QueryLogger{
+		logger: slog.New(slog.NewJSONHandler(w, nil)),
+		rate:   rate,
+		file:   file,
+	}, nil
+}
+
+// parseQueryLogRate 解析 QUERY_LOG_SAMPLE_RATE, 为空时关闭查询日志
+func parseQueryLogRate(value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	rate, err := strconv.ParseFloat(value, 64)
+	if err != nil || rate < 0 || rate > 1 {
+		return 0, fmt.Errorf("invalid query log sample rate %q, must be between 0 and 1", value)
+	}
+	return rate, nil
+}
+
+func (l *QueryLogger) sampled() bool {
+	return l.rate >= 1 || rand.Float64() < l.rate
+}
+
+// Log 记录一次查询, response 为 nil 表示没有应答
+func (l *QueryLogger) Log(w dns.ResponseWriter, r *dns.Msg, response *dns.Msg, duration time.Duration, country string) {
+	attrs := []slog.Attr{
+		slog.String("client", remoteIP(w).String()),
+		slog.String("transport", w.LocalAddr().Network()),
+		slog.Float64("duration_ms", float64(duration.Microseconds())/1000),
+	}
+	if ip, ok, source := clientIP(w, r); ok && source == "ecs" {
+		attrs = append(attrs, slog.String("ecs", ip.String()))
+	}
+	if country != "" {
+		attrs = append(attrs, slog.String("country", country))
+	}
+	if len(r.Question) > 0 {
+		q := r.Question[0]
+		attrs = append(attrs,
+			slog.String("qname", q.Name),
+			slog.String("qtype", dns.Type(q.Qtype).String()),
+			slog.String("qclass", dns.Class(q.Qclass).String()),
+		)
+	}
+	if opt := r.IsEdns0(); opt != nil {
+		attrs = append(attrs, slog.Int("udp_size", int(opt.UDPSize())), slog.Bool("do", opt.Do()))
+	}
+	if response == nil {
+		attrs = append(attrs, slog.String("rcode", "DROPPED"))
+	} else {
+		attrs = append(attrs,
+			slog.String("rcode", dns.RcodeToString[response.Rcode]),
+			slog.Int("answers", len(response.Answer)),
+			slog.Int("size", response.Len()),
+			slog.Bool("tc", response.Truncated),
+		)
+	}
+	l.logger.LogAttrs(context.Background(), slog.LevelInfo, "query", attrs...)
+}
+
+func (l *QueryLogger) Close() error {
+	if l.file == nil {
+		return nil
+	}
+	return l.file.Close()
+}
@@ -0,0 +1,256 @@
+package dns
+
+import (
+	"fmt"
+	"log/slog"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+	"github.com/miekg/dns"
+	"github.com/samber/lo"
+)
+
+// rrlAction 限速后对请求的处理
+type rrlAction int
+
+const (
+	rrlAllow rrlAction = iota
+	rrlDrop            // 不返回任何应答
+	rrlSlip            // 返回 TC 的空应答, 真实客户端会使用 TCP 重试
+)
+
+// RRLConfig response rate limiting 配置
+type RRLConfig struct {
+	// ResponsesPerSecond 同一个客户端网段同一类应答每秒的应答数, 0 表示关闭 RRL, 应答的分类见 responseKey
+	ResponsesPerSecond int
+	// AllPerSecond 同一个客户端网段每秒的应答总数, 0 表示不限制
+	AllPerSecond int
+	// Window 令牌桶可以累积的时间, 即允许的突发为 rate * window
+	Window time.Duration
+	// Slip 每 Slip 个被限速的请求返回一次 TC, 0 表示全部丢弃, 1 表示全部返回 TC
+	Slip int
+	// 客户端网段的前缀长度
+	IPv4PrefixLen int
+	IPv6PrefixLen int
+	// Whitelist 不限速的客户端网段, 如内部的监控和递归解析器
+	Whitelist []netip.Prefix
+	// Size 最多跟踪的 key 数量
+	Size int
+}
+
+// parseRRLConfig 从环境变量的值解析 RRL 配置, 空值使用默认值
+func parseRRLConfig(config *Config) (RRLConfig, error) {
+	rrl := RRLConfig{
+		ResponsesPerSecond: 20,
+		Window:             time.Second * 5,
+		Slip:               2,
+		IPv4PrefixLen:      24,
+		IPv6PrefixLen:      56,
+		Size:               100000,
+	}
+	var err error
+	if config.RRLResponsesPerSecond != "" {
+		if rrl.ResponsesPerSecond, err = strconv.Atoi(config.RRLResponsesPerSecond); err != nil || rrl.ResponsesPerSecond < 0 {
+			return RRLConfig{}, fmt.Errorf("invalid RRL responses per second %q", config.RRLResponsesPerSecond)
+		}
+	}
+	if config.RRLAllPerSecond != "" {
+		if rrl.AllPerSecond, err = strconv.Atoi(config.RRLAllPerSecond); err != nil || rrl.AllPerSecond < 0 {
+			return RRLConfig{}, fmt.Errorf("invalid RRL all per second %q", config.RRLAllPerSecond)
+		}
+	}
+	if config.RRLWindow != "" {
+		if rrl.Window, err = time.ParseDuration(config.RRLWindow); err != nil || rrl.Window < time.Second {
+			return RRLConfig{}, fmt.Errorf("invalid RRL window %q", config.RRLWindow)
+		}
+	}
+	if config.RRLSlip != "" {
+		if rrl.Slip, err = strconv.Atoi(config.RRLSlip); err != nil || rrl.Slip < 0 {
+			return RRLConfig{}, fmt.Errorf("invalid RRL slip %q", config.RRLSlip)
+		}
+	}
+	// "24,56", 只有一个值或者某一项为空时另一个使用默认值, 如 "24" 或 ",64"
+	if config.RRLPrefixes != "" {
+		v4, v6, _ := strings.Cut(config.RRLPrefixes, ",")
+		if v4 = strings.TrimSpace(v4); v4 != "" {
+			rrl.IPv4PrefixLen, err = strconv.Atoi(v4)
+			if err != nil || rrl.IPv4PrefixLen < 8 || rrl.IPv4PrefixLen > 32 {
+				return RRLConfig{}, fmt.Errorf("invalid RRL IPv4 prefix length %q", v4)
+			}
+		}
+		if v6 = strings.TrimSpace(v6); v6 != "" {
+			rrl.IPv6PrefixLen, err = strconv.Atoi(v6)
+			if err != nil || rrl.IPv6PrefixLen < 16 || rrl.IPv6PrefixLen > 128 {
+				return RRLConfig{}, fmt.Errorf("invalid RRL IPv6 prefix length %q", v6)
+			}
+		}
+	}
+	for _, item := range strings.Split(config.RRLWhitelist, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if !strings.Contains(item, "/") {
+			item += lo.Ternary(strings.Contains(item, ":"), "/128", "/32")
+		}
+		prefix, err := netip.ParsePrefix(item)
+		if err != nil {
+			return RRLConfig{}, fmt.Errorf("invalid RRL whitelist entry %q: %w", item, err)
+		}
+		rrl.Whitelist = append(rrl.Whitelist, prefix.Masked())
+	}
+	return rrl, nil
+}
+
+// RRLStats 限速计数
+type RRLStats struct {
+	Dropped uint64
+	Slipped uint64
+}
+
+// RateLimiter 针对 UDP 反射放大攻击的 response rate limiting
+//
+// 在应答确定后按照 (客户端网段, 应答分类) 维护令牌桶, 超过速率的请求按照 slip 丢弃或者返回 TC,
+// 被伪造源地址的受害者收不到大的应答, 真实客户端则可以通过 TCP 或 DNS cookie 绕过限制
+type RateLimiter struct {
+	config  RRLConfig
+	mu      sync.Mutex
+	buckets *simplelru.LRU[string, *rrlBucket]
+
+	dropped atomic.Uint64
+	slipped atomic.Uint64
+}
+
+type rrlBucket struct {
+	tokens  float64
+	last    time.Time
+	limited uint64 // 连续被限速的请求数, 用于计算 slip
+}
+
+func NewRateLimiter(config RRLConfig) (*RateLimiter, error) {
+	buckets, err := simplelru.NewLRU[string, *rrlBucket](config.Size, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RateLimiter{config: config, buckets: buckets}, nil
+}
+
+// CheckQuery 在处理查询之前按照 (客户端网段, qname, qtype) 预先限速
+//
+// 和应答分开计数, 被限速的请求不会再查询 bloom filter, 缓存, GeoIP 和 ask
+func (l *RateLimiter) CheckQuery(ip net.IP, q dns.Question) rrlAction {
+	return l.limit(ip, "|QUERY", strings.ToLower(q.Name)+"|"+dns.TypeToString[q.Qtype])
+}
+
+// Check 返回对发给 ip 的应答 m 的处理方式
+func (l *RateLimiter) Check(ip net.IP, m *dns.Msg) rrlAction {
+	return l.limit(ip, "", responseKey(m))
+}
+
+// limit 从 (网段, stage, key) 和 (网段, stage) 的令牌桶中取出令牌, stage 区分查询和应答两个阶段
+func (l *RateLimiter) limit(ip net.IP, stage string, key string) rrlAction {
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return rrlAllow
+	}
+	addr = addr.Unmap()
+	for _, prefix := range l.config.Whitelist {
+		if prefix.Contains(addr) {
+			return rrlAllow
+		}
+	}
+	network, err := addr.Prefix(lo.Ternary(addr.Is4(), l.config.IPv4PrefixLen, l.config.IPv6PrefixLen))
+	if err != nil {
+		return rrlAllow
+	}
+	now := time.Now()
+
+	l.mu.Lock()
+	buckets := []*rrlBucket{l.bucket(network.String()+stage+"|"+key, l.config.ResponsesPerSecond, now)}
+	if l.config.AllPerSecond > 0 {
+		buckets = append(buckets, l.bucket(network.String()+stage, l.config.AllPerSecond, now))
+	}
+	// 所有令牌桶都有令牌时才取出, 被总数限速时不消耗应答分类的令牌
+	var count uint64
+	if limited, ok := lo.Find(buckets, func(b *rrlBucket) bool { return b.tokens < 1 }); ok {
+		limited.limited++
+		count = limited.limited
+	} else {
+		for _, b := range buckets {
+			b.tokens--
+			b.limited = 0
+		}
+	}
+	l.mu.Unlock()
+	if count == 0 {
+		return rrlAllow
+	}
+	// 只在开始限速时记录一次日志, 避免攻击时日志被刷屏
+	if count == 1 {
+		slog.Warn("rate limiting client", "network", network.String(), "response", key)
+	}
+	if l.config.Slip > 0 && count%uint64(l.config.Slip) == 0 {
+		l.slipped.Add(1)
+		return rrlSlip
+	}
+	l.dropped.Add(1)
+	return rrlDrop
+}
+
+// bucket 返回 key 的令牌桶, 并按照经过的时间补充令牌
+func (l *RateLimiter) bucket(key string, rate int, now time.Time) *rrlBucket {
+	burst := float64(rate) * l.config.Window.Seconds()
+	bucket, ok := l.buckets.Get(key)
+	if !ok {
+		bucket = &rrlBucket{tokens: burst, last: now}
+		l.buckets.Add(key, bucket)
+	}
+	bucket.tokens = min(burst, bucket.tokens+now.Sub(bucket.last).Seconds()*float64(rate))
+	bucket.last = now
+	return bucket
+}
+
+// responseKey 按照应答的分类选择令牌桶, 和 BIND 的 RRL 相同:
+//
+//   - 有记录的应答按 (qname, qtype), 同一个名称的放大应答共享令牌
+//   - NXDOMAIN 和 NODATA 按 (zone, 分类), 随机子域名的查询不会每个名称一个令牌桶
+//   - 委派按委派的名称
+//   - 其他错误按 (zone, rcode), 没有 SOA 时同一网段的错误共享一个令牌桶
+func responseKey(m *dns.Msg) string {
+	if m.Rcode == dns.RcodeSuccess && len(m.Answer) > 0 && len(m.Question) > 0 {
+		return strings.ToLower(m.Question[0].Name) + "|" + dns.TypeToString[m.Question[0].Qtype]
+	}
+	var zone string
+	for _, rr := range m.Ns {
+		switch rr.Header().Rrtype {
+		case dns.TypeSOA:
+			zone = strings.ToLower(rr.Header().Name)
+		case dns.TypeNS:
+			if m.Rcode == dns.RcodeSuccess && len(m.Answer) == 0 {
+				return "REFERRAL|" + strings.ToLower(rr.Header().Name)
+			}
+		}
+	}
+	switch m.Rcode {
+	case dns.RcodeSuccess:
+		return "NODATA|" + zone
+	case dns.RcodeNameError:
+		return "NXDOMAIN|" + zone
+	default:
+		return dns.RcodeToString[m.Rcode] + "|" + zone
+	}
+}
+
+// Stats 返回累计的限速计数
+func (l *RateLimiter) Stats() RRLStats {
+	return RRLStats{
+		Dropped: l.dropped.Load(),
+		Slipped: l.slipped.Load(),
+	}
+}
@@ -0,0 +1,221 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/bits-and-blooms/bloom/v3"
+	"github.com/miekg/dns"
+)
+
+func newTestRateLimiter(t *testing.T, config RRLConfig) *RateLimiter {
+	t.Helper()
+	config.Window = time.Second
+	config.IPv4PrefixLen = 24
+	config.IPv6PrefixLen = 56
+	config.Size = 1000
+	l, err := NewRateLimiter(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l
+}
+
+func testReply(name string, qtype uint16, rcode int, answer bool) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetQuestion(name, qtype)
+	m.Response = true
+	m.Rcode = rcode
+	if answer {
+		m.Answer = append(m.Answer, &dns.A{
+			Hdr: dns.RR_Header{Name: name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 300},
+			A:   net.IPv4(192, 0, 2, 1),
+		})
+		return m
+	}
+	m.Ns = append(m.Ns, &dns.SOA{
+		Hdr: dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: 300},
+		Ns:  "ns1.example.com.",
+	})
+	return m
+}
+
+func TestResponseKey(t *testing.T) {
+	referral := testReply("www.sub.example.com.", dns.TypeA, dns.RcodeSuccess, false)
+	referral.Ns = []dns.RR{&dns.NS{
+		Hdr: dns.RR_Header{Name: "sub.example.com.", Rrtype: dns.TypeNS, Class: dns.ClassINET},
+		Ns:  "ns.other.net.",
+	}}
+	tests := []struct {
+		name string
+		m    *dns.Msg
+		want string
+	}{
+		{"answer", testReply("WWW.example.com.", dns.TypeA, dns.RcodeSuccess, true), "www.example.com.|A"},
+		{"nxdomain", testReply("random.example.com.", dns.TypeA, dns.RcodeNameError, false), "NXDOMAIN|example.com."},
+		{"nodata", testReply("www.example.com.", dns.TypeAAAA, dns.RcodeSuccess, false), "NODATA|example.com."},
+		{"referral", referral, "REFERRAL|sub.example.com."},
+		{"refused", func() *dns.Msg {
+			m := testReply("www.example.org.", dns.TypeA, dns.RcodeRefused, false)
+			m.Ns = nil
+			return m
+		}(), "REFUSED|"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := responseKey(tt.m); got != tt.want {
+				t.Errorf("responseKey() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateLimiterRandomSubdomains(t *testing.T) {
+	l := newTestRateLimiter(t, RRLConfig{ResponsesPerSecond: 5})
+	ip := net.IPv4(198, 51, 100, 7)
+	limited := 0
+	for i := range 20 {
+		m := testReply(fmt.Sprintf("r%d.example.com.", i), dns.TypeA, dns.RcodeNameError, false)
+		if l.Check(ip, m) != rrlAllow {
+			limited++
+		}
+	}
+	// 每个名称都不同, 但 NXDOMAIN 共享 zone 的令牌桶
+	if limited != 15 {
+		t.Errorf("limited %d of 20 NXDOMAIN responses, want 15", limited)
+	}
+	// 其他名称的正常应答不受影响
+	if l.Check(ip, testReply("www.example.com.", dns.TypeA, dns.RcodeSuccess, true)) != rrlAllow {
+		t.Error("positive answer limited by NXDOMAIN bucket")
+	}
+}
+
+func TestRateLimiterAllBucketDoesNotSpendKeyTokens(t *testing.T) {
+	l := newTestRateLimiter(t, RRLConfig{ResponsesPerSecond: 3, AllPerSecond: 3})
+	ip := net.IPv4(198, 51, 100, 7)
+	// 用完总数的令牌
+	for i := range 3 {
+		if l.Check(ip, testReply(fmt.Sprintf("a%d.example.com.", i), dns.TypeA, dns.RcodeSuccess, true)) != rrlAllow {
+			t.Fatalf("response %d limited", i)
+		}
+	}
+	m := testReply("www.example.com.", dns.TypeA, dns.RcodeSuccess, true)
+	for range 3 {
+		if l.Check(ip, m) == rrlAllow {
+			t.Fatal("response allowed after the all bucket is empty")
+		}
+	}
+	// 总数补充后 www 的令牌桶仍然是满的
+	l.mu.Lock()
+	l.bucket("198.51.100.0/24", 3, time.Now()).tokens = 3
+	l.mu.Unlock()
+	for i := range 3 {
+		if l.Check(ip, m) != rrlAllow {
+			t.Fatalf("response %d limited, key tokens were spent while the all bucket limited", i)
+		}
+	}
+}
+
+func TestRateLimiterWhitelist(t *testing.T) {
+	config := RRLConfig{ResponsesPerSecond: 1}
+	whitelist, err := parseRRLConfig(&Config{RRLWhitelist: "198.51.100.7"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.Whitelist = whitelist.Whitelist
+	l := newTestRateLimiter(t, config)
+	m := testReply("www.example.com.", dns.TypeA, dns.RcodeSuccess, true)
+	for range 10 {
+		if l.Check(net.IPv4(198, 51, 100, 7), m) != rrlAllow {
+			t.Fatal("whitelisted client limited")
+		}
+	}
+}
+
+func TestRateLimiterQueryStage(t *testing.T) {
+	l := newTestRateLimiter(t, RRLConfig{ResponsesPerSecond: 2, Slip: 2})
+	ip := net.IPv4(198, 51, 100, 7)
+	q := dns.Question{Name: "WWW.example.com.", Qtype: dns.TypeA, Qclass: dns.ClassINET}
+	for i := range 2 {
+		if l.CheckQuery(ip, q) != rrlAllow {
+			t.Fatalf("query %d limited", i)
+		}
+	}
+	if got := l.CheckQuery(ip, dns.Question{Name: "www.example.com.", Qtype: dns.TypeA}); got != rrlDrop {
+		t.Errorf("third query = %d, want drop", got)
+	}
+	if got := l.CheckQuery(ip, q); got != rrlSlip {
+		t.Errorf("fourth query = %d, want slip", got)
+	}
+	// 查询和应答分开计数
+	if l.Check(ip, testReply("www.example.com.", dns.TypeA, dns.RcodeSuccess, true)) != rrlAllow {
+		t.Error("response limited by the query stage")
+	}
+	if l.CheckQuery(ip, dns.Question{Name: "www.example.com.", Qtype: dns.TypeAAAA}) != rrlAllow {
+		t.Error("other qtype limited")
+	}
+}
+
+func TestHandleQueryRateLimitedBeforeCache(t *testing.T) {
+	l := newTestRateLimiter(t, RRLConfig{ResponsesPerSecond: 1, Slip: 2})
+	filter := bloom.NewWithEstimates(1000, 0.01)
+	filter.AddString("example.com")
+	// cache 和 geoDB 为 nil, 被限速的查询如果继续处理会 panic
+	s := &Server{config: &Config{}, rrl: l, bloomFilter: filter}
+	ip := net.IPv4(198, 51, 100, 7)
+	r := new(dns.Msg)
+	r.SetQuestion("www.example.com.", dns.TypeA)
+	if l.CheckQuery(ip, r.Question[0]) != rrlAllow {
+		t.Fatal("first query limited")
+	}
+
+	w := &testWriter{remote: &net.UDPAddr{IP: ip, Port: 40000}}
+	s.handleQuery(w, r)
+	if w.msg != nil {
+		t.Fatalf("dropped query got a response: %v", w.msg)
+	}
+	w = &testWriter{remote: &net.UDPAddr{IP: ip, Port: 40000}}
+	s.handleQuery(w, r)
+	if w.msg == nil || !w.msg.Truncated || len(w.msg.Answer) != 0 {
+		t.Fatalf("slipped query response = %v, want empty TC", w.msg)
+	}
+	if stats := l.Stats(); stats.Dropped != 1 || stats.Slipped != 1 {
+		t.Errorf("stats = %+v, want 1 dropped and 1 slipped", stats)
+	}
+}
+
+func TestParseRRLPrefixes(t *testing.T) {
+	tests := []struct {
+		value  string
+		v4, v6 int
+		err    bool
+	}{
+		{"24,56", 24, 56, false},
+		{"20", 20, 56, false},
+		{"20,", 20, 56, false},
+		{",64", 24, 64, false},
+		{" 16 , 48 ", 16, 48, false},
+		{"7", 0, 0, true},
+		{"24,200", 0, 0, true},
+		{"x", 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			config, err := parseRRLConfig(&Config{RRLPrefixes: tt.value})
+			if tt.err {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if config.IPv4PrefixLen != tt.v4 || config.IPv6PrefixLen != tt.v6 {
+				t.Errorf("prefixes = %d,%d, want %d,%d", config.IPv4PrefixLen, config.IPv6PrefixLen, tt.v4, tt.v6)
+			}
+		})
+	}
+}
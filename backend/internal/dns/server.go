@@ -3,9 +3,11 @@ package dns
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"math/rand"
 	"net"
+	"net/http"
 	"net/netip"
 	"os"
 	"os/signal"
@@ -16,20 +18,26 @@ import (
 	"unicode/utf8"
 
 	"github.com/bits-and-blooms/bloom/v3"
+	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/miekg/dns"
-	"github.com/openai/openai-go"
-	"github.com/openai/openai-go/responses"
 	"github.com/oschwald/geoip2-golang/v2"
 	"github.com/pires/go-proxyproto"
 	"github.com/redis/go-redis/v9"
 	"github.com/samber/lo"
 	"github.com/weppos/publicsuffix-go/publicsuffix"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/net/idna"
 	"gorm.io/gorm"
 
+	"dnsarc/internal/analytics"
+	"dnsarc/internal/answerer"
 	"dnsarc/internal/database"
 	"dnsarc/internal/event"
+	"dnsarc/internal/metrics"
 	"dnsarc/internal/models"
+	"dnsarc/internal/resolver"
+	"dnsarc/internal/tracing"
 )
 
 type Server struct {
@@ -44,7 +52,20 @@ type Server struct {
 	pendingRebuilds int
 	rebuildTimer    *time.Timer
 
-	openaiClient openai.Client
+	resolver      *resolver.Resolver
+	upstreamCache *lru.Cache[string, upstreamEntry]
+
+	cookieSecret []byte
+	rrl          *RateLimiter // 为 nil 时不限速
+	analytics    *analytics.Collector
+	tapper       *Tapper      // 为 nil 时不输出 dnstap
+	queryLog     *QueryLogger // 为 nil 时不输出查询日志
+
+	shutdownTracing func(context.Context) error
+
+	// TXT 问答, 没有配置 ASK_PROVIDER 时为 nil
+	answerer answerer.Answerer
+	ask      *askSettings
 }
 
 type Config struct {
@@ -55,6 +76,38 @@ type Config struct {
 	MBox        string
 	Port        string
 	Host        string
+	ServerID    string // hostname.bind / id.server 和 NSID 返回的标识, 默认为主机名
+	// CookieSecret hex 编码的 DNS cookie 密钥, 多个实例需要配置相同的值, 为空时随机生成
+	CookieSecret string
+
+	// response rate limiting, 见 RRLConfig
+	RRLResponsesPerSecond string
+	RRLAllPerSecond       string
+	RRLWindow             string
+	RRLSlip               string
+	RRLPrefixes           string // IPv4 和 IPv6 的前缀长度, 如 "24,56"
+	RRLWhitelist          string
+
+	MetricsAddr  string // Prometheus /metrics 的监听地址, 默认 :9153
+	OTLPEndpoint string // OTLP/HTTP collector 地址, 为空时不导出 trace
+
+	DNSTapTarget       string // unix:<path> 或 file:<path>, 为空时关闭
+	QueryLogSampleRate string // 0 到 1, 为空或 0 时关闭
+	QueryLogSink       string // stdout, stderr 或文件路径
+
+	ResolverUpstreams   string
+	ResolverTimeout     string
+	ResolverRootServers string
+
+	// TXT 问答, 见 askSettings
+	AskProvider  string
+	AskSuffix    string
+	AskModel     string
+	AskBaseURL   string
+	AskAPIKey    string
+	AskTimeout   string
+	AskCacheTTL  string
+	AskRateLimit string
 }
 
 var BLACK_LIST_ZONE = []string{
@@ -70,6 +123,39 @@ func NewServer() *Server {
 		MBox:        os.Getenv("MBOX"),
 		Port:        "53",
 		Host:        "0.0.0.0",
+		ServerID:    os.Getenv("SERVER_ID"),
+
+		CookieSecret: os.Getenv("DNS_COOKIE_SECRET"),
+
+		RRLResponsesPerSecond: os.Getenv("RRL_RESPONSES_PER_SECOND"),
+		RRLAllPerSecond:       os.Getenv("RRL_ALL_PER_SECOND"),
+		RRLWindow:             os.Getenv("RRL_WINDOW"),
+		RRLSlip:               os.Getenv("RRL_SLIP"),
+		RRLPrefixes:           os.Getenv("RRL_PREFIXES"),
+		RRLWhitelist:          os.Getenv("RRL_WHITELIST"),
+
+		MetricsAddr:  lo.CoalesceOrEmpty(os.Getenv("METRICS_ADDR"), ":9153"),
+		OTLPEndpoint: os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"),
+
+		DNSTapTarget:       os.Getenv("DNSTAP_TARGET"),
+		QueryLogSampleRate: os.Getenv("QUERY_LOG_SAMPLE_RATE"),
+		QueryLogSink:       os.Getenv("QUERY_LOG_SINK"),
+
+		AskProvider:  os.Getenv("ASK_PROVIDER"),
+		AskSuffix:    os.Getenv("ASK_SUFFIX"),
+		AskModel:     os.Getenv("ASK_MODEL"),
+		AskBaseURL:   os.Getenv("ASK_BASE_URL"),
+		AskAPIKey:    os.Getenv("ASK_API_KEY"),
+		AskTimeout:   os.Getenv("ASK_TIMEOUT"),
+		AskCacheTTL:  os.Getenv("ASK_CACHE_TTL"),
+		AskRateLimit: os.Getenv("ASK_RATE_LIMIT"),
+
+		ResolverUpstreams:   os.Getenv("RESOLVER_UPSTREAMS"),
+		ResolverTimeout:     os.Getenv("RESOLVER_TIMEOUT"),
+		ResolverRootServers: os.Getenv("RESOLVER_ROOT_SERVERS"),
+	}
+	if config.ServerID == "" {
+		config.ServerID, _ = os.Hostname()
 	}
 	db, err := database.NewDatabase(config.DatabaseURL)
 	if err != nil {
@@ -104,17 +190,85 @@ func NewServer() *Server {
 		}
 		slog.Info("bloom filter initialized", "zone_names", zoneNames)
 	}()
-	openaiClient := openai.NewClient()
-	return &Server{
-		db:           db,
-		rdb:          rdb,
-		geoDB:        geoDB,
-		config:       config,
-		cache:        cache,
-		bloomFilter:  bloomFilter,
-		bloomMu:      sync.Mutex{},
-		openaiClient: openaiClient,
+	resolverConfig, err := resolver.ParseConfig(config.ResolverUpstreams, config.ResolverTimeout, config.ResolverRootServers)
+	if err != nil {
+		slog.Error("failed to parse resolver config", "error", err)
+		os.Exit(1)
+	}
+	res, err := resolver.New(resolverConfig)
+	if err != nil {
+		slog.Error("failed to create resolver", "error", err)
+		os.Exit(1)
+	}
+	upstreamCache, err := newUpstreamCache(100000)
+	if err != nil {
+		slog.Error("failed to create upstream cache", "error", err)
+		os.Exit(1)
+	}
+	rrlConfig, err := parseRRLConfig(config)
+	if err != nil {
+		slog.Error("failed to parse RRL config", "error", err)
+		os.Exit(1)
+	}
+	var rrl *RateLimiter
+	if rrlConfig.ResponsesPerSecond > 0 {
+		if rrl, err = NewRateLimiter(rrlConfig); err != nil {
+			slog.Error("failed to create rate limiter", "error", err)
+			os.Exit(1)
+		}
+		metrics.RegisterRRL(
+			func() float64 { return float64(rrl.Stats().Dropped) },
+			func() float64 { return float64(rrl.Stats().Slipped) },
+		)
+	}
+	shutdownTracing, err := tracing.Setup(context.Background(), "dnsarc-dns", config.OTLPEndpoint)
+	if err != nil {
+		slog.Error("failed to set up tracing", "error", err)
+		os.Exit(1)
+	}
+	var tapper *Tapper
+	if config.DNSTapTarget != "" {
+		if tapper, err = NewTapper(config.DNSTapTarget, config.ServerID); err != nil {
+			slog.Error("failed to create dnstap output", "error", err)
+			os.Exit(1)
+		}
 	}
+	queryLogRate, err := parseQueryLogRate(config.QueryLogSampleRate)
+	if err != nil {
+		slog.Error("failed to parse query log config", "error", err)
+		os.Exit(1)
+	}
+	var queryLog *QueryLogger
+	if queryLogRate > 0 {
+		if queryLog, err = NewQueryLogger(config.QueryLogSink, queryLogRate); err != nil {
+			slog.Error("failed to create query log", "error", err)
+			os.Exit(1)
+		}
+	}
+	server := &Server{
+		db:            db,
+		rdb:           rdb,
+		geoDB:         geoDB,
+		config:        config,
+		cache:         cache,
+		bloomFilter:   bloomFilter,
+		bloomMu:       sync.Mutex{},
+		resolver:      res,
+		upstreamCache: upstreamCache,
+		cookieSecret:  newCookieSecret(config.CookieSecret),
+		rrl:           rrl,
+		analytics:     analytics.NewCollector(db),
+		tapper:        tapper,
+		queryLog:      queryLog,
+
+		shutdownTracing: shutdownTracing,
+	}
+	server.answerer, server.ask, err = newAnswerer(config, server)
+	if err != nil {
+		slog.Error("failed to create answerer", "error", err)
+		os.Exit(1)
+	}
+	return server
 }
 
 func (s *Server) rebuildBloomFilter() {
@@ -139,151 +293,16 @@ func (s *Server) rebuildBloomFilter() {
 
 func (s *Server) Start() error {
 	go s.startSubscribeRedis()
+	analyticsCtx, stopAnalytics := context.WithCancel(context.Background())
+	defer stopAnalytics()
+	analyticsDone := make(chan struct{})
+	go func() {
+		s.analytics.Run(analyticsCtx)
+		close(analyticsDone)
+	}()
 
 	mux := dns.NewServeMux()
-	mux.HandleFunc(".", func(w dns.ResponseWriter, r *dns.Msg) {
-		m := new(dns.Msg)
-		m.SetReply(r)
-		m.Authoritative = true
-
-		needQuery := func() bool {
-			for _, q := range r.Question {
-				if q.Qtype == dns.TypeA {
-					return true
-				}
-			}
-			return false
-		}()
-
-		// 获取 domain
-		firstQuestion := r.Question[0]
-		name := firstQuestion.Name
-		name = strings.TrimSuffix(name, ".")
-		name = strings.ToLower(name)
-		name, err := idna.ToASCII(name)
-		if err != nil {
-			slog.Error("failed to convert name to ASCII", "error", err, "name", name)
-			m.Rcode = dns.RcodeServerFailure
-			if err := w.WriteMsg(m); err != nil {
-				slog.Error("failed to write response", "error", err)
-			}
-		}
-		// 提取 zone（获取顶级域名）
-		zoneName, err := publicsuffix.Domain(name)
-		zoneName = strings.TrimSuffix(zoneName, ".")
-		if lo.Contains(BLACK_LIST_ZONE, zoneName) {
-			m.Rcode = dns.RcodeNameError
-			if err := w.WriteMsg(m); err != nil {
-				slog.Error("failed to write response", "error", err)
-			}
-			return
-		}
-		if err != nil {
-			if firstQuestion.Qtype == dns.TypeTXT {
-				prompt, err := idna.ToUnicode(firstQuestion.Name)
-				if err != nil {
-					slog.Error("failed to convert name to Unicode", "error", err, "name", firstQuestion.Name)
-					m.Rcode = dns.RcodeServerFailure
-					if err := w.WriteMsg(m); err != nil {
-						slog.Error("failed to write response", "error", err)
-					}
-					return
-				}
-				slog.Info("prompt", "prompt", prompt)
-				response, err := s.openaiClient.Responses.New(context.Background(), responses.ResponseNewParams{
-					Model:        "gpt-4.1-mini",
-					Instructions: openai.String("You are a helpful assistant that can answer questions, and only output plain text. only output English text."),
-					Input: responses.ResponseNewParamsInputUnion{
-						OfString: openai.String(prompt),
-					},
-					MaxOutputTokens: openai.Int(1000),
-				})
-				if err != nil {
-					slog.Error("failed to create response", "error", err)
-					m.Rcode = dns.RcodeServerFailure
-					if err := w.WriteMsg(m); err != nil {
-						slog.Error("failed to write response", "error", err)
-					}
-				}
-				responseText := response.OutputText()
-				slog.Info("response", "response", responseText)
-
-				txtStrings := splitTXTRecord(responseText)
-				rr := &dns.TXT{
-					Hdr: dns.RR_Header{
-						Name:   firstQuestion.Name,
-						Rrtype: dns.TypeTXT,
-						Class:  dns.ClassINET,
-						Ttl:    3600,
-					},
-					Txt: txtStrings,
-				}
-				m.Answer = append(m.Answer, rr)
-				if err := w.WriteMsg(m); err != nil {
-					slog.Error("failed to write response", "error", err)
-				}
-				return
-			}
-			slog.Error("failed to get zone", "error", err, "name", firstQuestion.Name)
-			m.Rcode = dns.RcodeNameError
-			if err := w.WriteMsg(m); err != nil {
-				slog.Error("failed to write response", "error", err)
-			}
-		}
-
-		// 使用bloom filter检查Zone是否存在
-		if !s.bloomFilter.TestString(zoneName) {
-			slog.Info("zone not found in bloom filter", "zone", zoneName)
-			m.Rcode = dns.RcodeNameError
-			if err := w.WriteMsg(m); err != nil {
-				slog.Error("failed to write response", "error", err)
-			}
-			return
-		}
-		// 获取 record
-		records := make([]models.DNSRecord, 0)
-		if needQuery {
-			if cachedRecords, err := s.cache.GetRecords(context.Background(), zoneName); err != nil {
-				slog.Error("failed to get records", "error", err)
-			} else {
-				records = cachedRecords
-			}
-		}
-		ip, hasClientIP, clientIPType := clientIP(w, r)
-		if hasClientIP {
-			slog.Info("client_ip", "ip", ip, "clientIPType", clientIPType)
-			if ipNetip, ok := ipToNetip(ip); ok {
-				if city, err := s.geoDB.City(ipNetip); err != nil {
-					slog.Warn("geo lookup failed", "error", err)
-				} else {
-					slog.Info("city", "city", city.City.Names.SimplifiedChinese, "country", city.Country.Names.SimplifiedChinese)
-				}
-			} else {
-				slog.Warn("failed to convert ip to netip", "ip", ip)
-			}
-		} else {
-			slog.Info("no client ip")
-		}
-		for _, q := range r.Question {
-			switch q.Qtype {
-			case dns.TypeSOA:
-				s.handleSOA(m, q)
-			case dns.TypeNS:
-				s.handleNS(m, q)
-			case dns.TypeCAA:
-				s.handleCAA(m, q)
-			case dns.TypeA:
-				s.handleA(m, q, records)
-			case dns.TypeCNAME:
-				s.handleCNAME(m, q, records)
-			default:
-				m.Rcode = dns.RcodeNotImplemented
-			}
-		}
-		if err := w.WriteMsg(m); err != nil {
-			slog.Error("failed to write response", "error", err)
-		}
-	})
+	mux.HandleFunc(".", s.instrument(s.handleQuery))
 	// UDP Server - proxy protocol is handled at Traefik level for UDP
 	udpServer := &dns.Server{
 		Addr:    ":53",
@@ -298,11 +317,11 @@ func (s *Server) Start() error {
 		return err
 	}
 	slog.Info("TCP listener created successfully")
-	
+
 	proxyTCPListener := &proxyproto.Listener{
 		Listener: tcpListener,
 		Policy: func(upstream net.Addr) (proxyproto.Policy, error) {
-			slog.Info("TCP proxy protocol policy check", "upstream", upstream.String())
+			slog.Debug("TCP proxy protocol policy check", "upstream", upstream.String())
 			return proxyproto.USE, nil
 		},
 	}
@@ -313,7 +332,19 @@ func (s *Server) Start() error {
 		Listener: proxyTCPListener,
 	}
 
-	errChan := make(chan error, 2)
+	metricsServer := &http.Server{
+		Addr:    s.config.MetricsAddr,
+		Handler: metrics.Handler(),
+	}
+
+	errChan := make(chan error, 3)
+	go func() {
+		slog.Info("starting metrics server", "addr", s.config.MetricsAddr)
+		if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			slog.Error("metrics server error", "error", err)
+			errChan <- err
+		}
+	}()
 	go func() {
 		slog.Info("starting UDP server")
 		if err := udpServer.ListenAndServe(); err != nil {
@@ -344,34 +375,194 @@ func (s *Server) Start() error {
 		if err := tcpServer.Shutdown(); err != nil {
 			slog.Error("failed to shutdown TCP server", "error", err)
 		}
+		if err := metricsServer.Close(); err != nil {
+			slog.Error("failed to shutdown metrics server", "error", err)
+		}
+		// 写入还没有 flush 的查询统计
+		stopAnalytics()
+		<-analyticsDone
+		if s.tapper != nil {
+			s.tapper.Close()
+		}
+		if s.queryLog != nil {
+			if err := s.queryLog.Close(); err != nil {
+				slog.Error("failed to close query log", "error", err)
+			}
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		if err := s.shutdownTracing(ctx); err != nil {
+			slog.Error("failed to flush traces", "error", err)
+		}
 		return nil
 	}
 }
 
-func (s *Server) handleSOA(m *dns.Msg, q dns.Question) {
+// handleQuery 处理一个 DNS 查询
+func (s *Server) handleQuery(w dns.ResponseWriter, r *dns.Msg) {
+	m := new(dns.Msg)
+	m.SetReply(r)
+	m.Authoritative = true
+
+	req, ok := s.parseEDNS(w, r, m)
+	if !ok {
+		m.Authoritative = false
+		s.writeMsg(w, req, m)
+		return
+	}
+	// 只支持一个 question, 没有或者有多个时返回 FORMERR
+	if len(r.Question) != 1 {
+		m.Authoritative = false
+		m.Rcode = dns.RcodeFormatError
+		s.writeMsg(w, req, m)
+		return
+	}
+	firstQuestion := r.Question[0]
+	// 在 bloom filter, 缓存, GeoIP 和 ask 之前先按照 qname 限速, 被限速的请求不消耗后面的资源
+	if s.rateLimited(w, req) {
+		switch s.rrl.CheckQuery(remoteIP(w), firstQuestion) {
+		case rrlDrop:
+			return
+		case rrlSlip:
+			slipMsg(m)
+			s.writeReply(w, req, m)
+			return
+		}
+	}
+	switch firstQuestion.Qclass {
+	case dns.ClassINET:
+	case dns.ClassCHAOS:
+		s.handleChaos(m, firstQuestion)
+		s.writeMsg(w, req, m)
+		return
+	default:
+		m.Authoritative = false
+		m.Rcode = dns.RcodeRefused
+		setEDE(m, dns.ExtendedErrorCodeNotSupported, "only IN and CH classes are supported")
+		s.writeMsg(w, req, m)
+		return
+	}
+	// 不支持区域传送
+	if firstQuestion.Qtype == dns.TypeAXFR || firstQuestion.Qtype == dns.TypeIXFR {
+		m.Rcode = dns.RcodeRefused
+		setEDE(m, dns.ExtendedErrorCodeNotSupported, "zone transfers are not supported")
+		s.writeMsg(w, req, m)
+		return
+	}
+
+	if firstQuestion.Qtype == dns.TypeTXT && s.isAskName(firstQuestion.Name) {
+		s.handleAsk(w, m, firstQuestion)
+		s.writeMsg(w, req, m)
+		return
+	}
+
+	// 获取 domain
+	name := firstQuestion.Name
+	name = strings.TrimSuffix(name, ".")
+	name = strings.ToLower(name)
+	name, err := idna.ToASCII(name)
+	if err != nil {
+		slog.Error("failed to convert name to ASCII", "error", err, "name", name)
+		m.Rcode = dns.RcodeServerFailure
+		setEDE(m, dns.ExtendedErrorCodeOther, "invalid IDN name")
+		s.writeMsg(w, req, m)
+		return
+	}
+	// 提取 zone（获取顶级域名）
+	zoneName, err := publicsuffix.Domain(name)
+	zoneName = strings.TrimSuffix(zoneName, ".")
+	if lo.Contains(BLACK_LIST_ZONE, zoneName) {
+		m.Rcode = dns.RcodeNameError
+		s.writeMsg(w, req, m)
+		return
+	}
+	if err != nil {
+		slog.Error("failed to get zone", "error", err, "name", firstQuestion.Name)
+		m.Rcode = dns.RcodeNameError
+		s.writeMsg(w, req, m)
+		return
+	}
+
+	ctx := queryContext(w)
+	// 使用bloom filter检查Zone是否存在
+	_, bloomSpan := tracing.Start(ctx, "dns.bloom")
+	inBloomFilter := s.bloomFilter.TestString(zoneName)
+	bloomSpan.SetAttributes(attribute.Bool("dns.bloom.hit", inBloomFilter))
+	bloomSpan.End()
+	metrics.DNSBloomFilter.WithLabelValues(metrics.Result(inBloomFilter)).Inc()
+	if !inBloomFilter {
+		slog.Debug("zone not found in bloom filter", "zone", zoneName)
+		m.Rcode = dns.RcodeNameError
+		s.writeMsg(w, req, m)
+		return
+	}
+	zone, err := s.cache.GetZone(ctx, zoneName)
+	if err != nil {
+		// 只有确定没有 active zone 时才返回 NXDOMAIN, 数据库错误时返回 NXDOMAIN 会被递归服务器缓存
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			slog.Debug("zone not found", "zone", zoneName)
+			m.Rcode = dns.RcodeNameError
+		} else {
+			slog.Error("failed to get zone", "zone", zoneName, "error", err)
+			m.Rcode = dns.RcodeServerFailure
+			setEDE(m, dns.ExtendedErrorCodeOther, "zone data unavailable")
+		}
+		s.writeMsg(w, req, m)
+		return
+	}
+	// 获取 record
+	records, err := s.cache.GetRecords(ctx, zoneName)
+	if err != nil {
+		slog.Error("failed to get records", "error", err)
+		m.Rcode = dns.RcodeServerFailure
+		setEDE(m, dns.ExtendedErrorCodeOther, "zone data unavailable")
+		s.writeMsg(w, req, m)
+		return
+	}
+	s.lookup(ctx, m, firstQuestion, zone, records)
+	ip, _, _ := clientIP(w, r)
+	s.analytics.Record(zone.ID, name, dns.TypeToString[firstQuestion.Qtype], dns.RcodeToString[m.Rcode], s.country(ctx, ip))
+	s.writeMsg(w, req, m)
+}
+
+func (s *Server) handleSOA(m *dns.Msg, q dns.Question, zone *models.Zone) {
+	soa, ok := s.soaRecord(zone)
+	if !ok {
+		m.Rcode = dns.RcodeServerFailure
+		setEDE(m, dns.ExtendedErrorCodeOther, "no nameservers configured")
+		return
+	}
+	soa.Hdr.Name = q.Name
+	m.Answer = append(m.Answer, soa)
+}
+
+func (s *Server) soaRecord(zone *models.Zone) (*dns.SOA, bool) {
+	nameservers := s.nameservers(zone)
+	if len(nameservers) == 0 {
+		slog.Error("no nameservers configured", "zone", zone.ZoneName)
+		return nil, false
+	}
 	now := time.Now()
 	serial := uint32(now.Year())*10000 + uint32(now.Month())*100 + uint32(now.Day())
-	rr := &dns.SOA{
+	return &dns.SOA{
 		Hdr: dns.RR_Header{
-			Name:   q.Name,
+			Name:   dns.Fqdn(zone.ZoneName),
 			Rrtype: dns.TypeSOA,
 			Class:  dns.ClassINET,
 			Ttl:    3600,
 		},
-		Ns:      s.config.NS1,
-		Mbox:    s.config.MBox,
+		Ns:      dns.Fqdn(nameservers[0]),
+		Mbox:    dns.Fqdn(zone.EffectiveMBox(s.config.MBox)),
 		Serial:  serial,
 		Refresh: 1800,
 		Retry:   600,
 		Expire:  86400,
 		Minttl:  60,
-	}
-	m.Answer = append(m.Answer, rr)
+	}, true
 }
 
-func (s *Server) handleNS(m *dns.Msg, q dns.Question) {
-	nsRecords := []string{s.config.NS1, s.config.NS2}
-	for _, ns := range nsRecords {
+func (s *Server) handleNS(m *dns.Msg, q dns.Question, zone *models.Zone, records []models.DNSRecord) {
+	for _, ns := range s.nameservers(zone) {
 		rr := &dns.NS{
 			Hdr: dns.RR_Header{
 				Name:   q.Name,
@@ -382,65 +573,39 @@ func (s *Server) handleNS(m *dns.Msg, q dns.Question) {
 			Ns: dns.Fqdn(ns),
 		}
 		m.Answer = append(m.Answer, rr)
+		// zone 内的 NS (如 ns1.example.com) 需要在 additional 中带上 glue
+		if dns.IsSubDomain(dns.Fqdn(zone.ZoneName), dns.Fqdn(ns)) {
+			m.Extra = append(m.Extra, glueRecords(ns, records)...)
+		}
 	}
 }
 
-func (s *Server) handleA(m *dns.Msg, q dns.Question, records []models.DNSRecord) {
-	if len(records) == 0 {
-		m.Rcode = dns.RcodeNameError
-		return
-	}
-	name := strings.TrimSuffix(q.Name, ".")
-	name = strings.ToLower(name)
-	aRecords := lo.Filter(records, func(record models.DNSRecord, _ int) bool {
-		return record.Name == name && record.Type == "A"
-	})
-	cnameRecords := lo.Filter(records, func(record models.DNSRecord, _ int) bool {
-		return record.Name == name && record.Type == "CNAME"
-	})
-	if len(aRecords) > 0 {
-		record := s.selectRecordWithWeight(aRecords)
-		rr := &dns.A{
-			Hdr: dns.RR_Header{
-				Name:   q.Name,
-				Rrtype: dns.TypeA,
-				Class:  dns.ClassINET,
-				Ttl:    uint32(record.TTL),
-			},
-			A: net.ParseIP(record.Content).To4(),
-		}
-		m.Answer = append(m.Answer, rr)
-	} else if len(cnameRecords) > 0 {
-		record := s.selectRecordWithWeight(cnameRecords)
-		s.flattenCNAME(m, q, record)
-		return
-	} else {
-		m.Rcode = dns.RcodeNameError
-		return
-	}
+// nameservers 返回 zone 的 NS 列表, 没有自定义 NS 时使用默认的 NS1/NS2
+func (s *Server) nameservers(zone *models.Zone) []string {
+	return zone.EffectiveNameservers(s.config.NS1, s.config.NS2)
 }
 
-func (s *Server) handleCNAME(m *dns.Msg, q dns.Question, records []models.DNSRecord) {
-	name := strings.TrimSuffix(q.Name, ".")
-	name = strings.ToLower(name)
-	records = lo.Filter(records, func(record models.DNSRecord, _ int) bool {
-		return record.Name == name && record.Type == "CNAME"
-	})
-	if len(records) == 0 {
-		m.Rcode = dns.RcodeNameError
-		return
-	}
-	record := s.selectRecordWithWeight(records)
-	rr := &dns.CNAME{
-		Hdr: dns.RR_Header{
-			Name:   q.Name,
-			Rrtype: dns.TypeCNAME,
-			Class:  dns.ClassINET,
-			Ttl:    uint32(record.TTL),
-		},
-		Target: record.Content,
+func glueRecords(ns string, records []models.DNSRecord) []dns.RR {
+	glue := make([]dns.RR, 0)
+	for _, record := range records {
+		if record.Name != ns {
+			continue
+		}
+		hdr := dns.RR_Header{
+			Name:  dns.Fqdn(ns),
+			Class: dns.ClassINET,
+			Ttl:   uint32(record.TTL),
+		}
+		switch record.Type {
+		case "A":
+			hdr.Rrtype = dns.TypeA
+			glue = append(glue, &dns.A{Hdr: hdr, A: net.ParseIP(record.Content).To4()})
+		case "AAAA":
+			hdr.Rrtype = dns.TypeAAAA
+			glue = append(glue, &dns.AAAA{Hdr: hdr, AAAA: net.ParseIP(record.Content).To16()})
+		}
 	}
-	m.Answer = append(m.Answer, rr)
+	return glue
 }
 
 func (s *Server) selectRecordWithWeight(records []models.DNSRecord) models.DNSRecord {
@@ -464,76 +629,6 @@ func (s *Server) selectRecordWithWeight(records []models.DNSRecord) models.DNSRe
 	return record
 }
 
-func (s *Server) flattenCNAME(m *dns.Msg, q dns.Question, record models.DNSRecord) {
-	targetDomain := record.Content
-	client := dns.Client{
-		Timeout: time.Second * 5,
-	}
-	msg := dns.Msg{}
-	msg.SetQuestion(dns.Fqdn(targetDomain), dns.TypeA)
-	resp, _, err := client.Exchange(&msg, "8.8.8.8:53")
-	if err != nil {
-		slog.Error("failed to resolve CNAME target", "error", err, "target", targetDomain)
-		m.Rcode = dns.RcodeServerFailure //  设置错误码
-		return
-	}
-	//  检查DNS响应码
-	if resp.Rcode != dns.RcodeSuccess {
-		slog.Warn("CNAME target resolution failed", "target", targetDomain, "rcode", resp.Rcode)
-		m.Rcode = resp.Rcode // 传递上游错误码
-		return
-	}
-	//  可以考虑处理CNAME链
-	for _, answer := range resp.Answer {
-		if aRecord, ok := answer.(*dns.A); ok {
-			rr := &dns.A{
-				Hdr: dns.RR_Header{
-					Name:   q.Name,
-					Rrtype: dns.TypeA,
-					Class:  dns.ClassINET,
-					Ttl:    uint32(record.TTL),
-				},
-				A: aRecord.A,
-			}
-			m.Answer = append(m.Answer, rr)
-		}
-	}
-	if len(m.Answer) == 0 {
-		m.Rcode = dns.RcodeNameError
-	}
-}
-
-func (s *Server) handleCAA(m *dns.Msg, q dns.Question) {
-	// handleCAA 处理CAA记录查询请求
-	// CAA记录用于指定哪些证书颁发机构(CA)可以为该域名颁发证书
-	// 遵循RFC 6844标准：https://tools.ietf.org/html/rfc6844
-	// 当前配置：允许所有证书颁发机构颁发证书
-
-	// 要允许所有CA颁发证书，我们不返回任何限制性的CAA记录
-	// 根据RFC 6844，如果没有CAA记录或没有相关的issue记录，
-	// 则允许任何CA为该域名颁发证书
-
-	// 可选：只返回iodef记录用于违规报告，但不限制任何CA
-	caas := []*dns.CAA{
-		{
-			Hdr: dns.RR_Header{
-				Name:   q.Name,        // 查询的域名
-				Rrtype: dns.TypeCAA,   // 记录类型：CAA
-				Class:  dns.ClassINET, // 类别：Internet
-				Ttl:    3600,          // TTL：1小时
-			},
-			Flag:  0,                                                                     // 标志位：0表示非关键
-			Tag:   "iodef",                                                               // 标签：iodef表示事件报告
-			Value: "mailto:security@" + strings.TrimSuffix(strings.ToLower(q.Name), "."), // 违规报告邮箱
-		},
-	}
-
-	// 将所有CAA记录添加到DNS响应中
-	for _, caa := range caas {
-		m.Answer = append(m.Answer, caa)
-	}
-}
-
 func (s *Server) startSubscribeRedis() {
 	slog.Info("start subscribe redis", "event_channel", "event")
 	ctx := context.Background()
@@ -550,12 +645,19 @@ func (s *Server) startSubscribeRedis() {
 			slog.Error("failed to unmarshal event", "error", err)
 			continue
 		}
+		_, span := tracing.Start(evt.Context(ctx), "event.apply", trace.WithSpanKind(trace.SpanKindConsumer), trace.WithAttributes(
+			attribute.String("event.type", string(evt.Type)),
+			attribute.String("event.zone_name", evt.ZoneName),
+		))
 		switch evt.Type {
 		case event.EventTypeDNSRecordCreate, event.EventTypeDNSRecordDelete, event.EventTypeDNSRecordUpdate:
 			s.cache.InvalidateCache(evt.ZoneName)
 		case event.EventTypeZoneCreate:
 			slog.Info("zone create", "zone_name", evt.ZoneName)
+			s.cache.InvalidateCache(evt.ZoneName)
 			s.bloomFilter.AddString(evt.ZoneName)
+		case event.EventTypeZoneUpdate:
+			s.cache.InvalidateCache(evt.ZoneName)
 		case event.EventTypeZoneDelete:
 			s.cache.InvalidateCache(evt.ZoneName)
 			s.pendingRebuilds++
@@ -572,6 +674,10 @@ func (s *Server) startSubscribeRedis() {
 				s.pendingRebuilds = 0
 			})
 		}
+		span.End()
+		if !evt.PublishedAt.IsZero() {
+			metrics.EventLag.WithLabelValues(string(evt.Type)).Observe(time.Since(evt.PublishedAt).Seconds())
+		}
 		slog.Info("received message", "message", evt)
 	}
 }
@@ -616,31 +722,53 @@ func clientIP(w dns.ResponseWriter, r *dns.Msg) (net.IP, bool, string) {
 	if opt := r.IsEdns0(); opt != nil {
 		for _, o := range opt.Option {
 			if e, ok := o.(*dns.EDNS0_SUBNET); ok && e.Address != nil {
-				slog.Info("found ECS", "address", e.Address.String(), "source_netmask", e.SourceNetmask)
 				return e.Address, true, "ecs"
 			}
 		}
 	}
 
-	remoteAddr := w.RemoteAddr()
-	network := remoteAddr.Network()
-	addrStr := remoteAddr.String()
+	if ip := remoteIP(w); ip != nil {
+		return ip, true, "remote_addr"
+	}
+	return nil, false, ""
+}
 
-	// proxy protocol listener 应该自动处理真实客户端地址
-	switch network {
+// remoteIP 返回连接的对端地址, proxy protocol listener 会自动处理真实客户端地址
+func remoteIP(w dns.ResponseWriter) net.IP {
+	remoteAddr := w.RemoteAddr()
+	switch remoteAddr.Network() {
 	case "tcp", "udp":
-		host, _, err := net.SplitHostPort(addrStr)
+		host, _, err := net.SplitHostPort(remoteAddr.String())
 		if err != nil {
-			return nil, false, ""
+			return nil
 		}
 		ip := net.ParseIP(host)
-		if ip == nil {
-			return nil, false, ""
+		if ip4 := ip.To4(); ip4 != nil {
+			return ip4
 		}
-		return ip, true, "remote_addr"
+		return ip
 	default:
-		return nil, false, ""
+		return nil
+	}
+}
+
+// country 返回 ip 所在国家的 ISO 3166 代码, 未知时为空
+func (s *Server) country(ctx context.Context, ip net.IP) string {
+	if ip == nil {
+		return ""
+	}
+	addr, ok := ipToNetip(ip)
+	if !ok {
+		return ""
+	}
+	_, span := tracing.Start(ctx, "dns.geo")
+	defer span.End()
+	country, err := s.geoDB.Country(addr)
+	if err != nil {
+		slog.Debug("geo lookup failed", "ip", ip, "error", err)
+		return ""
 	}
+	return country.Country.ISOCode
 }
 
 func ipToNetip(ip net.IP) (netip.Addr, bool) {
@@ -0,0 +1,58 @@
+package dns
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/bits-and-blooms/bloom/v3"
+	"github.com/miekg/dns"
+
+	"dnsarc/internal/database/dbtest"
+)
+
+func TestHandleQueryZoneLookupError(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		rcode int
+	}{
+		{"no active zone", nil, dns.RcodeNameError},
+		{"database unavailable", errors.New("connection refused"), dns.RcodeServerFailure},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := dbtest.New(t)
+			if tt.err != nil {
+				db.SetError("zones", tt.err)
+			}
+			cache, err := NewDNSCache(db.DB, 100, time.Hour)
+			if err != nil {
+				t.Fatal(err)
+			}
+			filter := bloom.NewWithEstimates(1000, 0.01)
+			filter.AddString("example.com")
+			s := &Server{config: &Config{}, cache: cache, bloomFilter: filter}
+
+			r := new(dns.Msg)
+			r.SetQuestion("www.example.com.", dns.TypeA)
+			r.SetEdns0(dns.DefaultMsgSize, false)
+			w := &testWriter{remote: &net.UDPAddr{IP: net.IPv4(198, 51, 100, 7), Port: 40000}}
+			s.handleQuery(w, r)
+			if w.msg == nil {
+				t.Fatal("no response")
+			}
+			if w.msg.Rcode != tt.rcode {
+				t.Errorf("rcode = %s, want %s", dns.RcodeToString[w.msg.Rcode], dns.RcodeToString[tt.rcode])
+			}
+			code, ok := edeCode(w.msg)
+			if tt.rcode == dns.RcodeServerFailure && (!ok || code != dns.ExtendedErrorCodeOther) {
+				t.Errorf("extended error = %d, %v, want %d", code, ok, dns.ExtendedErrorCodeOther)
+			}
+			if tt.rcode == dns.RcodeNameError && ok {
+				t.Errorf("unexpected extended error %d", code)
+			}
+		})
+	}
+}
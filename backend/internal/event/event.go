@@ -4,8 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"log/slog"
+	"time"
 
 	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"dnsarc/internal/tracing"
 )
 
 type EventType string
@@ -22,10 +27,29 @@ const (
 type Event struct {
 	Type     EventType `json:"type"`
 	ZoneName string    `json:"zone_name"`
+	// PublishedAt 发布时间, 用于统计事件从发布到生效的延迟
+	PublishedAt time.Time `json:"published_at,omitzero"`
+	// TraceContext 发布时的 trace context (W3C traceparent), DNS 服务处理事件时作为 parent
+	TraceContext map[string]string `json:"trace_context,omitempty"`
+}
+
+// Context 返回带有发布方 trace context 的 ctx
+func (e Event) Context(ctx context.Context) context.Context {
+	if len(e.TraceContext) == 0 {
+		return ctx
+	}
+	return tracing.Extract(ctx, e.TraceContext)
 }
 
-func PublishEvent(rdb *redis.Client, event Event) {
-	ctx := context.Background()
+// PublishEvent 发布事件, ctx 只用于传递 trace context, 调用方通常在 goroutine 中传入 context.WithoutCancel(ctx)
+func PublishEvent(ctx context.Context, rdb *redis.Client, event Event) {
+	ctx, span := tracing.Start(ctx, "event.publish", trace.WithSpanKind(trace.SpanKindProducer), trace.WithAttributes(
+		attribute.String("event.type", string(event.Type)),
+		attribute.String("event.zone_name", event.ZoneName),
+	))
+	defer span.End()
+	event.PublishedAt = time.Now()
+	event.TraceContext = tracing.Inject(ctx)
 	slog.Info("publish event", "event", event)
 	json, err := json.Marshal(event)
 	if err != nil {
@@ -33,6 +57,7 @@ func PublishEvent(rdb *redis.Client, event Event) {
 		return
 	}
 	if err := rdb.Publish(ctx, "event", string(json)).Err(); err != nil {
+		span.RecordError(err)
 		slog.Error("failed to publish event", "error", err)
 	}
 }
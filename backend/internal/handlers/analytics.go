@@ -0,0 +1,235 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	analyticsv1 "dnsarc/gen/analytics/v1"
+	"dnsarc/internal/models"
+	"dnsarc/internal/services"
+)
+
+const (
+	// maxAnalyticsRange 查询范围的上限, 与统计数据的保留时间一致
+	maxAnalyticsRange = time.Hour * 24 * 31
+	// maxAnalyticsPoints 每个序列最多的点数, 没有指定 step 时按照这个数量自动选择
+	maxAnalyticsPoints     = 2000
+	defaultAnalyticsPoints = 288
+	// maxAnalyticsSeries 分组后最多返回的序列数, 按照总数排序
+	maxAnalyticsSeries = 20
+	maxTopNames        = 100
+)
+
+type AnalyticsHandler struct {
+	db    *gorm.DB
+	authz *services.Authorizer
+}
+
+func NewAnalyticsHandler(db *gorm.DB, authz *services.Authorizer) *AnalyticsHandler {
+	return &AnalyticsHandler{db: db, authz: authz}
+}
+
+func (h *AnalyticsHandler) GetQueryTimeSeries(ctx context.Context, req *connect.Request[analyticsv1.GetQueryTimeSeriesRequest]) (*connect.Response[analyticsv1.GetQueryTimeSeriesResponse], error) {
+	zone, err := h.getZone(ctx, req.Msg.ZoneId)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := analyticsRange(req.Msg.Start, req.Msg.End)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	step, err := analyticsStep(req.Msg.StepSeconds, end.Sub(start))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	column := dimensionColumn(req.Msg.GroupBy)
+	// 按照 step 对齐到 unix 时间, 与下面补零的时间点保持一致
+	stepSeconds := int64(step.Seconds())
+	bucket := fmt.Sprintf("to_timestamp(floor(extract(epoch from bucket) / %d) * %d)", stepSeconds, stepSeconds)
+	query := h.db.WithContext(ctx).Model(&models.QueryStat{}).
+		Select(bucket+" AS ts, "+column+" AS key, SUM(count) AS count").
+		Where("zone_id = ? AND bucket >= ? AND bucket < ?", zone.ID, start, end)
+	query, err = applyQueryFilter(query, req.Msg.Filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	var rows []struct {
+		Ts    time.Time
+		Key   string
+		Count int64
+	}
+	if err := query.Group("ts, key").Order("ts").Scan(&rows).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// 补齐没有查询的时间点
+	first := time.Unix(start.Unix()/stepSeconds*stepSeconds, 0).UTC()
+	points := int((end.Sub(first) + step - 1) / step)
+	series := make(map[string]*analyticsv1.Series)
+	for _, row := range rows {
+		s, ok := series[row.Key]
+		if !ok {
+			s = &analyticsv1.Series{Key: row.Key, Points: make([]*analyticsv1.Point, points)}
+			for i := range s.Points {
+				s.Points[i] = &analyticsv1.Point{Timestamp: first.Add(step * time.Duration(i)).Format(time.RFC3339)}
+			}
+			series[row.Key] = s
+		}
+		i := int(row.Ts.Sub(first) / step)
+		if i < 0 || i >= points {
+			continue
+		}
+		s.Points[i].Count += row.Count
+		s.Total += row.Count
+	}
+	result := lo.Values(series)
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Total != result[j].Total {
+			return result[i].Total > result[j].Total
+		}
+		return result[i].Key < result[j].Key
+	})
+	if len(result) > maxAnalyticsSeries {
+		result = result[:maxAnalyticsSeries]
+	}
+	return &connect.Response[analyticsv1.GetQueryTimeSeriesResponse]{
+		Msg: &analyticsv1.GetQueryTimeSeriesResponse{
+			Series:      result,
+			StepSeconds: stepSeconds,
+		},
+	}, nil
+}
+
+func (h *AnalyticsHandler) GetTopNames(ctx context.Context, req *connect.Request[analyticsv1.GetTopNamesRequest]) (*connect.Response[analyticsv1.GetTopNamesResponse], error) {
+	zone, err := h.getZone(ctx, req.Msg.ZoneId)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := analyticsRange(req.Msg.Start, req.Msg.End)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > maxTopNames {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("limit must be at most %d", maxTopNames))
+	}
+	query := h.db.WithContext(ctx).Model(&models.QueryStat{}).
+		Select("name, SUM(count) AS count").
+		Where("zone_id = ? AND bucket >= ? AND bucket < ?", zone.ID, start, end)
+	query, err = applyQueryFilter(query, req.Msg.Filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	var rows []struct {
+		Name  string
+		Count int64
+	}
+	if err := query.Group("name").Order("count DESC, name").Limit(limit).Scan(&rows).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	names := make([]*analyticsv1.NameCount, 0, len(rows))
+	for _, row := range rows {
+		names = append(names, &analyticsv1.NameCount{Name: row.Name, Count: row.Count})
+	}
+	return &connect.Response[analyticsv1.GetTopNamesResponse]{
+		Msg: &analyticsv1.GetTopNamesResponse{
+			Names: names,
+		},
+	}, nil
+}
+
+func (h *AnalyticsHandler) getZone(ctx context.Context, zoneID string) (*models.Zone, error) {
+	return authorizeZone(ctx, h.authz, zoneID, services.PermissionViewZones)
+}
+
+// analyticsRange 解析 RFC3339 格式的查询范围, 默认为最近 24 小时
+func analyticsRange(startText, endText string) (time.Time, time.Time, error) {
+	end := time.Now().UTC()
+	if endText != "" {
+		t, err := time.Parse(time.RFC3339, endText)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid end %q", endText)
+		}
+		end = t.UTC()
+	}
+	start := end.Add(-time.Hour * 24)
+	if startText != "" {
+		t, err := time.Parse(time.RFC3339, startText)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid start %q", startText)
+		}
+		start = t.UTC()
+	}
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, errors.New("start must be before end")
+	}
+	if end.Sub(start) > maxAnalyticsRange {
+		return time.Time{}, time.Time{}, fmt.Errorf("range must be at most %s", maxAnalyticsRange)
+	}
+	return start, end, nil
+}
+
+// analyticsStep 校验 step, 必须是统计粒度的整数倍; 没有指定时根据范围自动选择
+func analyticsStep(stepSeconds int64, span time.Duration) (time.Duration, error) {
+	if stepSeconds == 0 {
+		step := span / defaultAnalyticsPoints
+		return max(models.QueryStatBucket, (step+models.QueryStatBucket-1)/models.QueryStatBucket*models.QueryStatBucket), nil
+	}
+	step := time.Duration(stepSeconds) * time.Second
+	if stepSeconds < 0 || step%models.QueryStatBucket != 0 {
+		return 0, fmt.Errorf("step must be a positive multiple of %d seconds", int64(models.QueryStatBucket.Seconds()))
+	}
+	if span/step > maxAnalyticsPoints {
+		return 0, fmt.Errorf("step is too small for the range, at most %d points are allowed", maxAnalyticsPoints)
+	}
+	return step, nil
+}
+
+// dimensionColumn 返回分组使用的列, 不分组时所有查询在同一个序列中
+func dimensionColumn(dimension analyticsv1.Dimension) string {
+	switch dimension {
+	case analyticsv1.Dimension_DIMENSION_QTYPE:
+		return "qtype"
+	case analyticsv1.Dimension_DIMENSION_RCODE:
+		return "rcode"
+	case analyticsv1.Dimension_DIMENSION_COUNTRY:
+		return "country"
+	case analyticsv1.Dimension_DIMENSION_NAME:
+		return "name"
+	default:
+		return "''"
+	}
+}
+
+func applyQueryFilter(query *gorm.DB, filter *analyticsv1.QueryFilter) (*gorm.DB, error) {
+	if filter == nil {
+		return query, nil
+	}
+	if filter.Name != "" {
+		query = query.Where("name = ?", strings.TrimSuffix(strings.ToLower(strings.TrimSpace(filter.Name)), "."))
+	}
+	if filter.Qtype != "" {
+		query = query.Where("qtype = ?", strings.ToUpper(filter.Qtype))
+	}
+	if filter.Rcode != "" {
+		query = query.Where("rcode = ?", strings.ToUpper(filter.Rcode))
+	}
+	if filter.Country != "" {
+		if len(filter.Country) != 2 {
+			return nil, fmt.Errorf("invalid country code %q", filter.Country)
+		}
+		query = query.Where("country = ?", strings.ToUpper(filter.Country))
+	}
+	return query, nil
+}
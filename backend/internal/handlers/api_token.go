@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	apitokenv1 "dnsarc/gen/apitoken/v1"
+	"dnsarc/internal/interceptors"
+	"dnsarc/internal/models"
+	"dnsarc/internal/services"
+)
+
+const maxAPITokens = 50
+
+type APITokenHandler struct {
+	db              *gorm.DB
+	authz           *services.Authorizer
+	apiTokenService *services.APITokenService
+}
+
+func NewAPITokenHandler(db *gorm.DB, authz *services.Authorizer, apiTokenService *services.APITokenService) *APITokenHandler {
+	return &APITokenHandler{db: db, authz: authz, apiTokenService: apiTokenService}
+}
+
+func (h *APITokenHandler) CreateApiToken(ctx context.Context, req *connect.Request[apitokenv1.CreateApiTokenRequest]) (*connect.Response[apitokenv1.CreateApiTokenResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	name := strings.TrimSpace(req.Msg.Name)
+	if name == "" || len(name) > 100 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name must be between 1 and 100 characters"))
+	}
+	var scope models.APITokenScope
+	switch req.Msg.Scope {
+	case apitokenv1.Scope_SCOPE_READ:
+		scope = models.APITokenScopeRead
+	case apitokenv1.Scope_SCOPE_WRITE:
+		scope = models.APITokenScopeWrite
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("scope is required"))
+	}
+	var expiresAt *time.Time
+	if req.Msg.ExpiresAt != "" {
+		t, err := time.Parse(time.RFC3339, req.Msg.ExpiresAt)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid expires_at %q", req.Msg.ExpiresAt))
+		}
+		if !t.After(time.Now()) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("expires_at must be in the future"))
+		}
+		expiresAt = &t
+	}
+	// 限制的 zone 必须是当前用户可以访问的, token 的权限不会超过用户在组织中的角色
+	zoneIDs := lo.Uniq(req.Msg.ZoneIds)
+	if len(zoneIDs) > 0 {
+		zones, err := h.authz.Zones(ctx, userID, "")
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		ids := lo.Map(zones, func(zone models.Zone, _ int) string { return zone.ID })
+		if !lo.Every(ids, zoneIDs) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("zone not found"))
+		}
+	}
+	var count int64
+	if err := h.db.WithContext(ctx).Model(&models.APIToken{}).Where("user_id = ?", userID).Count(&count).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if count >= maxAPITokens {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("at most %d api tokens are allowed", maxAPITokens))
+	}
+
+	token, prefix, hash, err := h.apiTokenService.GenerateToken()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	apiToken := models.APIToken{
+		UserID:    userID,
+		Name:      name,
+		Prefix:    prefix,
+		TokenHash: hash,
+		Scope:     scope,
+		ZoneIDs:   zoneIDs,
+		ExpiresAt: expiresAt,
+	}
+	if err := h.db.WithContext(ctx).Create(&apiToken).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// 明文 token 只在创建时返回一次
+	return connect.NewResponse(&apitokenv1.CreateApiTokenResponse{
+		ApiToken: apiToken.ToProto(),
+		Token:    token,
+	}), nil
+}
+
+func (h *APITokenHandler) ListApiTokens(ctx context.Context, req *connect.Request[apitokenv1.ListApiTokensRequest]) (*connect.Response[apitokenv1.ListApiTokensResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	var apiTokens []models.APIToken
+	if err := h.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at DESC").Find(&apiTokens).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&apitokenv1.ListApiTokensResponse{
+		ApiTokens: lo.Map(apiTokens, func(t models.APIToken, _ int) *apitokenv1.ApiToken {
+			return t.ToProto()
+		}),
+	}), nil
+}
+
+func (h *APITokenHandler) RevokeApiToken(ctx context.Context, req *connect.Request[apitokenv1.RevokeApiTokenRequest]) (*connect.Response[apitokenv1.RevokeApiTokenResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	result := h.db.WithContext(ctx).Where("user_id = ? AND id = ?", userID, req.Msg.Id).Delete(&models.APIToken{})
+	if result.Error != nil {
+		return nil, connect.NewError(connect.CodeInternal, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("api token not found"))
+	}
+	if err := h.db.WithContext(ctx).Where("principal_type = ? AND principal_id = ?", models.PrincipalTypeAPIToken, req.Msg.Id).Delete(&models.RecordGrant{}).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&apitokenv1.RevokeApiTokenResponse{}), nil
+}
+
+// checkZoneAccess 使用限制了 zone 的 API token 时, 检查是否可以访问 zone
+func checkZoneAccess(ctx context.Context, zoneID string) error {
+	if !interceptors.CanAccessZone(ctx, zoneID) {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("api token has no access to this zone"))
+	}
+	return nil
+}
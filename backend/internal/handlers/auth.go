@@ -6,44 +6,82 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
-	"golang.org/x/oauth2"
+	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 
 	authv1 "dnsarc/gen/auth/v1"
 	"dnsarc/internal/interceptors"
+	"dnsarc/internal/mail"
 	"dnsarc/internal/models"
+	"dnsarc/internal/oauth"
 	"dnsarc/internal/services"
 )
 
+const (
+	// 连续登录失败 maxFailedLogins 次后锁定 lockoutDuration
+	maxFailedLogins = 5
+	lockoutDuration = time.Minute * 15
+
+	verifyEmailTokenTTL   = time.Hour * 24
+	resetPasswordTokenTTL = time.Hour
+)
+
 type AuthHandler struct {
-	db              *gorm.DB
-	jwtService      *services.JwtService
-	googleOauthConf *oauth2.Config
+	db               *gorm.DB
+	rdb              *redis.Client
+	sessionService   *services.SessionService
+	mfaService       *services.MFAService
+	providers        *oauth.Registry
+	states           *oauth.Store
+	userTokenService *services.UserTokenService
+	mailer           mail.Sender
+	frontendURL      string
 }
 
-func NewAuthHandler(db *gorm.DB, jwtService *services.JwtService, googleOauthConf *oauth2.Config) *AuthHandler {
-	return &AuthHandler{db: db, jwtService: jwtService, googleOauthConf: googleOauthConf}
+func NewAuthHandler(db *gorm.DB, rdb *redis.Client, sessionService *services.SessionService, mfaService *services.MFAService, providers *oauth.Registry, states *oauth.Store, userTokenService *services.UserTokenService, mailer mail.Sender, frontendURL string) *AuthHandler {
+	return &AuthHandler{
+		db:               db,
+		rdb:              rdb,
+		sessionService:   sessionService,
+		mfaService:       mfaService,
+		providers:        providers,
+		states:           states,
+		userTokenService: userTokenService,
+		mailer:           mailer,
+		frontendURL:      frontendURL,
+	}
 }
 
+// GoogleLoginURL 已废弃, 使用 GetLoginURL
 func (h *AuthHandler) GoogleLoginURL(ctx context.Context, req *connect.Request[authv1.GoogleLoginURLRequest]) (*connect.Response[authv1.GoogleLoginURLResponse], error) {
-	return connect.NewResponse(&authv1.GoogleLoginURLResponse{
-		Url: h.googleOauthConf.AuthCodeURL("state"),
-	}), nil
+	res := connect.NewResponse(&authv1.GoogleLoginURLResponse{})
+	url, err := h.authCodeURL(ctx, res.Header(), oauth.ProviderGoogle, "")
+	if err != nil {
+		return nil, err
+	}
+	res.Msg.Url = url
+	return res, nil
 }
 
 func (h *AuthHandler) WhoAmI(ctx context.Context, req *connect.Request[authv1.WhoAmIRequest]) (*connect.Response[authv1.WhoAmIResponse], error) {
 	userID, _ := interceptors.GetUserID(ctx)
 	var user models.User
-	if err := h.db.Where("id = ?", userID).First(&user).Error; err != nil {
+	if err := h.db.WithContext(ctx).Where("id = ?", userID).First(&user).Error; err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
 	}
+	mfaEnabled, err := h.mfaService.Enabled(ctx, &user)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
 	return connect.NewResponse(&authv1.WhoAmIResponse{
 		User: &authv1.User{
-			Id:        user.ID,
-			Email:     user.Email,
-			Avatar:    user.Avatar,
-			CreatedAt: user.CreatedAt.Format(time.RFC3339),
-			UpdatedAt: user.UpdatedAt.Format(time.RFC3339),
+			Id:            user.ID,
+			Email:         user.Email,
+			Avatar:        user.Avatar,
+			CreatedAt:     user.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:     user.UpdatedAt.Format(time.RFC3339),
+			EmailVerified: user.EmailVerified,
+			MfaEnabled:    mfaEnabled,
 		},
 	}), nil
 }
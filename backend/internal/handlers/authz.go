@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	"dnsarc/internal/interceptors"
+	"dnsarc/internal/models"
+	"dnsarc/internal/services"
+)
+
+// authorizeZone 检查当前用户在 zone 所属组织中的角色, 以及 API token 的 zone 限制
+//
+// 管理 zone 时还要求用户和 token 在 zone 中没有 grant, 受 grant 限制的对象只能修改匹配的记录
+func authorizeZone(ctx context.Context, authz *services.Authorizer, zoneID string, permission services.Permission) (*models.Zone, error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	zone, err := authz.Zone(ctx, userID, zoneID, permission)
+	if err != nil {
+		return nil, authzError(err)
+	}
+	if err := checkZoneAccess(ctx, zone.ID); err != nil {
+		return nil, err
+	}
+	if permission == services.PermissionManageZones {
+		restricted, err := authz.Restricted(ctx, currentPrincipal(ctx), zone.ID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if restricted {
+			return nil, connect.NewError(connect.CodePermissionDenied, services.ErrZoneRestricted)
+		}
+	}
+	return zone, nil
+}
+
+// authorizeZoneByName 和 authorizeZone 相同, organizationID 为空时在用户的所有组织中查找
+func authorizeZoneByName(ctx context.Context, authz *services.Authorizer, organizationID, zoneName string, permission services.Permission) (*models.Zone, error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	zone, err := authz.ZoneByName(ctx, userID, organizationID, zoneName, permission)
+	if err != nil {
+		return nil, authzError(err)
+	}
+	if err := checkZoneAccess(ctx, zone.ID); err != nil {
+		return nil, err
+	}
+	return zone, nil
+}
+
+// authorizeRecord 检查记录所在 zone 的权限
+func authorizeRecord(ctx context.Context, authz *services.Authorizer, recordID string, permission services.Permission) (*models.DNSRecord, error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	record, err := authz.Record(ctx, userID, recordID, permission)
+	if err != nil {
+		return nil, authzError(err)
+	}
+	if err := checkZoneAccess(ctx, record.ZoneID); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// authorizeRecordChange 检查当前用户和 API token 的 grant 是否允许修改 name 上 recordType 类型的记录
+func authorizeRecordChange(ctx context.Context, authz *services.Authorizer, zoneID, name, recordType string) error {
+	if err := authz.AuthorizeRecordChange(ctx, currentPrincipal(ctx), zoneID, name, recordType); err != nil {
+		return authzError(err)
+	}
+	return nil
+}
+
+// currentPrincipal 返回当前请求的用户和使用的 API token
+func currentPrincipal(ctx context.Context) services.Principal {
+	userID, _ := interceptors.GetUserID(ctx)
+	principal := services.Principal{UserID: userID}
+	if apiToken, ok := interceptors.GetAPIToken(ctx); ok {
+		principal.APITokenID = apiToken.ID
+	}
+	return principal
+}
+
+// authorizeOrganization 检查当前用户在组织中的权限, 返回用户的角色
+func authorizeOrganization(ctx context.Context, authz *services.Authorizer, organizationID string, permission services.Permission) (models.OrgRole, error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	role, err := authz.Authorize(ctx, userID, organizationID, permission)
+	if err != nil {
+		return "", authzError(err)
+	}
+	return role, nil
+}
+
+// authzError 不是成员时返回 not found, 角色不够时返回 permission denied
+func authzError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrOrganizationNotFound),
+		errors.Is(err, services.ErrZoneNotFound),
+		errors.Is(err, services.ErrRecordNotFound),
+		errors.Is(err, services.ErrMemberNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, services.ErrRoleForbidden),
+		errors.Is(err, services.ErrRecordNotGranted),
+		errors.Is(err, services.ErrZoneRestricted),
+		errors.Is(err, services.ErrLastOwner),
+		errors.Is(err, services.ErrInvitationEmail):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	case errors.Is(err, services.ErrPersonalOrganization),
+		errors.Is(err, services.ErrOrganizationHasZones),
+		errors.Is(err, services.ErrInvalidInvitation):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, services.ErrAlreadyMember):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
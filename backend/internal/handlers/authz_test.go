@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	dns_recordv1 "dnsarc/gen/dns_record/v1"
+	"dnsarc/internal/database/dbtest"
+	"dnsarc/internal/interceptors"
+	"dnsarc/internal/models"
+	"dnsarc/internal/services"
+)
+
+// newTestAuthzDB editor 角色的 user-a 在 example.com 中有一条 www 的 A 记录
+func newTestAuthzDB(t *testing.T) *dbtest.DB {
+	t.Helper()
+	db := dbtest.New(t)
+	db.Set("organization_members", []models.OrganizationMember{{OrganizationID: "org-a", UserID: "user-a", Role: models.OrgRoleEditor}})
+	db.Set("zones", []models.Zone{{ID: "zone-a", OrganizationID: "org-a", ZoneName: "example.com"}})
+	db.Set("dns_records", []models.DNSRecord{{ID: "record-a", ZoneID: "zone-a", ZoneName: "example.com", Name: "www.example.com", Type: "A", Content: "192.0.2.1", TTL: 300}})
+	return db
+}
+
+func TestUpdateDNSRecordOutOfGrant(t *testing.T) {
+	tests := []struct {
+		name  string
+		grant models.RecordGrant
+		req   *dns_recordv1.UpdateDNSRecordRequest
+	}{
+		{
+			"rename out of grant",
+			models.RecordGrant{NamePattern: "www.example.com"},
+			&dns_recordv1.UpdateDNSRecordRequest{Id: "record-a", Name: "api"},
+		},
+		{
+			"change type out of grant",
+			models.RecordGrant{NamePattern: "www.example.com", Types: []string{"A"}},
+			&dns_recordv1.UpdateDNSRecordRequest{Id: "record-a", Type: "TXT", Content: "hello"},
+		},
+		{
+			"move record into grant",
+			models.RecordGrant{NamePattern: "api.example.com"},
+			&dns_recordv1.UpdateDNSRecordRequest{Id: "record-a", Name: "api"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestAuthzDB(t)
+			tt.grant.ZoneID, tt.grant.PrincipalType, tt.grant.PrincipalID = "zone-a", models.PrincipalTypeUser, "user-a"
+			db.Set("record_grants", []models.RecordGrant{tt.grant})
+			h := NewDNSRecordHandler(db.DB, nil, services.NewAuthorizer(db.DB))
+			ctx := context.WithValue(context.Background(), interceptors.UserIDKey, "user-a")
+
+			_, err := h.UpdateDNSRecord(ctx, connect.NewRequest(tt.req))
+			if connect.CodeOf(err) != connect.CodePermissionDenied || !errors.Is(err, services.ErrRecordNotGranted) {
+				t.Fatalf("err = %v, want %v", err, services.ErrRecordNotGranted)
+			}
+			for _, query := range db.Queries() {
+				if strings.HasPrefix(query, "UPDATE") {
+					t.Errorf("record updated: %s", query)
+				}
+			}
+		})
+	}
+}
@@ -2,11 +2,13 @@ package handlers
 
 import (
 	"context"
-	"encoding/json"
-	"log/slog"
+	"errors"
+	"fmt"
+	"math"
 	"strings"
 
 	"connectrpc.com/connect"
+	"github.com/miekg/dns"
 	"github.com/redis/go-redis/v9"
 	"github.com/samber/lo"
 	"gorm.io/gorm"
@@ -15,59 +17,62 @@ import (
 	"dnsarc/internal/event"
 	"dnsarc/internal/interceptors"
 	"dnsarc/internal/models"
+	"dnsarc/internal/records"
+	"dnsarc/internal/services"
 )
 
 type DNSRecordHandler struct {
-	db  *gorm.DB
-	rdb *redis.Client
+	db    *gorm.DB
+	rdb   *redis.Client
+	authz *services.Authorizer
 }
 
-func NewDNSRecordHandler(db *gorm.DB, rdb *redis.Client) *DNSRecordHandler {
-	return &DNSRecordHandler{db: db, rdb: rdb}
+func NewDNSRecordHandler(db *gorm.DB, rdb *redis.Client, authz *services.Authorizer) *DNSRecordHandler {
+	return &DNSRecordHandler{db: db, rdb: rdb, authz: authz}
 }
 
-func (h *DNSRecordHandler) PublishEvent(event event.Event) {
-	ctx := context.Background()
-	slog.Info("publish event", "event", event)
-	json, err := json.Marshal(event)
-	if err != nil {
-		slog.Error("failed to marshal event", "error", err)
-		return
-	}
-	if err := h.rdb.Publish(ctx, "event", string(json)).Err(); err != nil {
-		slog.Error("failed to publish event", "error", err)
-	}
+func (h *DNSRecordHandler) PublishEvent(ctx context.Context, evt event.Event) {
+	event.PublishEvent(ctx, h.rdb, evt)
 }
 
 func (h *DNSRecordHandler) CreateDNSRecord(ctx context.Context, req *connect.Request[dns_recordv1.CreateDNSRecordRequest]) (*connect.Response[dns_recordv1.CreateDNSRecordResponse], error) {
 	userID, _ := interceptors.GetUserID(ctx)
-	name := strings.ToLower(req.Msg.Name) // 这里 name 是 @ 或者 api 这种，需要转换为 name
-	name = strings.TrimSuffix(name, ".")
-	var zone models.Zone
-	if err := h.db.Where("user_id = ? AND zone_name = ?", userID, req.Msg.ZoneName).First(&zone).Error; err != nil {
-		return nil, connect.NewError(connect.CodeNotFound, err)
-	}
-	// 拼接成完整的 name
-	if name == "@" || name == "" {
-		name = zone.ZoneName
-	} else {
-		name = name + "." + zone.ZoneName
+	zone, err := authorizeZoneByName(ctx, h.authz, req.Msg.OrganizationId, req.Msg.ZoneName, services.PermissionEditRecords)
+	if err != nil {
+		return nil, err
+	}
+	// 这里 name 是 @ 或者 api 这种，需要转换为完整的 name
+	name := recordName(zone.ZoneName, req.Msg.Name)
+	recordType := models.NormalizeRecordType(req.Msg.Type)
+	if err := authorizeRecordChange(ctx, h.authz, zone.ID, name, recordType); err != nil {
+		return nil, err
+	}
+	if err := validateAlias(zone.ZoneName, name, recordType, req.Msg.Content); err != nil {
+		return nil, err
+	}
+	if err := h.checkCNAMEConflict(ctx, zone.ID, name, recordType, ""); err != nil {
+		return nil, err
+	}
+	content, svcb, err := normalizeContent(recordType, req.Msg.Content, req.Msg.Svcb)
+	if err != nil {
+		return nil, err
 	}
 	record := models.DNSRecord{
 		UserID:   userID,
 		ZoneID:   zone.ID,
 		ZoneName: zone.ZoneName,
 		Name:     name,
-		Type:     req.Msg.Type,
-		Content:  req.Msg.Content,
+		Type:     recordType,
+		Content:  content,
 		TTL:      int(req.Msg.Ttl),
 		Weight:   int(req.Msg.Weight),
+		SVCB:     svcb,
 	}
-	if err := h.db.Create(&record).Error; err != nil {
+	if err := h.db.WithContext(ctx).Create(&record).Error; err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	go func() {
-		h.PublishEvent(event.Event{
+		h.PublishEvent(context.WithoutCancel(ctx), event.Event{
 			Type:     event.EventTypeDNSRecordCreate,
 			ZoneName: zone.ZoneName,
 		})
@@ -80,9 +85,12 @@ func (h *DNSRecordHandler) CreateDNSRecord(ctx context.Context, req *connect.Req
 }
 
 func (h *DNSRecordHandler) ListDNSRecords(ctx context.Context, req *connect.Request[dns_recordv1.ListDNSRecordsRequest]) (*connect.Response[dns_recordv1.ListDNSRecordsResponse], error) {
-	userID, _ := interceptors.GetUserID(ctx)
+	zone, err := authorizeZone(ctx, h.authz, req.Msg.ZoneId, services.PermissionViewZones)
+	if err != nil {
+		return nil, err
+	}
 	var records []models.DNSRecord
-	if err := h.db.Where("user_id = ? AND zone_id = ?", userID, req.Msg.ZoneId).Find(&records).Error; err != nil {
+	if err := h.db.WithContext(ctx).Where("zone_id = ?", zone.ID).Find(&records).Error; err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	return &connect.Response[dns_recordv1.ListDNSRecordsResponse]{
@@ -95,9 +103,12 @@ func (h *DNSRecordHandler) ListDNSRecords(ctx context.Context, req *connect.Requ
 }
 
 func (h *DNSRecordHandler) ListDNSRecordsByZoneName(ctx context.Context, req *connect.Request[dns_recordv1.ListDNSRecordsByZoneNameRequest]) (*connect.Response[dns_recordv1.ListDNSRecordsByZoneNameResponse], error) {
-	userID, _ := interceptors.GetUserID(ctx)
+	zone, err := authorizeZoneByName(ctx, h.authz, req.Msg.OrganizationId, req.Msg.ZoneName, services.PermissionViewZones)
+	if err != nil {
+		return nil, err
+	}
 	var records []models.DNSRecord
-	if err := h.db.Where("user_id = ? AND zone_name = ?", userID, req.Msg.ZoneName).Find(&records).Error; err != nil {
+	if err := h.db.WithContext(ctx).Where("zone_id = ?", zone.ID).Find(&records).Error; err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	return &connect.Response[dns_recordv1.ListDNSRecordsByZoneNameResponse]{
@@ -110,10 +121,9 @@ func (h *DNSRecordHandler) ListDNSRecordsByZoneName(ctx context.Context, req *co
 }
 
 func (h *DNSRecordHandler) GetDNSRecord(ctx context.Context, req *connect.Request[dns_recordv1.GetDNSRecordRequest]) (*connect.Response[dns_recordv1.GetDNSRecordResponse], error) {
-	userID, _ := interceptors.GetUserID(ctx)
-	var record models.DNSRecord
-	if err := h.db.Where("user_id = ? AND id = ?", userID, req.Msg.Id).First(&record).Error; err != nil {
-		return nil, connect.NewError(connect.CodeNotFound, err)
+	record, err := authorizeRecord(ctx, h.authz, req.Msg.Id, services.PermissionViewZones)
+	if err != nil {
+		return nil, err
 	}
 	return &connect.Response[dns_recordv1.GetDNSRecordResponse]{
 		Msg: &dns_recordv1.GetDNSRecordResponse{Record: record.ToProto()},
@@ -121,22 +131,43 @@ func (h *DNSRecordHandler) GetDNSRecord(ctx context.Context, req *connect.Reques
 }
 
 func (h *DNSRecordHandler) UpdateDNSRecord(ctx context.Context, req *connect.Request[dns_recordv1.UpdateDNSRecordRequest]) (*connect.Response[dns_recordv1.UpdateDNSRecordResponse], error) {
-	userID, _ := interceptors.GetUserID(ctx)
-	var record models.DNSRecord
-	if err := h.db.Where("user_id = ? AND id = ?", userID, req.Msg.Id).First(&record).Error; err != nil {
-		return nil, connect.NewError(connect.CodeNotFound, err)
+	record, err := authorizeRecord(ctx, h.authz, req.Msg.Id, services.PermissionEditRecords)
+	if err != nil {
+		return nil, err
 	}
-	name := strings.ToLower(req.Msg.Name)
-	name = strings.TrimSuffix(name, ".")
+	name := record.Name
+	recordType := record.Type
 	updateMap := map[string]any{}
-	if name != "" {
+	if req.Msg.Name != "" {
+		name = recordName(record.ZoneName, req.Msg.Name)
 		updateMap["name"] = name
 	}
 	if req.Msg.Type != "" {
-		updateMap["type"] = req.Msg.Type
+		recordType = models.NormalizeRecordType(req.Msg.Type)
+		updateMap["type"] = recordType
+	}
+	// 修改前后的名称和类型都需要在 grant 范围内, 否则可以把记录移出或移入 grant 范围
+	if err := authorizeRecordChange(ctx, h.authz, record.ZoneID, record.Name, record.Type); err != nil {
+		return nil, err
 	}
-	if req.Msg.Content != "" {
-		updateMap["content"] = req.Msg.Content
+	if err := authorizeRecordChange(ctx, h.authz, record.ZoneID, name, recordType); err != nil {
+		return nil, err
+	}
+	if err := validateAlias(record.ZoneName, name, recordType, lo.Ternary(req.Msg.Content != "", req.Msg.Content, record.Content)); err != nil {
+		return nil, err
+	}
+	if err := h.checkCNAMEConflict(ctx, record.ZoneID, name, recordType, record.ID); err != nil {
+		return nil, err
+	}
+	contentChanged := req.Msg.Content != "" || req.Msg.Type != "" || req.Msg.Svcb != nil
+	var svcb *models.SVCBData
+	if contentChanged {
+		content, data, err := normalizeContent(recordType, lo.Ternary(req.Msg.Content != "", req.Msg.Content, record.Content), req.Msg.Svcb)
+		if err != nil {
+			return nil, err
+		}
+		updateMap["content"] = content
+		svcb = data
 	}
 	if req.Msg.Ttl != 0 {
 		updateMap["ttl"] = int(req.Msg.Ttl)
@@ -144,11 +175,22 @@ func (h *DNSRecordHandler) UpdateDNSRecord(ctx context.Context, req *connect.Req
 	if req.Msg.Weight != 0 {
 		updateMap["weight"] = int(req.Msg.Weight)
 	}
-	if err := h.db.Model(&record).Updates(updateMap).Error; err != nil {
+	err = h.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(record).Updates(updateMap).Error; err != nil {
+			return err
+		}
+		if !contentChanged {
+			return nil
+		}
+		// map 更新不会经过 json serializer, svcb 需要通过结构体更新
+		record.SVCB = svcb
+		return tx.Model(record).Select("svcb").Updates(record).Error
+	})
+	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	go func() {
-		h.PublishEvent(event.Event{
+		h.PublishEvent(context.WithoutCancel(ctx), event.Event{
 			Type:     event.EventTypeDNSRecordUpdate,
 			ZoneName: record.ZoneName,
 		})
@@ -161,19 +203,129 @@ func (h *DNSRecordHandler) UpdateDNSRecord(ctx context.Context, req *connect.Req
 }
 
 func (h *DNSRecordHandler) DeleteDNSRecord(ctx context.Context, req *connect.Request[dns_recordv1.DeleteDNSRecordRequest]) (*connect.Response[dns_recordv1.DeleteDNSRecordResponse], error) {
-	userID, _ := interceptors.GetUserID(ctx)
-	var record models.DNSRecord
-	if err := h.db.Where("user_id = ? AND id = ?", userID, req.Msg.Id).First(&record).Error; err != nil {
-		return nil, connect.NewError(connect.CodeNotFound, err)
+	record, err := authorizeRecord(ctx, h.authz, req.Msg.Id, services.PermissionEditRecords)
+	if err != nil {
+		return nil, err
 	}
-	if err := h.db.Where("user_id = ? AND id = ?", userID, req.Msg.Id).Delete(&models.DNSRecord{}).Error; err != nil {
+	if err := authorizeRecordChange(ctx, h.authz, record.ZoneID, record.Name, record.Type); err != nil {
+		return nil, err
+	}
+	if err := h.db.WithContext(ctx).Delete(record).Error; err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	go func() {
-		h.PublishEvent(event.Event{
+		h.PublishEvent(context.WithoutCancel(ctx), event.Event{
 			Type:     event.EventTypeDNSRecordDelete,
 			ZoneName: record.ZoneName,
 		})
 	}()
 	return &connect.Response[dns_recordv1.DeleteDNSRecordResponse]{}, nil
 }
+
+// recordName 将 @ 或者 api 这种相对名称转换为完整的 name
+func recordName(zoneName, name string) string {
+	name = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+	if name == "@" || name == "" || name == zoneName {
+		return zoneName
+	}
+	if strings.HasSuffix(name, "."+zoneName) {
+		return name
+	}
+	return name + "." + zoneName
+}
+
+// checkCNAMEConflict CNAME 不能和其他记录共存 (RFC 1034 3.6.2), 多条 CNAME 用于权重负载均衡
+func (h *DNSRecordHandler) checkCNAMEConflict(ctx context.Context, zoneID, name, recordType, excludeID string) error {
+	query := h.db.WithContext(ctx).Model(&models.DNSRecord{}).Where("zone_id = ? AND name = ?", zoneID, name)
+	if excludeID != "" {
+		query = query.Where("id <> ?", excludeID)
+	}
+	var existing []string
+	if err := query.Distinct("type").Pluck("type", &existing).Error; err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return cnameConflict(name, recordType, existing)
+}
+
+// cnameConflict 检查 name 上已有 existing 类型的记录时能否添加 recordType 类型的记录
+func cnameConflict(name, recordType string, existing []string) error {
+	if recordType == models.RecordTypeCNAME {
+		if lo.ContainsBy(existing, func(t string) bool { return t != models.RecordTypeCNAME }) {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s already has other records, a CNAME must be the only record at a name", name))
+		}
+		return nil
+	}
+	if lo.Contains(existing, models.RecordTypeCNAME) {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s already has a CNAME record, which cannot coexist with other records", name))
+	}
+	return nil
+}
+
+// normalizeContent 校验并规范化有结构的记录内容
+//
+// SVCB/HTTPS 记录优先使用结构化的 svcb, 没有时从 content 的文本格式解析
+func normalizeContent(recordType, content string, svcb *dns_recordv1.SVCBData) (string, *models.SVCBData, error) {
+	switch {
+	case recordType == "CAA":
+		normalized, err := records.NormalizeCAA(content)
+		if err != nil {
+			return "", nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return normalized, nil, nil
+	case records.IsSVCB(recordType):
+		var data *models.SVCBData
+		var err error
+		if svcb != nil {
+			data, err = svcbFromProto(svcb)
+		} else {
+			data, err = records.ParseSVCB(recordType, content)
+		}
+		if err != nil {
+			return "", nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		normalized, err := records.NormalizeSVCB(recordType, data)
+		if err != nil {
+			return "", nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return normalized, data, nil
+	default:
+		return content, nil, nil
+	}
+}
+
+func svcbFromProto(svcb *dns_recordv1.SVCBData) (*models.SVCBData, error) {
+	params := svcb.GetParams()
+	if svcb.Priority > math.MaxUint16 {
+		return nil, fmt.Errorf("invalid priority %d", svcb.Priority)
+	}
+	if params.GetPort() > math.MaxUint16 {
+		return nil, fmt.Errorf("invalid port %d", params.GetPort())
+	}
+	return &models.SVCBData{
+		Priority:      uint16(svcb.Priority),
+		Target:        svcb.Target,
+		ALPN:          params.GetAlpn(),
+		NoDefaultALPN: params.GetNoDefaultAlpn(),
+		Port:          uint16(params.GetPort()),
+		IPv4Hint:      params.GetIpv4Hint(),
+		IPv6Hint:      params.GetIpv6Hint(),
+		ECH:           params.GetEch(),
+	}, nil
+}
+
+// validateAlias apex 上不允许 CNAME (RFC 1912), 需要使用会被展平的 ALIAS
+func validateAlias(zoneName, name, recordType, content string) error {
+	switch recordType {
+	case models.RecordTypeCNAME:
+		if name == zoneName {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("CNAME is not allowed at the zone apex, use an ALIAS record instead"))
+		}
+	case models.RecordTypeALIAS:
+	default:
+		return nil
+	}
+	if _, ok := dns.IsDomainName(content); !ok {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid %s target %q", recordType, content))
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+)
+
+func TestCNAMEConflict(t *testing.T) {
+	tests := []struct {
+		name       string
+		recordType string
+		existing   []string
+		conflict   bool
+	}{
+		{"cname on empty name", "CNAME", nil, false},
+		{"second cname for weighting", "CNAME", []string{"CNAME"}, false},
+		{"cname next to address", "CNAME", []string{"A"}, true},
+		{"cname next to txt", "CNAME", []string{"TXT", "MX"}, true},
+		{"cname next to cname and mx", "CNAME", []string{"CNAME", "MX"}, true},
+		{"address on empty name", "A", nil, false},
+		{"address next to other types", "AAAA", []string{"A", "TXT", "MX"}, false},
+		{"address next to cname", "A", []string{"CNAME"}, true},
+		{"mx next to cname", "MX", []string{"CNAME"}, true},
+		{"txt next to cname", "TXT", []string{"CNAME"}, true},
+		{"alias next to cname", "ALIAS", []string{"CNAME"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cnameConflict("www.example.com", tt.recordType, tt.existing)
+			if !tt.conflict {
+				if err != nil {
+					t.Fatalf("unexpected conflict: %v", err)
+				}
+				return
+			}
+			if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+				t.Fatalf("expected failed precondition, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRecordName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"@", "example.com"},
+		{"", "example.com"},
+		{"example.com.", "example.com"},
+		{"API", "api.example.com"},
+		{" www ", "www.example.com"},
+		{"www.example.com", "www.example.com"},
+		{"a.b", "a.b.example.com"},
+	}
+	for _, tt := range tests {
+		if got := recordName("example.com", tt.name); got != tt.want {
+			t.Errorf("recordName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"connectrpc.com/connect"
+	"github.com/samber/lo"
+
+	authv1 "dnsarc/gen/auth/v1"
+	"dnsarc/internal/interceptors"
+	"dnsarc/internal/models"
+	"dnsarc/internal/oauth"
+	"dnsarc/internal/services"
+)
+
+func (h *AuthHandler) ListLoginProviders(ctx context.Context, req *connect.Request[authv1.ListLoginProvidersRequest]) (*connect.Response[authv1.ListLoginProvidersResponse], error) {
+	return connect.NewResponse(&authv1.ListLoginProvidersResponse{
+		Providers: lo.Map(h.providers.List(), func(p oauth.Provider, _ int) *authv1.LoginProvider {
+			return &authv1.LoginProvider{Name: p.Name(), DisplayName: p.DisplayName()}
+		}),
+	}), nil
+}
+
+func (h *AuthHandler) GetLoginURL(ctx context.Context, req *connect.Request[authv1.GetLoginURLRequest]) (*connect.Response[authv1.GetLoginURLResponse], error) {
+	res := connect.NewResponse(&authv1.GetLoginURLResponse{})
+	url, err := h.authCodeURL(ctx, res.Header(), req.Msg.Provider, "")
+	if err != nil {
+		return nil, err
+	}
+	res.Msg.Url = url
+	return res, nil
+}
+
+// GetLinkURL 已登录的用户绑定新的 provider
+func (h *AuthHandler) GetLinkURL(ctx context.Context, req *connect.Request[authv1.GetLinkURLRequest]) (*connect.Response[authv1.GetLinkURLResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	res := connect.NewResponse(&authv1.GetLinkURLResponse{})
+	url, err := h.authCodeURL(ctx, res.Header(), req.Msg.Provider, userID)
+	if err != nil {
+		return nil, err
+	}
+	res.Msg.Url = url
+	return res, nil
+}
+
+func (h *AuthHandler) ListIdentities(ctx context.Context, req *connect.Request[authv1.ListIdentitiesRequest]) (*connect.Response[authv1.ListIdentitiesResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	var identities []models.UserIdentity
+	if err := h.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at").Find(&identities).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&authv1.ListIdentitiesResponse{
+		Identities: lo.Map(identities, func(identity models.UserIdentity, _ int) *authv1.Identity {
+			return identity.ToProto()
+		}),
+	}), nil
+}
+
+// UnlinkIdentity 解除绑定, 不能移除最后一种登录方式
+func (h *AuthHandler) UnlinkIdentity(ctx context.Context, req *connect.Request[authv1.UnlinkIdentityRequest]) (*connect.Response[authv1.UnlinkIdentityResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	var user models.User
+	if err := h.db.WithContext(ctx).Where("id = ?", userID).First(&user).Error; err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	}
+	var identity models.UserIdentity
+	if err := h.db.WithContext(ctx).Where("user_id = ? AND id = ?", userID, req.Msg.Id).First(&identity).Error; err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	var count int64
+	if err := h.db.WithContext(ctx).Model(&models.UserIdentity{}).Where("user_id = ?", userID).Count(&count).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if count <= 1 && user.Password == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("cannot unlink the only sign-in method, set a password first"))
+	}
+	if err := h.db.WithContext(ctx).Delete(&identity).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&authv1.UnlinkIdentityResponse{}), nil
+}
+
+// ExchangeAuthCode 使用登录回调得到的一次性 code 换取 token
+func (h *AuthHandler) ExchangeAuthCode(ctx context.Context, req *connect.Request[authv1.ExchangeAuthCodeRequest]) (*connect.Response[authv1.ExchangeAuthCodeResponse], error) {
+	code, err := h.states.TakeCode(ctx, req.Msg.Code)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, oauth.ErrInvalidCode)
+	}
+	if code.UserID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, oauth.ErrInvalidCode)
+	}
+	var user models.User
+	if err := h.db.WithContext(ctx).Where("id = ?", code.UserID).First(&user).Error; err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	}
+	tokens, challenge, err := h.signIn(ctx, req, &user)
+	if err != nil {
+		return nil, err
+	}
+	if challenge != nil {
+		return connect.NewResponse(&authv1.ExchangeAuthCodeResponse{
+			Mfa: challenge,
+		}), nil
+	}
+	return connect.NewResponse(&authv1.ExchangeAuthCodeResponse{
+		Token:        tokens.AccessToken.Token,
+		RefreshToken: tokens.RefreshToken,
+	}), nil
+}
+
+// CompleteLink 已登录的用户确认绑定回调得到的身份, code 只能由发起绑定的用户使用
+func (h *AuthHandler) CompleteLink(ctx context.Context, req *connect.Request[authv1.CompleteLinkRequest]) (*connect.Response[authv1.CompleteLinkResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	code, err := h.states.TakeCode(ctx, req.Msg.Code)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, oauth.ErrInvalidCode)
+	}
+	if code.Identity == nil || code.LinkUserID != userID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, oauth.ErrInvalidCode)
+	}
+	if err := services.NewIdentityService(h.db).Link(ctx, userID, code.Identity); err != nil {
+		if errors.Is(err, services.ErrIdentityLinked) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	var identity models.UserIdentity
+	if err := h.db.WithContext(ctx).Where("provider = ? AND subject = ?", code.Identity.Provider, code.Identity.Subject).First(&identity).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&authv1.CompleteLinkResponse{
+		Identity: identity.ToProto(),
+	}), nil
+}
+
+// authCodeURL 保存 state 并返回 API 服务的登录地址, 由它检查 state cookie 后跳转到 provider.
+// linkUserID 不为空时回调得到的身份会绑定到该用户
+//
+// state cookie 在这里写入而不是在登录地址写入, 其他人拿到的登录地址在没有这个 cookie 的浏览器中无法使用
+func (h *AuthHandler) authCodeURL(ctx context.Context, header http.Header, provider, linkUserID string) (string, error) {
+	p, ok := h.providers.Get(provider)
+	if !ok {
+		return "", connect.NewError(connect.CodeNotFound, fmt.Errorf("auth provider %q is not enabled", provider))
+	}
+	state, err := h.states.BeginLogin(ctx, p.Name(), linkUserID)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, err)
+	}
+	header.Add("Set-Cookie", oauth.StateCookie(p, state, int(oauth.StateTTL.Seconds())).String())
+	return p.LoginURL() + "?state=" + url.QueryEscape(state), nil
+}
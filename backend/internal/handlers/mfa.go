@@ -0,0 +1,257 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	authv1 "dnsarc/gen/auth/v1"
+	mfav1 "dnsarc/gen/mfa/v1"
+	"dnsarc/internal/interceptors"
+	"dnsarc/internal/models"
+	"dnsarc/internal/services"
+)
+
+type MFAHandler struct {
+	db         *gorm.DB
+	mfaService *services.MFAService
+}
+
+func NewMFAHandler(db *gorm.DB, mfaService *services.MFAService) *MFAHandler {
+	return &MFAHandler{
+		db:         db,
+		mfaService: mfaService,
+	}
+}
+
+func (h *MFAHandler) GetMFAStatus(ctx context.Context, req *connect.Request[mfav1.GetMFAStatusRequest]) (*connect.Response[mfav1.GetMFAStatusResponse], error) {
+	user, err := h.user(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var credentials []models.WebAuthnCredential
+	if err := h.db.WithContext(ctx).Where("user_id = ?", user.ID).Order("created_at").Find(&credentials).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&mfav1.GetMFAStatusResponse{
+		TotpEnabled: user.TOTPEnabled(),
+		WebauthnCredentials: lo.Map(credentials, func(credential models.WebAuthnCredential, _ int) *mfav1.WebAuthnCredential {
+			return credential.ToProto()
+		}),
+		RecoveryCodesRemaining: int32(len(user.RecoveryCodes)),
+		WebauthnAvailable:      h.mfaService.WebAuthnAvailable(),
+	}), nil
+}
+
+func (h *MFAHandler) BeginTOTPEnrollment(ctx context.Context, req *connect.Request[mfav1.BeginTOTPEnrollmentRequest]) (*connect.Response[mfav1.BeginTOTPEnrollmentResponse], error) {
+	user, err := h.user(ctx)
+	if err != nil {
+		return nil, err
+	}
+	secret, otpauthURL, err := h.mfaService.BeginTOTPEnrollment(ctx, user)
+	if err != nil {
+		return nil, mfaError(err)
+	}
+	return connect.NewResponse(&mfav1.BeginTOTPEnrollmentResponse{
+		Secret:     secret,
+		OtpauthUrl: otpauthURL,
+	}), nil
+}
+
+func (h *MFAHandler) ConfirmTOTPEnrollment(ctx context.Context, req *connect.Request[mfav1.ConfirmTOTPEnrollmentRequest]) (*connect.Response[mfav1.ConfirmTOTPEnrollmentResponse], error) {
+	user, err := h.user(ctx)
+	if err != nil {
+		return nil, err
+	}
+	codes, err := h.mfaService.ConfirmTOTPEnrollment(ctx, user, req.Msg.Code)
+	if err != nil {
+		return nil, mfaError(err)
+	}
+	return connect.NewResponse(&mfav1.ConfirmTOTPEnrollmentResponse{
+		RecoveryCodes: codes,
+	}), nil
+}
+
+func (h *MFAHandler) DisableTOTP(ctx context.Context, req *connect.Request[mfav1.DisableTOTPRequest]) (*connect.Response[mfav1.DisableTOTPResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	if err := h.mfaService.DisableTOTP(ctx, userID); err != nil {
+		return nil, mfaError(err)
+	}
+	return connect.NewResponse(&mfav1.DisableTOTPResponse{}), nil
+}
+
+func (h *MFAHandler) BeginWebAuthnRegistration(ctx context.Context, req *connect.Request[mfav1.BeginWebAuthnRegistrationRequest]) (*connect.Response[mfav1.BeginWebAuthnRegistrationResponse], error) {
+	user, err := h.user(ctx)
+	if err != nil {
+		return nil, err
+	}
+	options, err := h.mfaService.BeginWebAuthnRegistration(ctx, user)
+	if err != nil {
+		return nil, mfaError(err)
+	}
+	return connect.NewResponse(&mfav1.BeginWebAuthnRegistrationResponse{
+		OptionsJson: string(options),
+	}), nil
+}
+
+func (h *MFAHandler) FinishWebAuthnRegistration(ctx context.Context, req *connect.Request[mfav1.FinishWebAuthnRegistrationRequest]) (*connect.Response[mfav1.FinishWebAuthnRegistrationResponse], error) {
+	name := strings.TrimSpace(req.Msg.Name)
+	if name == "" || len(name) > 64 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name must be between 1 and 64 characters"))
+	}
+	user, err := h.user(ctx)
+	if err != nil {
+		return nil, err
+	}
+	credential, codes, err := h.mfaService.FinishWebAuthnRegistration(ctx, user, name, req.Msg.ResponseJson)
+	if err != nil {
+		return nil, mfaError(err)
+	}
+	return connect.NewResponse(&mfav1.FinishWebAuthnRegistrationResponse{
+		Credential:    credential.ToProto(),
+		RecoveryCodes: codes,
+	}), nil
+}
+
+func (h *MFAHandler) DeleteWebAuthnCredential(ctx context.Context, req *connect.Request[mfav1.DeleteWebAuthnCredentialRequest]) (*connect.Response[mfav1.DeleteWebAuthnCredentialResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	if err := h.mfaService.DeleteWebAuthnCredential(ctx, userID, req.Msg.Id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("security key not found"))
+		}
+		return nil, mfaError(err)
+	}
+	return connect.NewResponse(&mfav1.DeleteWebAuthnCredentialResponse{}), nil
+}
+
+func (h *MFAHandler) RegenerateRecoveryCodes(ctx context.Context, req *connect.Request[mfav1.RegenerateRecoveryCodesRequest]) (*connect.Response[mfav1.RegenerateRecoveryCodesResponse], error) {
+	user, err := h.user(ctx)
+	if err != nil {
+		return nil, err
+	}
+	codes, err := h.mfaService.RegenerateRecoveryCodes(ctx, user)
+	if err != nil {
+		return nil, mfaError(err)
+	}
+	return connect.NewResponse(&mfav1.RegenerateRecoveryCodesResponse{
+		RecoveryCodes: codes,
+	}), nil
+}
+
+// BeginStepUp 没有安全密钥时 webauthn_options_json 为空, 只能使用验证码或恢复码
+func (h *MFAHandler) BeginStepUp(ctx context.Context, req *connect.Request[mfav1.BeginStepUpRequest]) (*connect.Response[mfav1.BeginStepUpResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	sessionID, _ := interceptors.GetSessionID(ctx)
+	options, err := h.mfaService.BeginStepUp(ctx, userID, sessionID)
+	if err != nil {
+		return nil, mfaError(err)
+	}
+	return connect.NewResponse(&mfav1.BeginStepUpResponse{
+		WebauthnOptionsJson: string(options),
+	}), nil
+}
+
+// StepUp 危险操作前再次验证, 之后的 services.StepUpWindow 内当前会话可以执行危险操作
+func (h *MFAHandler) StepUp(ctx context.Context, req *connect.Request[mfav1.StepUpRequest]) (*connect.Response[mfav1.StepUpResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	sessionID, _ := interceptors.GetSessionID(ctx)
+	if err := h.mfaService.StepUp(ctx, userID, sessionID, &services.MFAFactor{
+		TOTPCode:         req.Msg.TotpCode,
+		RecoveryCode:     req.Msg.RecoveryCode,
+		WebAuthnResponse: req.Msg.WebauthnResponseJson,
+	}); err != nil {
+		return nil, mfaError(err)
+	}
+	return connect.NewResponse(&mfav1.StepUpResponse{}), nil
+}
+
+func (h *MFAHandler) user(ctx context.Context) (*models.User, error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	var user models.User
+	if err := h.db.WithContext(ctx).Where("id = ?", userID).First(&user).Error; err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	}
+	return &user, nil
+}
+
+// BeginMFAWebAuthn 登录时使用安全密钥完成两步验证的 options
+func (h *AuthHandler) BeginMFAWebAuthn(ctx context.Context, req *connect.Request[authv1.BeginMFAWebAuthnRequest]) (*connect.Response[authv1.BeginMFAWebAuthnResponse], error) {
+	options, err := h.mfaService.BeginChallengeWebAuthn(ctx, req.Msg.MfaToken)
+	if err != nil {
+		return nil, mfaError(err)
+	}
+	return connect.NewResponse(&authv1.BeginMFAWebAuthnResponse{
+		OptionsJson: string(options),
+	}), nil
+}
+
+// VerifyMFA 完成登录时的两步验证, 成功后创建会话
+func (h *AuthHandler) VerifyMFA(ctx context.Context, req *connect.Request[authv1.VerifyMFARequest]) (*connect.Response[authv1.VerifyMFAResponse], error) {
+	userID, err := h.mfaService.CompleteChallenge(ctx, req.Msg.MfaToken, &services.MFAFactor{
+		TOTPCode:         req.Msg.TotpCode,
+		RecoveryCode:     req.Msg.RecoveryCode,
+		WebAuthnResponse: req.Msg.WebauthnResponseJson,
+	})
+	if err != nil {
+		return nil, mfaError(err)
+	}
+	userAgent, ip := clientInfo(req)
+	tokens, err := h.sessionService.Create(ctx, userID, userAgent, ip)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := h.mfaService.MarkStepUp(ctx, tokens.Session.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&authv1.VerifyMFAResponse{
+		Token:        tokens.AccessToken.Token,
+		RefreshToken: tokens.RefreshToken,
+	}), nil
+}
+
+// signIn 启用了两步验证的用户先返回 challenge, 通过 VerifyMFA 完成登录; 否则直接创建会话
+func (h *AuthHandler) signIn(ctx context.Context, req connect.AnyRequest, user *models.User) (*services.SessionTokens, *authv1.MFAChallenge, error) {
+	totp, webAuthn, err := h.mfaService.Methods(ctx, user)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if totp || webAuthn {
+		token, err := h.mfaService.BeginChallenge(ctx, user.ID)
+		if err != nil {
+			return nil, nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return nil, &authv1.MFAChallenge{
+			Token:    token,
+			Totp:     totp,
+			Webauthn: webAuthn && h.mfaService.WebAuthnAvailable(),
+		}, nil
+	}
+	userAgent, ip := clientInfo(req)
+	tokens, err := h.sessionService.Create(ctx, user.ID, userAgent, ip)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return tokens, nil, nil
+}
+
+func mfaError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidMFACode), errors.Is(err, services.ErrInvalidWebAuthn):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, services.ErrMFATooManyAttempts):
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	case errors.Is(err, services.ErrStepUpRequired):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	case errors.Is(err, services.ErrMFAChallengeExpired), errors.Is(err, services.ErrMFANotEnabled),
+		errors.Is(err, services.ErrTOTPEnabled), errors.Is(err, services.ErrNoWebAuthnKeys):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, services.ErrWebAuthnUnavailable):
+		return connect.NewError(connect.CodeUnimplemented, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
@@ -0,0 +1,255 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	organizationv1 "dnsarc/gen/organization/v1"
+	"dnsarc/internal/interceptors"
+	dnsarcmail "dnsarc/internal/mail"
+	"dnsarc/internal/models"
+	"dnsarc/internal/services"
+)
+
+// maxOwnedOrganizations 每个用户最多可以创建的组织, 不包括个人组织
+const maxOwnedOrganizations = 20
+
+type OrganizationHandler struct {
+	db            *gorm.DB
+	authz         *services.Authorizer
+	organizations *services.OrganizationService
+	mailer        dnsarcmail.Sender
+	frontendURL   string
+}
+
+func NewOrganizationHandler(db *gorm.DB, authz *services.Authorizer, organizations *services.OrganizationService, mailer dnsarcmail.Sender, frontendURL string) *OrganizationHandler {
+	return &OrganizationHandler{db: db, authz: authz, organizations: organizations, mailer: mailer, frontendURL: frontendURL}
+}
+
+func (h *OrganizationHandler) ListOrganizations(ctx context.Context, req *connect.Request[organizationv1.ListOrganizationsRequest]) (*connect.Response[organizationv1.ListOrganizationsResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	// 新用户第一次进入时创建个人组织
+	if _, err := h.organizations.Personal(ctx, userID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	var members []models.OrganizationMember
+	if err := h.db.WithContext(ctx).Where("user_id = ?", userID).Find(&members).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	roles := lo.SliceToMap(members, func(member models.OrganizationMember) (string, models.OrgRole) {
+		return member.OrganizationID, member.Role
+	})
+	var organizations []models.Organization
+	if err := h.db.WithContext(ctx).Where("id IN ?", lo.Keys(roles)).Order("personal_user_id IS NULL, name").Find(&organizations).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&organizationv1.ListOrganizationsResponse{
+		Organizations: lo.Map(organizations, func(organization models.Organization, _ int) *organizationv1.Organization {
+			return organization.ToProto(roles[organization.ID])
+		}),
+	}), nil
+}
+
+func (h *OrganizationHandler) CreateOrganization(ctx context.Context, req *connect.Request[organizationv1.CreateOrganizationRequest]) (*connect.Response[organizationv1.CreateOrganizationResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	name, err := organizationName(req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	if err := h.db.WithContext(ctx).Model(&models.OrganizationMember{}).
+		Joins("JOIN organizations ON organizations.id = organization_members.organization_id").
+		Where("organization_members.user_id = ? AND organization_members.role = ? AND organizations.personal_user_id IS NULL", userID, models.OrgRoleOwner).
+		Count(&count).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if count >= maxOwnedOrganizations {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("at most %d organizations are allowed", maxOwnedOrganizations))
+	}
+	organization, err := h.organizations.Create(ctx, userID, name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&organizationv1.CreateOrganizationResponse{
+		Organization: organization.ToProto(models.OrgRoleOwner),
+	}), nil
+}
+
+func (h *OrganizationHandler) RenameOrganization(ctx context.Context, req *connect.Request[organizationv1.RenameOrganizationRequest]) (*connect.Response[organizationv1.RenameOrganizationResponse], error) {
+	role, err := authorizeOrganization(ctx, h.authz, req.Msg.Id, services.PermissionManageOrganization)
+	if err != nil {
+		return nil, err
+	}
+	name, err := organizationName(req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	var organization models.Organization
+	if err := h.db.WithContext(ctx).Where("id = ?", req.Msg.Id).First(&organization).Error; err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err := h.db.WithContext(ctx).Model(&organization).Update("name", name).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&organizationv1.RenameOrganizationResponse{
+		Organization: organization.ToProto(role),
+	}), nil
+}
+
+func (h *OrganizationHandler) DeleteOrganization(ctx context.Context, req *connect.Request[organizationv1.DeleteOrganizationRequest]) (*connect.Response[organizationv1.DeleteOrganizationResponse], error) {
+	if _, err := authorizeOrganization(ctx, h.authz, req.Msg.Id, services.PermissionManageOrganization); err != nil {
+		return nil, err
+	}
+	if err := h.organizations.Delete(ctx, req.Msg.Id); err != nil {
+		return nil, authzError(err)
+	}
+	return connect.NewResponse(&organizationv1.DeleteOrganizationResponse{}), nil
+}
+
+func (h *OrganizationHandler) ListMembers(ctx context.Context, req *connect.Request[organizationv1.ListMembersRequest]) (*connect.Response[organizationv1.ListMembersResponse], error) {
+	if _, err := authorizeOrganization(ctx, h.authz, req.Msg.OrganizationId, services.PermissionViewZones); err != nil {
+		return nil, err
+	}
+	var members []models.OrganizationMember
+	if err := h.db.WithContext(ctx).Preload("User").Where("organization_id = ?", req.Msg.OrganizationId).Order("created_at").Find(&members).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&organizationv1.ListMembersResponse{
+		Members: lo.Map(members, func(member models.OrganizationMember, _ int) *organizationv1.Member {
+			return member.ToProto()
+		}),
+	}), nil
+}
+
+func (h *OrganizationHandler) UpdateMemberRole(ctx context.Context, req *connect.Request[organizationv1.UpdateMemberRoleRequest]) (*connect.Response[organizationv1.UpdateMemberRoleResponse], error) {
+	role := models.OrgRoleFromProto(req.Msg.Role)
+	if role == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("role is required"))
+	}
+	actorRole, err := authorizeOrganization(ctx, h.authz, req.Msg.OrganizationId, services.PermissionManageMembers)
+	if err != nil {
+		return nil, err
+	}
+	member, err := h.organizations.UpdateRole(ctx, req.Msg.OrganizationId, actorRole, req.Msg.UserId, role)
+	if err != nil {
+		return nil, authzError(err)
+	}
+	return connect.NewResponse(&organizationv1.UpdateMemberRoleResponse{
+		Member: member.ToProto(),
+	}), nil
+}
+
+func (h *OrganizationHandler) RemoveMember(ctx context.Context, req *connect.Request[organizationv1.RemoveMemberRequest]) (*connect.Response[organizationv1.RemoveMemberResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	// 所有成员都可以自己退出, 移除其他成员需要管理成员的权限
+	permission := services.PermissionManageMembers
+	if req.Msg.UserId == userID {
+		permission = services.PermissionViewZones
+	}
+	actorRole, err := authorizeOrganization(ctx, h.authz, req.Msg.OrganizationId, permission)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.organizations.RemoveMember(ctx, req.Msg.OrganizationId, actorRole, req.Msg.UserId); err != nil {
+		return nil, authzError(err)
+	}
+	return connect.NewResponse(&organizationv1.RemoveMemberResponse{}), nil
+}
+
+func (h *OrganizationHandler) InviteMember(ctx context.Context, req *connect.Request[organizationv1.InviteMemberRequest]) (*connect.Response[organizationv1.InviteMemberResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	email, err := normalizeEmail(req.Msg.Email)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	role := models.OrgRoleFromProto(req.Msg.Role)
+	if role == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("role is required"))
+	}
+	actorRole, err := authorizeOrganization(ctx, h.authz, req.Msg.OrganizationId, services.PermissionManageMembers)
+	if err != nil {
+		return nil, err
+	}
+	var organization models.Organization
+	if err := h.db.WithContext(ctx).Where("id = ?", req.Msg.OrganizationId).First(&organization).Error; err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	var user models.User
+	if err := h.db.WithContext(ctx).Where("id = ?", userID).First(&user).Error; err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	invitation, token, err := h.organizations.Invite(ctx, organization.ID, actorRole, user.Email, email, role)
+	if err != nil {
+		return nil, authzError(err)
+	}
+	sendMail(ctx, h.mailer, dnsarcmail.Message{
+		To:      email,
+		Subject: fmt.Sprintf("You have been invited to %s on DNSARC", organization.Name),
+		Body: fmt.Sprintf("%s invited you to join the %s organization on DNSARC as %s.\n\n"+
+			"Sign in with this email address and open the link below to accept:\n\n%s\n\n"+
+			"The invitation expires in 7 days. If you did not expect it, you can ignore this email.\n",
+			user.Email, organization.Name, role, frontendLink(h.frontendURL, "/invite", token)),
+	})
+	return connect.NewResponse(&organizationv1.InviteMemberResponse{
+		Invitation: invitation.ToProto(),
+	}), nil
+}
+
+func (h *OrganizationHandler) ListInvitations(ctx context.Context, req *connect.Request[organizationv1.ListInvitationsRequest]) (*connect.Response[organizationv1.ListInvitationsResponse], error) {
+	if _, err := authorizeOrganization(ctx, h.authz, req.Msg.OrganizationId, services.PermissionManageMembers); err != nil {
+		return nil, err
+	}
+	var invitations []models.OrganizationInvitation
+	if err := h.db.WithContext(ctx).Where("organization_id = ? AND accepted_at IS NULL AND expires_at > ?", req.Msg.OrganizationId, time.Now()).Order("created_at DESC").Find(&invitations).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&organizationv1.ListInvitationsResponse{
+		Invitations: lo.Map(invitations, func(invitation models.OrganizationInvitation, _ int) *organizationv1.Invitation {
+			return invitation.ToProto()
+		}),
+	}), nil
+}
+
+func (h *OrganizationHandler) RevokeInvitation(ctx context.Context, req *connect.Request[organizationv1.RevokeInvitationRequest]) (*connect.Response[organizationv1.RevokeInvitationResponse], error) {
+	if _, err := authorizeOrganization(ctx, h.authz, req.Msg.OrganizationId, services.PermissionManageMembers); err != nil {
+		return nil, err
+	}
+	result := h.db.WithContext(ctx).Where("organization_id = ? AND id = ? AND accepted_at IS NULL", req.Msg.OrganizationId, req.Msg.Id).Delete(&models.OrganizationInvitation{})
+	if result.Error != nil {
+		return nil, connect.NewError(connect.CodeInternal, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("invitation not found"))
+	}
+	return connect.NewResponse(&organizationv1.RevokeInvitationResponse{}), nil
+}
+
+func (h *OrganizationHandler) AcceptInvitation(ctx context.Context, req *connect.Request[organizationv1.AcceptInvitationRequest]) (*connect.Response[organizationv1.AcceptInvitationResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	var user models.User
+	if err := h.db.WithContext(ctx).Where("id = ?", userID).First(&user).Error; err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	organization, role, err := h.organizations.AcceptInvitation(ctx, &user, req.Msg.Token)
+	if err != nil {
+		return nil, authzError(err)
+	}
+	return connect.NewResponse(&organizationv1.AcceptInvitationResponse{
+		Organization: organization.ToProto(role),
+	}), nil
+}
+
+func organizationName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return "", connect.NewError(connect.CodeInvalidArgument, errors.New("name must be between 1 and 100 characters"))
+	}
+	return name, nil
+}
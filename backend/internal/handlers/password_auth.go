@@ -0,0 +1,298 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"gorm.io/gorm"
+
+	authv1 "dnsarc/gen/auth/v1"
+	dnsarcmail "dnsarc/internal/mail"
+	"dnsarc/internal/models"
+	"dnsarc/internal/services"
+)
+
+var errInvalidCredentials = errors.New("invalid email or password")
+
+// dummyPasswordHash 用户不存在时也计算一次 hash, 避免通过响应时间判断邮箱是否注册
+var dummyPasswordHash = sync.OnceValue(func() string {
+	hash, err := services.HashPassword(context.Background(), "dnsarc-dummy-password")
+	if err != nil {
+		panic(err)
+	}
+	return hash
+})
+
+func (h *AuthHandler) Register(ctx context.Context, req *connect.Request[authv1.RegisterRequest]) (*connect.Response[authv1.RegisterResponse], error) {
+	email, err := normalizeEmail(req.Msg.Email)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := services.ValidatePassword(req.Msg.Password); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := h.allowPasswordRequest(ctx, requestIP(req)); err != nil {
+		return nil, err
+	}
+	hash, err := services.HashPassword(ctx, req.Msg.Password)
+	if err != nil {
+		return nil, passwordHashError(err)
+	}
+	var user models.User
+	if err := h.db.WithContext(ctx).Where("email = ?", email).First(&user).Error; err == nil {
+		// 邮箱已经注册时返回相同的结果, 通过邮件告知账号已经存在
+		h.sendMail(ctx, dnsarcmail.Message{
+			To:      email,
+			Subject: "Your DNSARC account already exists",
+			Body: fmt.Sprintf("Someone tried to create a DNSARC account with this email address, but an account already exists.\n\n"+
+				"If this was you, sign in at %s/auth or reset your password there.\n"+
+				"If not, you can ignore this email.\n", h.frontendURL),
+		})
+		return connect.NewResponse(&authv1.RegisterResponse{}), nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	user = models.User{
+		Email:    email,
+		Password: hash,
+	}
+	if err := h.db.WithContext(ctx).Create(&user).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := h.sendVerificationEmail(ctx, &user); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&authv1.RegisterResponse{}), nil
+}
+
+func (h *AuthHandler) Login(ctx context.Context, req *connect.Request[authv1.LoginRequest]) (*connect.Response[authv1.LoginResponse], error) {
+	email, err := normalizeEmail(req.Msg.Email)
+	if err != nil || len(req.Msg.Password) > services.MaxPasswordLength {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errInvalidCredentials)
+	}
+	if err := h.allowPasswordRequest(ctx, requestIP(req)); err != nil {
+		return nil, err
+	}
+	var user models.User
+	if err := h.db.WithContext(ctx).Where("email = ?", email).First(&user).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		// 未注册的邮箱和已注册的账号使用相同的锁定规则和响应
+		locked, err := h.unknownEmailLocked(ctx, email)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if locked {
+			return nil, connect.NewError(connect.CodeResourceExhausted, errTooManyLoginAttempts)
+		}
+		if _, err := services.VerifyPassword(ctx, req.Msg.Password, dummyPasswordHash()); err != nil {
+			return nil, passwordHashError(err)
+		}
+		if err := h.recordUnknownEmailFailure(ctx, email); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return nil, connect.NewError(connect.CodeUnauthenticated, errInvalidCredentials)
+	}
+	now := time.Now()
+	if user.Locked(now) {
+		return nil, connect.NewError(connect.CodeResourceExhausted, errTooManyLoginAttempts)
+	}
+	// 只使用 Google 登录的用户没有密码, 可以通过重置密码设置
+	ok := false
+	if user.Password == "" {
+		if _, err := services.VerifyPassword(ctx, req.Msg.Password, dummyPasswordHash()); err != nil {
+			return nil, passwordHashError(err)
+		}
+	} else if ok, err = services.VerifyPassword(ctx, req.Msg.Password, user.Password); err != nil {
+		return nil, passwordHashError(err)
+	}
+	if !ok {
+		if err := h.recordFailedLogin(ctx, &user, now); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return nil, connect.NewError(connect.CodeUnauthenticated, errInvalidCredentials)
+	}
+	if user.FailedLogins > 0 || user.LockedUntil != nil {
+		if err := h.db.WithContext(ctx).Model(&user).Updates(map[string]any{"failed_logins": 0, "locked_until": nil}).Error; err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	if !user.EmailVerified {
+		if err := h.sendVerificationEmail(ctx, &user); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("email is not verified, check your inbox for the verification email"))
+	}
+	tokens, challenge, err := h.signIn(ctx, req, &user)
+	if err != nil {
+		return nil, err
+	}
+	if challenge != nil {
+		return connect.NewResponse(&authv1.LoginResponse{
+			Mfa: challenge,
+		}), nil
+	}
+	return connect.NewResponse(&authv1.LoginResponse{
+		Token:        tokens.AccessToken.Token,
+		RefreshToken: tokens.RefreshToken,
+	}), nil
+}
+
+func (h *AuthHandler) VerifyEmail(ctx context.Context, req *connect.Request[authv1.VerifyEmailRequest]) (*connect.Response[authv1.VerifyEmailResponse], error) {
+	userToken, err := h.userTokenService.Consume(ctx, req.Msg.Token, models.UserTokenPurposeVerifyEmail)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidUserToken) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := h.db.WithContext(ctx).Model(&models.User{}).Where("id = ?", userToken.UserID).Update("email_verified", true).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&authv1.VerifyEmailResponse{}), nil
+}
+
+func (h *AuthHandler) ForgotPassword(ctx context.Context, req *connect.Request[authv1.ForgotPasswordRequest]) (*connect.Response[authv1.ForgotPasswordResponse], error) {
+	email, err := normalizeEmail(req.Msg.Email)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := h.allowPasswordRequest(ctx, requestIP(req)); err != nil {
+		return nil, err
+	}
+	// 邮箱没有注册或者还在冷却时间内时也返回成功, 避免泄露注册的邮箱
+	if !h.allowMail(ctx, models.UserTokenPurposeResetPassword, email) {
+		return connect.NewResponse(&authv1.ForgotPasswordResponse{}), nil
+	}
+	var user models.User
+	if err := h.db.WithContext(ctx).Where("email = ?", email).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return connect.NewResponse(&authv1.ForgotPasswordResponse{}), nil
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	token, err := h.userTokenService.Issue(ctx, user.ID, models.UserTokenPurposeResetPassword, resetPasswordTokenTTL)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	h.sendMail(ctx, dnsarcmail.Message{
+		To:      user.Email,
+		Subject: "Reset your DNSARC password",
+		Body: fmt.Sprintf("Open the link below to set a new password. The link expires in %s and can only be used once.\n\n%s\n\n"+
+			"If you did not request a password reset, you can ignore this email.\n",
+			resetPasswordTokenTTL, h.frontendLink("/auth/reset-password", token)),
+	})
+	return connect.NewResponse(&authv1.ForgotPasswordResponse{}), nil
+}
+
+func (h *AuthHandler) ResetPassword(ctx context.Context, req *connect.Request[authv1.ResetPasswordRequest]) (*connect.Response[authv1.ResetPasswordResponse], error) {
+	// 先检查密码, 避免 token 因为密码不符合要求被用掉
+	if err := services.ValidatePassword(req.Msg.Password); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := h.allowPasswordRequest(ctx, requestIP(req)); err != nil {
+		return nil, err
+	}
+	hash, err := services.HashPassword(ctx, req.Msg.Password)
+	if err != nil {
+		return nil, passwordHashError(err)
+	}
+	userToken, err := h.userTokenService.Consume(ctx, req.Msg.Token, models.UserTokenPurposeResetPassword)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidUserToken) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// 能收到重置邮件说明邮箱属于用户, 同时解除锁定
+	if err := h.db.WithContext(ctx).Model(&models.User{}).Where("id = ?", userToken.UserID).Updates(map[string]any{
+		"password":       hash,
+		"email_verified": true,
+		"failed_logins":  0,
+		"locked_until":   nil,
+	}).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// 密码可能已经泄露, 退出所有设备
+	if err := h.sessionService.RevokeAll(ctx, userToken.UserID, ""); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&authv1.ResetPasswordResponse{}), nil
+}
+
+// recordFailedLogin 记录一次登录失败, 达到上限后锁定账号并重新计数
+func (h *AuthHandler) recordFailedLogin(ctx context.Context, user *models.User, now time.Time) error {
+	updates := map[string]any{"failed_logins": gorm.Expr("failed_logins + 1")}
+	if user.FailedLogins+1 >= maxFailedLogins {
+		slog.Warn("account locked after failed logins", "user_id", user.ID)
+		updates = map[string]any{"failed_logins": 0, "locked_until": now.Add(lockoutDuration)}
+	}
+	return h.db.WithContext(ctx).Model(user).Updates(updates).Error
+}
+
+// passwordHashError 等待 hash 计算时请求被取消或超时返回 Unavailable
+func passwordHashError(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return connect.NewError(connect.CodeUnavailable, errors.New("server is busy, try again later"))
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+// sendVerificationEmail 登录时会重新发送验证邮件, 冷却时间内不重复发送
+func (h *AuthHandler) sendVerificationEmail(ctx context.Context, user *models.User) error {
+	if !h.allowMail(ctx, models.UserTokenPurposeVerifyEmail, user.Email) {
+		return nil
+	}
+	token, err := h.userTokenService.Issue(ctx, user.ID, models.UserTokenPurposeVerifyEmail, verifyEmailTokenTTL)
+	if err != nil {
+		return err
+	}
+	h.sendMail(ctx, dnsarcmail.Message{
+		To:      user.Email,
+		Subject: "Verify your DNSARC email address",
+		Body: fmt.Sprintf("Open the link below to verify your email address. The link expires in %s.\n\n%s\n",
+			verifyEmailTokenTTL, h.frontendLink("/auth/verify-email", token)),
+	})
+	return nil
+}
+
+func (h *AuthHandler) sendMail(ctx context.Context, msg dnsarcmail.Message) {
+	sendMail(ctx, h.mailer, msg)
+}
+
+func (h *AuthHandler) frontendLink(path, token string) string {
+	return frontendLink(h.frontendURL, path, token)
+}
+
+// sendMail 在后台发送, 不阻塞请求, 也避免通过响应时间判断邮箱是否注册
+func sendMail(ctx context.Context, mailer dnsarcmail.Sender, msg dnsarcmail.Message) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second*30)
+		defer cancel()
+		if err := mailer.Send(ctx, msg); err != nil {
+			slog.Error("failed to send mail", "subject", msg.Subject, "error", err)
+		}
+	}()
+}
+
+func frontendLink(frontendURL, path, token string) string {
+	return fmt.Sprintf("%s%s?token=%s", frontendURL, path, url.QueryEscape(token))
+}
+
+func normalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email || len(email) > 254 {
+		return "", errors.New("invalid email address")
+	}
+	return email, nil
+}
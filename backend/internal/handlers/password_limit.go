@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/redis/go-redis/v9"
+	"github.com/samber/lo"
+
+	"dnsarc/internal/models"
+)
+
+// 每个客户端地址每分钟的密码请求数 (注册, 登录, 找回和重置密码), 这些请求会计算 argon2id 或者发送邮件
+const passwordRequestsPerMinute = 10
+
+// 同一个邮箱两次发送验证或重置邮件的最小间隔
+const mailCooldown = time.Minute
+
+var errTooManyLoginAttempts = errors.New("too many failed login attempts, try again later")
+
+// allowPasswordRequest 按客户端地址做每分钟的计数, 多个实例通过 Redis 共享, Redis 不可用时不限制
+func (h *AuthHandler) allowPasswordRequest(ctx context.Context, ip netip.Addr) error {
+	if !ip.IsValid() {
+		return nil
+	}
+	network, err := ip.Prefix(lo.Ternary(ip.Is4(), 32, 64))
+	if err != nil {
+		return nil
+	}
+	key := fmt.Sprintf("auth:rl:%s:%d", network.String(), time.Now().Unix()/60)
+	count, err := h.rdb.Incr(ctx, key).Result()
+	if err != nil {
+		slog.Warn("failed to check password rate limit", "error", err)
+		return nil
+	}
+	if count == 1 {
+		if err := h.rdb.Expire(ctx, key, time.Minute*2).Err(); err != nil {
+			slog.Warn("failed to expire password rate limit", "error", err)
+		}
+	}
+	if count > passwordRequestsPerMinute {
+		return connect.NewError(connect.CodeResourceExhausted, errors.New("too many requests, try again later"))
+	}
+	return nil
+}
+
+// unknownEmailLocked 未注册的邮箱也按照相同的规则锁定, 避免通过锁定的响应判断邮箱是否注册
+func (h *AuthHandler) unknownEmailLocked(ctx context.Context, email string) (bool, error) {
+	n, err := h.rdb.Get(ctx, unknownEmailKey(email)).Int()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return false, err
+	}
+	return n >= maxFailedLogins, nil
+}
+
+// recordUnknownEmailFailure 和 recordFailedLogin 一样计数, 达到上限后锁定 lockoutDuration
+func (h *AuthHandler) recordUnknownEmailFailure(ctx context.Context, email string) error {
+	key := unknownEmailKey(email)
+	n, err := h.rdb.Incr(ctx, key).Result()
+	if err != nil {
+		return err
+	}
+	switch {
+	case n == maxFailedLogins:
+		return h.rdb.Expire(ctx, key, lockoutDuration).Err()
+	case n < maxFailedLogins:
+		// 已注册账号的失败次数在登录成功前不会清零, 这里保留一天
+		return h.rdb.Expire(ctx, key, time.Hour*24).Err()
+	}
+	return nil
+}
+
+// allowMail 同一个邮箱每种邮件在 mailCooldown 内只发送一次, 避免被用来向别人的邮箱刷邮件, Redis 不可用时不限制
+func (h *AuthHandler) allowMail(ctx context.Context, purpose models.UserTokenPurpose, email string) bool {
+	ok, err := h.rdb.SetNX(ctx, "auth:mail:"+string(purpose)+":"+emailHash(email), 1, mailCooldown).Result()
+	if err != nil {
+		slog.Warn("failed to check mail cooldown", "error", err)
+		return true
+	}
+	return ok
+}
+
+// unknownEmailKey Redis 中不保存邮箱原文
+func unknownEmailKey(email string) string {
+	return "auth:unknown:" + emailHash(email)
+}
+
+func emailHash(email string) string {
+	sum := sha256.Sum256([]byte(email))
+	return hex.EncodeToString(sum[:])
+}
+
+// requestIP 限流使用的客户端地址
+func requestIP(req connect.AnyRequest) netip.Addr {
+	return forwardedIP(req.Peer().Addr, req.Header())
+}
+
+// forwardedIP API 部署在反向代理后面, 对端是内网地址时使用代理追加在 X-Forwarded-For 末尾的地址, 客户端自己填写的值只会在前面
+func forwardedIP(peerAddr string, header http.Header) netip.Addr {
+	addrPort, err := netip.ParseAddrPort(peerAddr)
+	if err != nil {
+		return netip.Addr{}
+	}
+	peer := addrPort.Addr().Unmap()
+	if !peer.IsPrivate() && !peer.IsLoopback() {
+		return peer
+	}
+	forwarded := header.Values("X-Forwarded-For")
+	if len(forwarded) == 0 {
+		return peer
+	}
+	last := forwarded[len(forwarded)-1]
+	if i := strings.LastIndex(last, ","); i >= 0 {
+		last = last[i+1:]
+	}
+	if ip, err := netip.ParseAddr(strings.TrimSpace(last)); err == nil {
+		return ip.Unmap()
+	}
+	return peer
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/netip"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+
+	"dnsarc/internal/models"
+)
+
+func newTestAuthHandler(t *testing.T) *AuthHandler {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() {
+		_ = rdb.Close()
+	})
+	return &AuthHandler{rdb: rdb}
+}
+
+func TestAllowPasswordRequest(t *testing.T) {
+	h := newTestAuthHandler(t)
+	ctx := context.Background()
+	ip := netip.MustParseAddr("203.0.113.7")
+	for i := range passwordRequestsPerMinute {
+		if err := h.allowPasswordRequest(ctx, ip); err != nil {
+			t.Fatalf("request %d limited: %v", i, err)
+		}
+	}
+	if err := h.allowPasswordRequest(ctx, ip); connect.CodeOf(err) != connect.CodeResourceExhausted {
+		t.Fatalf("err = %v, want resource exhausted", err)
+	}
+	if err := h.allowPasswordRequest(ctx, netip.MustParseAddr("203.0.113.8")); err != nil {
+		t.Errorf("other address limited: %v", err)
+	}
+	// IPv6 按 /64 计数
+	for range passwordRequestsPerMinute {
+		_ = h.allowPasswordRequest(ctx, netip.MustParseAddr("2001:db8::1"))
+	}
+	if err := h.allowPasswordRequest(ctx, netip.MustParseAddr("2001:db8::ffff")); err == nil {
+		t.Error("address in the same /64 not limited")
+	}
+	// 没有地址时不限制
+	for range passwordRequestsPerMinute + 1 {
+		if err := h.allowPasswordRequest(ctx, netip.Addr{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestUnknownEmailLockout(t *testing.T) {
+	h := newTestAuthHandler(t)
+	ctx := context.Background()
+	for i := range maxFailedLogins {
+		locked, err := h.unknownEmailLocked(ctx, "nobody@example.com")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if locked {
+			t.Fatalf("locked after %d failures", i)
+		}
+		if err := h.recordUnknownEmailFailure(ctx, "nobody@example.com"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if locked, _ := h.unknownEmailLocked(ctx, "nobody@example.com"); !locked {
+		t.Errorf("not locked after %d failures", maxFailedLogins)
+	}
+	if ttl := h.rdb.TTL(ctx, unknownEmailKey("nobody@example.com")).Val(); ttl != lockoutDuration {
+		t.Errorf("lockout ttl = %s, want %s", ttl, lockoutDuration)
+	}
+	if locked, _ := h.unknownEmailLocked(ctx, "other@example.com"); locked {
+		t.Error("other email locked")
+	}
+}
+
+func TestAllowMail(t *testing.T) {
+	h := newTestAuthHandler(t)
+	ctx := context.Background()
+	if !h.allowMail(ctx, models.UserTokenPurposeResetPassword, "user@example.com") {
+		t.Fatal("first mail not allowed")
+	}
+	if h.allowMail(ctx, models.UserTokenPurposeResetPassword, "user@example.com") {
+		t.Error("second mail allowed within the cooldown")
+	}
+	// 冷却按邮箱和用途分开计算
+	if !h.allowMail(ctx, models.UserTokenPurposeVerifyEmail, "user@example.com") {
+		t.Error("verification mail blocked by the reset cooldown")
+	}
+	if !h.allowMail(ctx, models.UserTokenPurposeResetPassword, "other@example.com") {
+		t.Error("other email blocked")
+	}
+	key := "auth:mail:reset_password:" + emailHash("user@example.com")
+	if ttl := h.rdb.TTL(ctx, key).Val(); ttl != mailCooldown {
+		t.Errorf("cooldown ttl = %s, want %s", ttl, mailCooldown)
+	}
+}
+
+func TestForwardedIP(t *testing.T) {
+	tests := []struct {
+		name      string
+		peer      string
+		forwarded []string
+		want      string
+	}{
+		{"public peer ignores header", "198.51.100.1:4000", []string{"203.0.113.7"}, "198.51.100.1"},
+		{"proxy peer uses last entry", "10.0.0.5:4000", []string{"1.2.3.4, 203.0.113.7"}, "203.0.113.7"},
+		{"last header wins", "10.0.0.5:4000", []string{"1.2.3.4", "203.0.113.7"}, "203.0.113.7"},
+		{"proxy peer without header", "127.0.0.1:4000", nil, "127.0.0.1"},
+		{"invalid header", "10.0.0.5:4000", []string{"unknown"}, "10.0.0.5"},
+		{"ipv6 peer", "[2001:db8::1]:4000", nil, "2001:db8::1"},
+		{"mapped ipv4", "[::ffff:198.51.100.1]:4000", nil, "198.51.100.1"},
+		{"no peer", "", nil, "invalid IP"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for _, value := range tt.forwarded {
+				header.Add("X-Forwarded-For", value)
+			}
+			if got := forwardedIP(tt.peer, header); got.String() != tt.want {
+				t.Errorf("forwardedIP() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
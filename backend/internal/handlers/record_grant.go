@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/miekg/dns"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	grantv1 "dnsarc/gen/grant/v1"
+	"dnsarc/internal/interceptors"
+	"dnsarc/internal/models"
+	"dnsarc/internal/services"
+)
+
+const maxRecordGrantsPerZone = 200
+
+// RecordGrantHandler 管理 zone 中用户和 API token 的 grant
+//
+// admin 可以管理 zone 中所有成员和 token 的 grant, editor 只能限制自己的 API token
+type RecordGrantHandler struct {
+	db    *gorm.DB
+	authz *services.Authorizer
+}
+
+func NewRecordGrantHandler(db *gorm.DB, authz *services.Authorizer) *RecordGrantHandler {
+	return &RecordGrantHandler{db: db, authz: authz}
+}
+
+func (h *RecordGrantHandler) CreateRecordGrant(ctx context.Context, req *connect.Request[grantv1.CreateRecordGrantRequest]) (*connect.Response[grantv1.CreateRecordGrantResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	principalType := models.PrincipalTypeFromProto(req.Msg.PrincipalType)
+	if principalType == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("principal_type is required"))
+	}
+	zone, err := h.authorizeGrant(ctx, req.Msg.ZoneId, principalType, req.Msg.PrincipalId)
+	if err != nil {
+		return nil, err
+	}
+	namePattern, err := grantNamePattern(zone.ZoneName, req.Msg.NamePattern)
+	if err != nil {
+		return nil, err
+	}
+	types, err := grantTypes(req.Msg.Types)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	if err := h.db.WithContext(ctx).Model(&models.RecordGrant{}).Where("zone_id = ?", zone.ID).Count(&count).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if count >= maxRecordGrantsPerZone {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("at most %d grants are allowed per zone", maxRecordGrantsPerZone))
+	}
+	grant := models.RecordGrant{
+		ZoneID:        zone.ID,
+		PrincipalType: principalType,
+		PrincipalID:   req.Msg.PrincipalId,
+		NamePattern:   namePattern,
+		Types:         types,
+		CreatedBy:     userID,
+	}
+	if err := h.db.WithContext(ctx).Create(&grant).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&grantv1.CreateRecordGrantResponse{Grant: grant.ToProto()}), nil
+}
+
+func (h *RecordGrantHandler) ListRecordGrants(ctx context.Context, req *connect.Request[grantv1.ListRecordGrantsRequest]) (*connect.Response[grantv1.ListRecordGrantsResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	zone, err := authorizeZone(ctx, h.authz, req.Msg.ZoneId, services.PermissionViewZones)
+	if err != nil {
+		return nil, err
+	}
+	role, err := h.authz.Role(ctx, userID, zone.OrganizationID)
+	if err != nil {
+		return nil, authzError(err)
+	}
+	query := h.db.WithContext(ctx).Where("zone_id = ?", zone.ID)
+	// admin 以下只能看到自己和自己 API token 的 grant
+	if !role.AtLeast(models.OrgRoleAdmin) {
+		apiTokens := h.db.Model(&models.APIToken{}).Select("id").Where("user_id = ?", userID)
+		query = query.Where(h.db.Where("principal_type = ? AND principal_id = ?", models.PrincipalTypeUser, userID).
+			Or("principal_type = ? AND principal_id IN (?)", models.PrincipalTypeAPIToken, apiTokens))
+	}
+	var grants []models.RecordGrant
+	if err := query.Order("created_at").Find(&grants).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&grantv1.ListRecordGrantsResponse{
+		Grants: lo.Map(grants, func(grant models.RecordGrant, _ int) *grantv1.RecordGrant {
+			return grant.ToProto()
+		}),
+	}), nil
+}
+
+func (h *RecordGrantHandler) DeleteRecordGrant(ctx context.Context, req *connect.Request[grantv1.DeleteRecordGrantRequest]) (*connect.Response[grantv1.DeleteRecordGrantResponse], error) {
+	var grant models.RecordGrant
+	if err := h.db.WithContext(ctx).Where("id = ?", req.Msg.Id).First(&grant).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("grant not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if _, err := h.authorizeGrant(ctx, grant.ZoneID, grant.PrincipalType, grant.PrincipalID); err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("grant not found"))
+		}
+		return nil, err
+	}
+	if err := h.db.WithContext(ctx).Delete(&grant).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&grantv1.DeleteRecordGrantResponse{}), nil
+}
+
+// authorizeGrant 检查当前用户是否可以管理 principal 在 zone 中的 grant
+//
+// 限制自己的 API token 只需要可以修改记录, 其他情况需要可以管理 zone, 对象必须是 zone 所属组织的成员或成员的 token
+func (h *RecordGrantHandler) authorizeGrant(ctx context.Context, zoneID string, principalType models.PrincipalType, principalID string) (*models.Zone, error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	memberID := principalID
+	if principalType == models.PrincipalTypeAPIToken {
+		var apiToken models.APIToken
+		if err := h.db.WithContext(ctx).Where("id = ?", principalID).First(&apiToken).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, connect.NewError(connect.CodeNotFound, errors.New("api token not found"))
+			}
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if apiToken.UserID == userID {
+			return authorizeZone(ctx, h.authz, zoneID, services.PermissionEditRecords)
+		}
+		memberID = apiToken.UserID
+	}
+	zone, err := authorizeZone(ctx, h.authz, zoneID, services.PermissionManageZones)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.authz.Role(ctx, memberID, zone.OrganizationID); err != nil {
+		if errors.Is(err, services.ErrOrganizationNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, services.ErrMemberNotFound)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return zone, nil
+}
+
+// grantNamePattern 将相对名称转换为完整的 name, *.dev 表示 dev 下任意层级的名称
+func grantNamePattern(zoneName, pattern string) (string, error) {
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" {
+		return "", connect.NewError(connect.CodeInvalidArgument, errors.New("name_pattern is required"))
+	}
+	wildcard := pattern == "*" || strings.HasPrefix(pattern, "*.")
+	if wildcard {
+		pattern = strings.TrimPrefix(strings.TrimPrefix(pattern, "*"), ".")
+	}
+	name := recordName(zoneName, pattern)
+	if strings.Contains(name, "*") {
+		return "", connect.NewError(connect.CodeInvalidArgument, errors.New("a wildcard is only allowed as the first label of the name pattern"))
+	}
+	if _, ok := dns.IsDomainName(name); !ok {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid name pattern %q", name))
+	}
+	if wildcard {
+		return "*." + name, nil
+	}
+	return name, nil
+}
+
+// grantTypes 规范化记录类型, 为空时不限制
+func grantTypes(types []string) ([]string, error) {
+	normalized := lo.Uniq(lo.Map(types, func(t string, _ int) string {
+		return models.NormalizeRecordType(t)
+	}))
+	for _, t := range normalized {
+		if _, ok := dns.StringToType[t]; !ok && t != models.RecordTypeALIAS {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown record type %q", t))
+		}
+	}
+	slices.Sort(normalized)
+	return normalized, nil
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	authv1 "dnsarc/gen/auth/v1"
+	"dnsarc/internal/interceptors"
+	"dnsarc/internal/models"
+	"dnsarc/internal/services"
+)
+
+// RefreshToken 使用 refresh token 换取新的 access token, 返回的 refresh token 替换旧的
+func (h *AuthHandler) RefreshToken(ctx context.Context, req *connect.Request[authv1.RefreshTokenRequest]) (*connect.Response[authv1.RefreshTokenResponse], error) {
+	userAgent, ip := clientInfo(req)
+	tokens, err := h.sessionService.Refresh(ctx, req.Msg.RefreshToken, userAgent, ip)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRefreshToken) {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&authv1.RefreshTokenResponse{
+		Token:        tokens.AccessToken.Token,
+		RefreshToken: tokens.RefreshToken,
+	}), nil
+}
+
+// Logout 撤销当前会话
+func (h *AuthHandler) Logout(ctx context.Context, req *connect.Request[authv1.LogoutRequest]) (*connect.Response[authv1.LogoutResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	sessionID, _ := interceptors.GetSessionID(ctx)
+	if err := h.sessionService.Revoke(ctx, userID, sessionID); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&authv1.LogoutResponse{}), nil
+}
+
+func (h *AuthHandler) ListSessions(ctx context.Context, req *connect.Request[authv1.ListSessionsRequest]) (*connect.Response[authv1.ListSessionsResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	sessionID, _ := interceptors.GetSessionID(ctx)
+	var sessions []models.Session
+	if err := h.db.WithContext(ctx).Where("user_id = ? AND expires_at > NOW()", userID).Order("last_used_at DESC").Find(&sessions).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&authv1.ListSessionsResponse{
+		Sessions: lo.Map(sessions, func(session models.Session, _ int) *authv1.Session {
+			return session.ToProto(sessionID)
+		}),
+	}), nil
+}
+
+func (h *AuthHandler) RevokeSession(ctx context.Context, req *connect.Request[authv1.RevokeSessionRequest]) (*connect.Response[authv1.RevokeSessionResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	if err := h.sessionService.Revoke(ctx, userID, req.Msg.Id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("session not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&authv1.RevokeSessionResponse{}), nil
+}
+
+// RevokeOtherSessions 退出其他设备, 保留当前会话
+func (h *AuthHandler) RevokeOtherSessions(ctx context.Context, req *connect.Request[authv1.RevokeOtherSessionsRequest]) (*connect.Response[authv1.RevokeOtherSessionsResponse], error) {
+	userID, _ := interceptors.GetUserID(ctx)
+	sessionID, _ := interceptors.GetSessionID(ctx)
+	if err := h.sessionService.RevokeAll(ctx, userID, sessionID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&authv1.RevokeOtherSessionsResponse{}), nil
+}
+
+// clientInfo 会话列表中展示的设备信息; IP 优先使用反向代理设置的 X-Forwarded-For, 只用于展示
+func clientInfo(req connect.AnyRequest) (userAgent, ip string) {
+	ip, _, _ = strings.Cut(req.Header().Get("X-Forwarded-For"), ",")
+	ip = strings.TrimSpace(ip)
+	if ip == "" {
+		ip, _, _ = net.SplitHostPort(req.Peer().Addr)
+	}
+	return req.Header().Get("User-Agent"), ip
+}
@@ -3,8 +3,14 @@ package handlers
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/miekg/dns"
 	"github.com/redis/go-redis/v9"
 	"github.com/samber/lo"
 	"github.com/weppos/publicsuffix-go/publicsuffix"
@@ -14,36 +20,67 @@ import (
 	"dnsarc/internal/event"
 	"dnsarc/internal/interceptors"
 	"dnsarc/internal/models"
+	"dnsarc/internal/services"
 )
 
 type ZoneHandler struct {
-	db  *gorm.DB
-	rdb *redis.Client
+	db            *gorm.DB
+	rdb           *redis.Client
+	authz         *services.Authorizer
+	organizations *services.OrganizationService
+	verifier      *services.ZoneVerifier
+	checker       *services.ZoneChecker
 }
 
-func NewZoneHandler(db *gorm.DB, rdb *redis.Client) *ZoneHandler {
-	return &ZoneHandler{db: db, rdb: rdb}
+// takeoverVerificationWindow TXT 验证通过后, 在这个时间内可以接管 zone
+const takeoverVerificationWindow = time.Hour * 24
+
+func NewZoneHandler(db *gorm.DB, rdb *redis.Client, authz *services.Authorizer, organizations *services.OrganizationService, verifier *services.ZoneVerifier, checker *services.ZoneChecker) *ZoneHandler {
+	return &ZoneHandler{db: db, rdb: rdb, authz: authz, organizations: organizations, verifier: verifier, checker: checker}
 }
 
 func (h *ZoneHandler) CreateZone(ctx context.Context, req *connect.Request[zonev1.CreateZoneRequest]) (*connect.Response[zonev1.CreateZoneResponse], error) {
 	userID, _ := interceptors.GetUserID(ctx)
+	// 限制了 zone 的 API token 不能创建新的 zone
+	if apiToken, ok := interceptors.GetAPIToken(ctx); ok && len(apiToken.ZoneIDs) > 0 {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("api token is restricted to specific zones"))
+	}
 	zoneName, err := publicsuffix.Domain(req.Msg.ZoneName)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
-	// 检查 zone name 是否已经有 active 的存在
-	var existsZone models.Zone
-	if err := h.db.Where("zone_name = ? AND is_active = ?", zoneName, true).First(&existsZone).Error; err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	// 没有指定组织时创建在用户的个人组织中
+	organizationID := req.Msg.OrganizationId
+	if organizationID == "" {
+		organization, err := h.organizations.Personal(ctx, userID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		organizationID = organization.ID
+	}
+	if _, err := authorizeOrganization(ctx, h.authz, organizationID, services.PermissionManageZones); err != nil {
+		return nil, err
+	}
+	// 同一个组织不能重复添加同一个 zone
+	var count int64
+	if err := h.db.WithContext(ctx).Model(&models.Zone{}).Where("organization_id = ? AND zone_name = ?", organizationID, zoneName).Count(&count).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if count > 0 {
+		return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("zone name already exists"))
 	}
-	if existsZone.ID != "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("zone name already exists"))
+	// zone name 已经被其他组织激活时也允许创建, 通过 TXT 验证后可以接管
+	token, err := services.GenerateVerificationToken()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	zone := models.Zone{
-		UserID:   userID,
-		ZoneName: zoneName,
+		OrganizationID:    organizationID,
+		UserID:            userID,
+		ZoneName:          zoneName,
+		VerificationToken: token,
 	}
-	if err := h.db.Create(&zone).Error; err != nil {
+	if err := h.db.WithContext(ctx).Create(&zone).Error; err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	return &connect.Response[zonev1.CreateZoneResponse]{
@@ -55,10 +92,13 @@ func (h *ZoneHandler) CreateZone(ctx context.Context, req *connect.Request[zonev
 
 func (h *ZoneHandler) ListZones(ctx context.Context, req *connect.Request[zonev1.ListZonesRequest]) (*connect.Response[zonev1.ListZonesResponse], error) {
 	userID, _ := interceptors.GetUserID(ctx)
-	var zones []models.Zone
-	if err := h.db.Where("user_id = ?", userID).Find(&zones).Error; err != nil {
+	zones, err := h.authz.Zones(ctx, userID, req.Msg.OrganizationId)
+	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	zones = lo.Filter(zones, func(zone models.Zone, _ int) bool {
+		return interceptors.CanAccessZone(ctx, zone.ID)
+	})
 	return &connect.Response[zonev1.ListZonesResponse]{
 		Msg: &zonev1.ListZonesResponse{
 			Zones: lo.Map(zones, func(zone models.Zone, _ int) *zonev1.Zone {
@@ -69,10 +109,9 @@ func (h *ZoneHandler) ListZones(ctx context.Context, req *connect.Request[zonev1
 }
 
 func (h *ZoneHandler) GetZone(ctx context.Context, req *connect.Request[zonev1.GetZoneRequest]) (*connect.Response[zonev1.GetZoneResponse], error) {
-	userID, _ := interceptors.GetUserID(ctx)
-	var zone models.Zone
-	if err := h.db.Where("user_id = ? AND id = ?", userID, req.Msg.Id).First(&zone).Error; err != nil {
-		return nil, connect.NewError(connect.CodeNotFound, err)
+	zone, err := authorizeZone(ctx, h.authz, req.Msg.Id, services.PermissionViewZones)
+	if err != nil {
+		return nil, err
 	}
 	return &connect.Response[zonev1.GetZoneResponse]{
 		Msg: &zonev1.GetZoneResponse{
@@ -82,10 +121,9 @@ func (h *ZoneHandler) GetZone(ctx context.Context, req *connect.Request[zonev1.G
 }
 
 func (h *ZoneHandler) GetZoneByName(ctx context.Context, req *connect.Request[zonev1.GetZoneByNameRequest]) (*connect.Response[zonev1.GetZoneByNameResponse], error) {
-	userID, _ := interceptors.GetUserID(ctx)
-	var zone models.Zone
-	if err := h.db.Where("user_id = ? AND zone_name = ?", userID, req.Msg.ZoneName).First(&zone).Error; err != nil {
-		return nil, connect.NewError(connect.CodeNotFound, err)
+	zone, err := authorizeZoneByName(ctx, h.authz, req.Msg.OrganizationId, req.Msg.ZoneName, services.PermissionViewZones)
+	if err != nil {
+		return nil, err
 	}
 	return &connect.Response[zonev1.GetZoneByNameResponse]{
 		Msg: &zonev1.GetZoneByNameResponse{
@@ -95,18 +133,25 @@ func (h *ZoneHandler) GetZoneByName(ctx context.Context, req *connect.Request[zo
 }
 
 func (h *ZoneHandler) DeleteZone(ctx context.Context, req *connect.Request[zonev1.DeleteZoneRequest]) (*connect.Response[zonev1.DeleteZoneResponse], error) {
-	userID, _ := interceptors.GetUserID(ctx)
-	var zone models.Zone
-	if err := h.db.Where("user_id = ? AND id = ?", userID, req.Msg.Id).First(&zone).Error; err != nil {
-		return nil, connect.NewError(connect.CodeNotFound, err)
+	zone, err := authorizeZone(ctx, h.authz, req.Msg.Id, services.PermissionManageZones)
+	if err != nil {
+		return nil, err
 	}
 	// 事务
-	tx := h.db.Begin()
-	if err := tx.Where("user_id = ? AND id = ?", userID, req.Msg.Id).Delete(&zone).Error; err != nil {
+	tx := h.db.WithContext(ctx).Begin()
+	if err := tx.Delete(zone).Error; err != nil {
 		tx.Rollback()
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	if err := tx.Where("user_id = ? AND zone_name = ?", userID, zone.ZoneName).Delete(&models.DNSRecord{}).Error; err != nil {
+	if err := tx.Where("zone_id = ?", zone.ID).Delete(&models.DNSRecord{}).Error; err != nil {
+		tx.Rollback()
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := tx.Where("zone_id = ?", zone.ID).Delete(&models.ZoneCheck{}).Error; err != nil {
+		tx.Rollback()
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := tx.Where("zone_id = ?", zone.ID).Delete(&models.RecordGrant{}).Error; err != nil {
 		tx.Rollback()
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -116,10 +161,228 @@ func (h *ZoneHandler) DeleteZone(ctx context.Context, req *connect.Request[zonev
 	}
 	// 发布事件
 	go func() {
-		event.PublishEvent(h.rdb, event.Event{
+		event.PublishEvent(context.WithoutCancel(ctx), h.rdb, event.Event{
 			Type:     event.EventTypeZoneDelete,
 			ZoneName: zone.ZoneName,
 		})
 	}()
 	return &connect.Response[zonev1.DeleteZoneResponse]{}, nil
 }
+
+func (h *ZoneHandler) UpdateZoneNameservers(ctx context.Context, req *connect.Request[zonev1.UpdateZoneNameserversRequest]) (*connect.Response[zonev1.UpdateZoneNameserversResponse], error) {
+	zone, err := authorizeZone(ctx, h.authz, req.Msg.Id, services.PermissionManageZones)
+	if err != nil {
+		return nil, err
+	}
+	nameservers, err := normalizeNameservers(req.Msg.Nameservers)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	mbox, err := normalizeMBox(req.Msg.SoaMbox)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	zone.SOAMBox = mbox
+	// 正在服务的 zone 不能直接切换到还没有指向我们的自定义 NS, 先作为待生效的 NS, 检查通过后再替换
+	if zone.IsActive && len(nameservers) > 0 && !slices.Equal(nameservers, zone.Nameservers) {
+		zone.PendingNameservers = nameservers
+	} else {
+		zone.Nameservers = nameservers
+		zone.PendingNameservers = nil
+	}
+	if err := h.db.WithContext(ctx).Model(zone).Select("nameservers", "pending_nameservers", "soa_mbox").Updates(zone).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if _, err := h.checker.ApplyPendingNameservers(ctx, zone); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	go func() {
+		event.PublishEvent(context.WithoutCancel(ctx), h.rdb, event.Event{
+			Type:     event.EventTypeZoneUpdate,
+			ZoneName: zone.ZoneName,
+		})
+	}()
+	return &connect.Response[zonev1.UpdateZoneNameserversResponse]{
+		Msg: &zonev1.UpdateZoneNameserversResponse{
+			Zone: zone.ToProto(),
+		},
+	}, nil
+}
+
+// normalizeNameservers 校验并规范化自定义 NS, 空列表表示恢复默认 NS
+func normalizeNameservers(nameservers []string) ([]string, error) {
+	if len(nameservers) == 0 {
+		return nil, nil
+	}
+	if len(nameservers) < 2 || len(nameservers) > 13 {
+		return nil, errors.New("a zone needs between 2 and 13 nameservers")
+	}
+	result := make([]string, 0, len(nameservers))
+	for _, ns := range nameservers {
+		ns = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(ns)), ".")
+		if _, ok := dns.IsDomainName(ns); !ok || !strings.Contains(ns, ".") {
+			return nil, fmt.Errorf("invalid nameserver %q", ns)
+		}
+		if lo.Contains(result, ns) {
+			return nil, fmt.Errorf("duplicate nameserver %q", ns)
+		}
+		result = append(result, ns)
+	}
+	return result, nil
+}
+
+// normalizeMBox 校验 SOA mbox, 支持 hostmaster.example.com 和 hostmaster@example.com 两种写法
+func normalizeMBox(mbox string) (string, error) {
+	mbox = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(mbox)), ".")
+	if mbox == "" {
+		return "", nil
+	}
+	if local, domain, ok := strings.Cut(mbox, "@"); ok {
+		mbox = strings.ReplaceAll(local, ".", "\\.") + "." + domain
+	}
+	if _, ok := dns.IsDomainName(mbox); !ok || !strings.Contains(mbox, ".") {
+		return "", fmt.Errorf("invalid soa mbox %q", mbox)
+	}
+	return mbox, nil
+}
+
+func (h *ZoneHandler) VerifyZone(ctx context.Context, req *connect.Request[zonev1.VerifyZoneRequest]) (*connect.Response[zonev1.VerifyZoneResponse], error) {
+	zone, err := authorizeZone(ctx, h.authz, req.Msg.Id, services.PermissionManageZones)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.verifyTXT(ctx, *zone); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	zone.VerifiedAt = &now
+	if err := h.db.WithContext(ctx).Model(zone).Update("verified_at", now).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// 没有其他用户占用时, 验证通过即可激活, 不需要等 NS 切换
+	if !zone.IsActive && zone.Status != models.ZoneStatusSuspended {
+		if err := h.activateZone(ctx, zone, false); err != nil && !errors.Is(err, services.ErrZoneActiveElsewhere) {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	return &connect.Response[zonev1.VerifyZoneResponse]{
+		Msg: &zonev1.VerifyZoneResponse{
+			Zone: zone.ToProto(),
+		},
+	}, nil
+}
+
+func (h *ZoneHandler) TakeoverZone(ctx context.Context, req *connect.Request[zonev1.TakeoverZoneRequest]) (*connect.Response[zonev1.TakeoverZoneResponse], error) {
+	zone, err := authorizeZone(ctx, h.authz, req.Msg.Id, services.PermissionManageZones)
+	if err != nil {
+		return nil, err
+	}
+	if zone.IsActive {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("zone is already active"))
+	}
+	if zone.Status == models.ZoneStatusSuspended {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, services.ErrZoneSuspended)
+	}
+	if zone.VerifiedAt == nil || time.Since(*zone.VerifiedAt) > takeoverVerificationWindow {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("zone must be verified before takeover"))
+	}
+	// 接管前再验证一次, 确保 TXT 记录仍然存在
+	if err := h.verifyTXT(ctx, *zone); err != nil {
+		return nil, err
+	}
+	if err := h.activateZone(ctx, zone, true); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &connect.Response[zonev1.TakeoverZoneResponse]{
+		Msg: &zonev1.TakeoverZoneResponse{
+			Zone: zone.ToProto(),
+		},
+	}, nil
+}
+
+func (h *ZoneHandler) verifyTXT(ctx context.Context, zone models.Zone) error {
+	if err := h.verifier.VerifyTXT(ctx, zone); err != nil {
+		if errors.Is(err, services.ErrVerificationRecordNotFound) {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("TXT record %s with value %s not found", zone.VerificationRecordName(), zone.VerificationValue()))
+		}
+		return connect.NewError(connect.CodeUnavailable, err)
+	}
+	return nil
+}
+
+// activateZone 激活 zone, takeover 时停用同名的其他 active zone, 否则同名 zone 已经激活时返回 ErrZoneActiveElsewhere
+func (h *ZoneHandler) activateZone(ctx context.Context, zone *models.Zone, takeover bool) error {
+	err := h.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		activeElsewhere, err := services.ZoneActiveElsewhere(tx, zone)
+		if err != nil {
+			return err
+		}
+		if activeElsewhere && !takeover {
+			return services.ErrZoneActiveElsewhere
+		}
+		result := tx.Model(&models.Zone{}).Where("zone_name = ? AND is_active = ? AND id <> ?", zone.ZoneName, true, zone.ID).Updates(map[string]any{
+			"is_active": false,
+			"status":    models.ZoneStatusDeactivated,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected > 0 {
+			slog.Info("zone taken over", "zone", zone.ZoneName, "zone_id", zone.ID, "organization_id", zone.OrganizationID)
+		}
+		return tx.Model(zone).Updates(map[string]any{
+			"is_active": true,
+			"status":    models.ZoneStatusActive,
+		}).Error
+	})
+	if err != nil {
+		return err
+	}
+	zone.IsActive = true
+	zone.Status = models.ZoneStatusActive
+	go func() {
+		event.PublishEvent(context.WithoutCancel(ctx), h.rdb, event.Event{
+			Type:     event.EventTypeZoneCreate,
+			ZoneName: zone.ZoneName,
+		})
+	}()
+	return nil
+}
+
+func (h *ZoneHandler) CheckZone(ctx context.Context, req *connect.Request[zonev1.CheckZoneRequest]) (*connect.Response[zonev1.CheckZoneResponse], error) {
+	zone, err := authorizeZone(ctx, h.authz, req.Msg.Id, services.PermissionEditRecords)
+	if err != nil {
+		return nil, err
+	}
+	check, err := h.checker.Check(ctx, zone)
+	if err != nil {
+		if errors.Is(err, services.ErrZoneSuspended) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &connect.Response[zonev1.CheckZoneResponse]{
+		Msg: &zonev1.CheckZoneResponse{
+			Zone:  zone.ToProto(),
+			Check: check.ToProto(),
+		},
+	}, nil
+}
+
+func (h *ZoneHandler) ListZoneChecks(ctx context.Context, req *connect.Request[zonev1.ListZoneChecksRequest]) (*connect.Response[zonev1.ListZoneChecksResponse], error) {
+	zone, err := authorizeZone(ctx, h.authz, req.Msg.ZoneId, services.PermissionViewZones)
+	if err != nil {
+		return nil, err
+	}
+	var checks []models.ZoneCheck
+	if err := h.db.WithContext(ctx).Where("zone_id = ?", zone.ID).Order("checked_at DESC").Limit(50).Find(&checks).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &connect.Response[zonev1.ListZoneChecksResponse]{
+		Msg: &zonev1.ListZoneChecksResponse{
+			Checks: lo.Map(checks, func(check models.ZoneCheck, _ int) *zonev1.ZoneCheck {
+				return check.ToProto()
+			}),
+		},
+	}, nil
+}
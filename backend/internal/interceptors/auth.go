@@ -3,12 +3,21 @@ package interceptors
 import (
 	"context"
 	"errors"
+	"log/slog"
 	"slices"
 	"strings"
 
 	"connectrpc.com/connect"
 
+	"dnsarc/gen/analytics/v1/analyticsv1connect"
+	"dnsarc/gen/apitoken/v1/apitokenv1connect"
 	"dnsarc/gen/auth/v1/authv1connect"
+	"dnsarc/gen/dns_record/v1/dns_recordv1connect"
+	"dnsarc/gen/grant/v1/grantv1connect"
+	"dnsarc/gen/mfa/v1/mfav1connect"
+	"dnsarc/gen/organization/v1/organizationv1connect"
+	"dnsarc/gen/zone/v1/zonev1connect"
+	"dnsarc/internal/models"
 	"dnsarc/internal/services"
 )
 
@@ -18,19 +27,109 @@ type contextKey string
 const (
 	// UserIDKey 用户ID的context key
 	UserIDKey contextKey = "user_id"
+	// APITokenKey 使用 API token 认证时的 token
+	APITokenKey contextKey = "api_token"
+	// SessionIDKey 使用 access token 认证时的登录会话 ID
+	SessionIDKey contextKey = "session_id"
 )
 
 var publicRoutes = []string{
 	authv1connect.AuthServiceGoogleLoginURLProcedure,
+	authv1connect.AuthServiceListLoginProvidersProcedure,
+	authv1connect.AuthServiceGetLoginURLProcedure,
+	authv1connect.AuthServiceExchangeAuthCodeProcedure,
+	authv1connect.AuthServiceRefreshTokenProcedure,
+	authv1connect.AuthServiceBeginMFAWebAuthnProcedure,
+	authv1connect.AuthServiceVerifyMFAProcedure,
+	authv1connect.AuthServiceRegisterProcedure,
+	authv1connect.AuthServiceLoginProcedure,
+	authv1connect.AuthServiceVerifyEmailProcedure,
+	authv1connect.AuthServiceForgotPasswordProcedure,
+	authv1connect.AuthServiceResetPasswordProcedure,
 }
 
-func NewAuthInterceptor(jwtService *services.JwtService) connect.UnaryInterceptorFunc {
+// readOnlyRoutes read scope 的 API token 只能调用这些接口
+var readOnlyRoutes = []string{
+	authv1connect.AuthServiceWhoAmIProcedure,
+	zonev1connect.ZoneServiceListZonesProcedure,
+	zonev1connect.ZoneServiceGetZoneProcedure,
+	zonev1connect.ZoneServiceGetZoneByNameProcedure,
+	zonev1connect.ZoneServiceListZoneChecksProcedure,
+	dns_recordv1connect.DNSRecordServiceListDNSRecordsProcedure,
+	dns_recordv1connect.DNSRecordServiceListDNSRecordsByZoneNameProcedure,
+	dns_recordv1connect.DNSRecordServiceGetDNSRecordProcedure,
+	analyticsv1connect.AnalyticsServiceGetQueryTimeSeriesProcedure,
+	analyticsv1connect.AnalyticsServiceGetTopNamesProcedure,
+	organizationv1connect.OrganizationServiceListOrganizationsProcedure,
+}
+
+// sessionOnlyRoutes 只能使用登录的 JWT 调用, API token 不能管理 token, 登录方式, 会话和组织成员
+var sessionOnlyRoutes = []string{
+	apitokenv1connect.ApiTokenServiceCreateApiTokenProcedure,
+	apitokenv1connect.ApiTokenServiceListApiTokensProcedure,
+	apitokenv1connect.ApiTokenServiceRevokeApiTokenProcedure,
+	authv1connect.AuthServiceGetLinkURLProcedure,
+	authv1connect.AuthServiceUnlinkIdentityProcedure,
+	authv1connect.AuthServiceCompleteLinkProcedure,
+	authv1connect.AuthServiceLogoutProcedure,
+	authv1connect.AuthServiceListSessionsProcedure,
+	authv1connect.AuthServiceRevokeSessionProcedure,
+	authv1connect.AuthServiceRevokeOtherSessionsProcedure,
+	mfav1connect.MFAServiceGetMFAStatusProcedure,
+	mfav1connect.MFAServiceBeginTOTPEnrollmentProcedure,
+	mfav1connect.MFAServiceConfirmTOTPEnrollmentProcedure,
+	mfav1connect.MFAServiceDisableTOTPProcedure,
+	mfav1connect.MFAServiceBeginWebAuthnRegistrationProcedure,
+	mfav1connect.MFAServiceFinishWebAuthnRegistrationProcedure,
+	mfav1connect.MFAServiceDeleteWebAuthnCredentialProcedure,
+	mfav1connect.MFAServiceRegenerateRecoveryCodesProcedure,
+	mfav1connect.MFAServiceBeginStepUpProcedure,
+	mfav1connect.MFAServiceStepUpProcedure,
+	organizationv1connect.OrganizationServiceCreateOrganizationProcedure,
+	organizationv1connect.OrganizationServiceRenameOrganizationProcedure,
+	organizationv1connect.OrganizationServiceDeleteOrganizationProcedure,
+	organizationv1connect.OrganizationServiceListMembersProcedure,
+	organizationv1connect.OrganizationServiceUpdateMemberRoleProcedure,
+	organizationv1connect.OrganizationServiceRemoveMemberProcedure,
+	organizationv1connect.OrganizationServiceInviteMemberProcedure,
+	organizationv1connect.OrganizationServiceListInvitationsProcedure,
+	organizationv1connect.OrganizationServiceRevokeInvitationProcedure,
+	organizationv1connect.OrganizationServiceAcceptInvitationProcedure,
+	grantv1connect.RecordGrantServiceCreateRecordGrantProcedure,
+	grantv1connect.RecordGrantServiceListRecordGrantsProcedure,
+	grantv1connect.RecordGrantServiceDeleteRecordGrantProcedure,
+}
+
+// stepUpRoutes 启用了两步验证的用户需要最近通过 step-up 才能调用, 包括删除 zone 或组织, 接管 zone, 修改 zone 的 NS,
+// 修改组织成员和邀请, 修改记录授权, 创建 API token 和修改两步验证本身;
+// 注册新的验证方式只需要检查开始的接口, 完成注册需要开始时保存的 challenge
+var stepUpRoutes = []string{
+	zonev1connect.ZoneServiceDeleteZoneProcedure,
+	zonev1connect.ZoneServiceTakeoverZoneProcedure,
+	zonev1connect.ZoneServiceUpdateZoneNameserversProcedure,
+	organizationv1connect.OrganizationServiceDeleteOrganizationProcedure,
+	organizationv1connect.OrganizationServiceUpdateMemberRoleProcedure,
+	organizationv1connect.OrganizationServiceRemoveMemberProcedure,
+	organizationv1connect.OrganizationServiceInviteMemberProcedure,
+	organizationv1connect.OrganizationServiceRevokeInvitationProcedure,
+	grantv1connect.RecordGrantServiceCreateRecordGrantProcedure,
+	grantv1connect.RecordGrantServiceDeleteRecordGrantProcedure,
+	apitokenv1connect.ApiTokenServiceCreateApiTokenProcedure,
+	mfav1connect.MFAServiceBeginTOTPEnrollmentProcedure,
+	mfav1connect.MFAServiceDisableTOTPProcedure,
+	mfav1connect.MFAServiceBeginWebAuthnRegistrationProcedure,
+	mfav1connect.MFAServiceDeleteWebAuthnCredentialProcedure,
+	mfav1connect.MFAServiceRegenerateRecoveryCodesProcedure,
+}
+
+func NewAuthInterceptor(jwtService *services.JwtService, apiTokenService *services.APITokenService, sessionService *services.SessionService, mfaService *services.MFAService) connect.UnaryInterceptorFunc {
 	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
 		return func(
 			ctx context.Context,
 			req connect.AnyRequest,
 		) (connect.AnyResponse, error) {
-			if slices.Contains(publicRoutes, req.Spec().Procedure) {
+			procedure := req.Spec().Procedure
+			if slices.Contains(publicRoutes, procedure) {
 				return next(ctx, req)
 			}
 			bearerToken := req.Header().Get("Authorization")
@@ -40,27 +139,88 @@ func NewAuthInterceptor(jwtService *services.JwtService) connect.UnaryIntercepto
 					errors.New("no token provided"),
 				)
 			}
-			token := strings.Split(bearerToken, " ")[1]
-			if token == "" {
+			token, ok := strings.CutPrefix(bearerToken, "Bearer ")
+			if !ok || token == "" {
 				return nil, connect.NewError(
 					connect.CodeUnauthenticated,
 					errors.New("invalid token"),
 				)
 			}
-			userID, err := jwtService.VerifyToken(token)
+			if strings.HasPrefix(token, models.APITokenPrefix) {
+				apiToken, err := apiTokenService.VerifyToken(ctx, token)
+				if err != nil {
+					if !errors.Is(err, services.ErrInvalidAPIToken) {
+						slog.Error("failed to verify api token", "error", err)
+					}
+					return nil, connect.NewError(
+						connect.CodeUnauthenticated,
+						errors.New("invalid token"),
+					)
+				}
+				if slices.Contains(sessionOnlyRoutes, procedure) {
+					return nil, connect.NewError(
+						connect.CodePermissionDenied,
+						errors.New("api tokens cannot manage tokens, sign-in methods, sessions or organizations"),
+					)
+				}
+				if apiToken.Scope != models.APITokenScopeWrite && !slices.Contains(readOnlyRoutes, procedure) {
+					return nil, connect.NewError(
+						connect.CodePermissionDenied,
+						errors.New("api token does not have write scope"),
+					)
+				}
+				if err := requireStepUp(ctx, mfaService, procedure, apiToken.UserID, ""); err != nil {
+					return nil, err
+				}
+				ctx = context.WithValue(ctx, UserIDKey, apiToken.UserID)
+				ctx = context.WithValue(ctx, APITokenKey, apiToken)
+				return next(ctx, req)
+			}
+			claims, err := jwtService.VerifyToken(token)
 			if err != nil {
 				return nil, connect.NewError(
 					connect.CodeUnauthenticated,
 					errors.New("invalid token"),
 				)
 			}
-			ctx = context.WithValue(ctx, UserIDKey, userID)
+			// 撤销的会话和轮换前的 access token 在过期前都在 denylist 中
+			denied, err := sessionService.Denied(ctx, claims)
+			if err != nil {
+				slog.Error("failed to check token denylist", "error", err)
+				return nil, connect.NewError(connect.CodeUnavailable, errors.New("failed to verify token"))
+			}
+			if denied {
+				return nil, connect.NewError(
+					connect.CodeUnauthenticated,
+					errors.New("token has been revoked"),
+				)
+			}
+			if err := requireStepUp(ctx, mfaService, procedure, claims.Subject, claims.SessionID); err != nil {
+				return nil, err
+			}
+			ctx = context.WithValue(ctx, UserIDKey, claims.Subject)
+			ctx = context.WithValue(ctx, SessionIDKey, claims.SessionID)
 			return next(ctx, req)
 		}
 	}
 	return interceptor
 }
 
+// requireStepUp 检查 stepUpRoutes, API token 没有会话, 启用了两步验证的用户不能用它调用这些接口
+func requireStepUp(ctx context.Context, mfaService *services.MFAService, procedure, userID, sessionID string) error {
+	if !slices.Contains(stepUpRoutes, procedure) {
+		return nil
+	}
+	if err := mfaService.RequireStepUp(ctx, userID, sessionID); err != nil {
+		if errors.Is(err, services.ErrStepUpRequired) {
+			return connect.NewError(connect.CodePermissionDenied, err)
+		}
+		slog.Error("failed to check step-up", "error", err)
+		return connect.NewError(connect.CodeInternal, errors.New("failed to check two-factor confirmation"))
+	}
+	return nil
+}
+
 func GetUserID(ctx context.Context) (string, error) {
 	userID, ok := ctx.Value(UserIDKey).(string)
 	if !ok {
@@ -68,3 +228,21 @@ func GetUserID(ctx context.Context) (string, error) {
 	}
 	return userID, nil
 }
+
+// GetSessionID 返回 access token 所属的登录会话, 使用 API token 时返回 false
+func GetSessionID(ctx context.Context) (string, bool) {
+	sessionID, ok := ctx.Value(SessionIDKey).(string)
+	return sessionID, ok
+}
+
+// GetAPIToken 返回认证使用的 API token, 使用 JWT 时返回 false
+func GetAPIToken(ctx context.Context) (*models.APIToken, bool) {
+	apiToken, ok := ctx.Value(APITokenKey).(*models.APIToken)
+	return apiToken, ok
+}
+
+// CanAccessZone 使用 API token 时检查 token 的 zone 限制, JWT 可以访问用户所在组织的所有 zone
+func CanAccessZone(ctx context.Context, zoneID string) bool {
+	apiToken, ok := GetAPIToken(ctx)
+	return !ok || apiToken.CanAccessZone(zoneID)
+}
@@ -0,0 +1,178 @@
+package interceptors_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	apitokenv1 "dnsarc/gen/apitoken/v1"
+	"dnsarc/gen/apitoken/v1/apitokenv1connect"
+	dns_recordv1 "dnsarc/gen/dns_record/v1"
+	"dnsarc/gen/dns_record/v1/dns_recordv1connect"
+	organizationv1 "dnsarc/gen/organization/v1"
+	"dnsarc/gen/organization/v1/organizationv1connect"
+	zonev1 "dnsarc/gen/zone/v1"
+	"dnsarc/gen/zone/v1/zonev1connect"
+	"dnsarc/internal/database/dbtest"
+	"dnsarc/internal/handlers"
+	"dnsarc/internal/interceptors"
+	"dnsarc/internal/models"
+	"dnsarc/internal/services"
+)
+
+const testAPIToken = models.APITokenPrefix + "test"
+
+// testClients 通过认证拦截器调用接口, zone 使用真实的 handler, 其他服务只返回 Unimplemented
+type testClients struct {
+	zones         zonev1connect.ZoneServiceClient
+	records       dns_recordv1connect.DNSRecordServiceClient
+	apiTokens     apitokenv1connect.ApiTokenServiceClient
+	organizations organizationv1connect.OrganizationServiceClient
+}
+
+// newTestClients db 中的 api_tokens 表决定请求使用的 token
+func newTestClients(t *testing.T, db *dbtest.DB) *testClients {
+	t.Helper()
+	options := connect.WithInterceptors(interceptors.NewAuthInterceptor(nil, services.NewAPITokenService(db.DB), nil, nil))
+	authz := services.NewAuthorizer(db.DB)
+	mux := http.NewServeMux()
+	mux.Handle(zonev1connect.NewZoneServiceHandler(handlers.NewZoneHandler(db.DB, nil, authz, nil, nil, nil), options))
+	mux.Handle(dns_recordv1connect.NewDNSRecordServiceHandler(dns_recordv1connect.UnimplementedDNSRecordServiceHandler{}, options))
+	mux.Handle(apitokenv1connect.NewApiTokenServiceHandler(apitokenv1connect.UnimplementedApiTokenServiceHandler{}, options))
+	mux.Handle(organizationv1connect.NewOrganizationServiceHandler(organizationv1connect.UnimplementedOrganizationServiceHandler{}, options))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return &testClients{
+		zones:         zonev1connect.NewZoneServiceClient(server.Client(), server.URL),
+		records:       dns_recordv1connect.NewDNSRecordServiceClient(server.Client(), server.URL),
+		apiTokens:     apitokenv1connect.NewApiTokenServiceClient(server.Client(), server.URL),
+		organizations: organizationv1connect.NewOrganizationServiceClient(server.Client(), server.URL),
+	}
+}
+
+func newTestTokenDB(t *testing.T, token models.APIToken) *dbtest.DB {
+	t.Helper()
+	// 最近使用过的 token 不会在后台更新 last_used_at
+	now := time.Now()
+	token.ID, token.UserID, token.TokenHash, token.LastUsedAt = "token-a", "user-a", "hash", &now
+	db := dbtest.New(t)
+	db.Set("api_tokens", []models.APIToken{token})
+	db.Set("organization_members", []models.OrganizationMember{{OrganizationID: "org-a", UserID: "user-a", Role: models.OrgRoleOwner}})
+	return db
+}
+
+func withToken[T any](msg *T) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+	req.Header().Set("Authorization", "Bearer "+testAPIToken)
+	return req
+}
+
+func TestReadTokenOnWriteRoute(t *testing.T) {
+	db := newTestTokenDB(t, models.APIToken{Scope: models.APITokenScopeRead})
+	c := newTestClients(t, db)
+	ctx := context.Background()
+	calls := map[string]func() error{
+		"CreateZone": func() error {
+			_, err := c.zones.CreateZone(ctx, withToken(&zonev1.CreateZoneRequest{ZoneName: "example.com"}))
+			return err
+		},
+		"DeleteZone": func() error {
+			_, err := c.zones.DeleteZone(ctx, withToken(&zonev1.DeleteZoneRequest{Id: "zone-a"}))
+			return err
+		},
+		"CreateDNSRecord": func() error {
+			_, err := c.records.CreateDNSRecord(ctx, withToken(&dns_recordv1.CreateDNSRecordRequest{ZoneName: "example.com", Name: "www", Type: "A", Content: "192.0.2.1"}))
+			return err
+		},
+		"DeleteDNSRecord": func() error {
+			_, err := c.records.DeleteDNSRecord(ctx, withToken(&dns_recordv1.DeleteDNSRecordRequest{Id: "record-a"}))
+			return err
+		},
+	}
+	for name, call := range calls {
+		err := call()
+		if connect.CodeOf(err) != connect.CodePermissionDenied || !strings.Contains(err.Error(), "write scope") {
+			t.Errorf("%s: err = %v, want permission denied for the read scope", name, err)
+		}
+	}
+	// 被拒绝的请求没有到达 handler, 只查询了 token
+	for _, query := range db.Queries() {
+		if !strings.HasPrefix(query, `SELECT * FROM "api_tokens"`) {
+			t.Errorf("unexpected query %s", query)
+		}
+	}
+	// 只读的接口可以调用
+	db.Set("zones", []models.Zone{{ID: "zone-a", OrganizationID: "org-a", ZoneName: "example.com"}})
+	if _, err := c.zones.GetZone(ctx, withToken(&zonev1.GetZoneRequest{Id: "zone-a"})); err != nil {
+		t.Errorf("GetZone: %v", err)
+	}
+}
+
+func TestAPITokenOnSessionOnlyRoute(t *testing.T) {
+	for _, scope := range []models.APITokenScope{models.APITokenScopeRead, models.APITokenScopeWrite} {
+		c := newTestClients(t, newTestTokenDB(t, models.APIToken{Scope: scope}))
+		ctx := context.Background()
+		// 这些服务的 handler 返回 Unimplemented, 拒绝只能来自拦截器
+		calls := map[string]func() error{
+			"CreateApiToken": func() error {
+				_, err := c.apiTokens.CreateApiToken(ctx, withToken(&apitokenv1.CreateApiTokenRequest{Name: "ci"}))
+				return err
+			},
+			"ListApiTokens": func() error {
+				_, err := c.apiTokens.ListApiTokens(ctx, withToken(&apitokenv1.ListApiTokensRequest{}))
+				return err
+			},
+			"RevokeApiToken": func() error {
+				_, err := c.apiTokens.RevokeApiToken(ctx, withToken(&apitokenv1.RevokeApiTokenRequest{Id: "token-a"}))
+				return err
+			},
+			"ListMembers": func() error {
+				_, err := c.organizations.ListMembers(ctx, withToken(&organizationv1.ListMembersRequest{OrganizationId: "org-a"}))
+				return err
+			},
+			"InviteMember": func() error {
+				_, err := c.organizations.InviteMember(ctx, withToken(&organizationv1.InviteMemberRequest{OrganizationId: "org-a", Email: "b@example.com"}))
+				return err
+			},
+		}
+		for name, call := range calls {
+			if err := call(); connect.CodeOf(err) != connect.CodePermissionDenied {
+				t.Errorf("%s token %s: err = %v, want permission denied", scope, name, err)
+			}
+		}
+	}
+}
+
+func TestZoneRestrictedToken(t *testing.T) {
+	db := newTestTokenDB(t, models.APIToken{Scope: models.APITokenScopeWrite, ZoneIDs: []string{"zone-a"}})
+	c := newTestClients(t, db)
+	ctx := context.Background()
+
+	// zone-b 同样属于用户的组织, 只有 token 的 zone 限制能拒绝
+	db.Set("zones", []models.Zone{{ID: "zone-b", OrganizationID: "org-a", ZoneName: "example.org"}})
+	_, err := c.zones.GetZone(ctx, withToken(&zonev1.GetZoneRequest{Id: "zone-b"}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("GetZone(zone-b) err = %v, want permission denied", err)
+	}
+	_, err = c.zones.GetZoneByName(ctx, withToken(&zonev1.GetZoneByNameRequest{ZoneName: "example.org"}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("GetZoneByName(example.org) err = %v, want permission denied", err)
+	}
+	// 限制了 zone 的 token 也不能创建新的 zone
+	_, err = c.zones.CreateZone(ctx, withToken(&zonev1.CreateZoneRequest{ZoneName: "example.net"}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("CreateZone err = %v, want permission denied", err)
+	}
+
+	db.Set("zones", []models.Zone{{ID: "zone-a", OrganizationID: "org-a", ZoneName: "example.com"}})
+	res, err := c.zones.GetZone(ctx, withToken(&zonev1.GetZoneRequest{Id: "zone-a"}))
+	if err != nil || res.Msg.Zone.Id != "zone-a" {
+		t.Errorf("GetZone(zone-a) = %v, %v, want zone-a", res, err)
+	}
+}
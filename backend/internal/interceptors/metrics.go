@@ -0,0 +1,32 @@
+package interceptors
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"dnsarc/internal/metrics"
+)
+
+// NewMetricsInterceptor 统计每个 procedure 的请求数和处理时间, 需要放在认证之前才能统计到未认证的请求
+func NewMetricsInterceptor() connect.UnaryInterceptorFunc {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(
+			ctx context.Context,
+			req connect.AnyRequest,
+		) (connect.AnyResponse, error) {
+			start := time.Now()
+			procedure := req.Spec().Procedure
+			res, err := next(ctx, req)
+			code := "ok"
+			if err != nil {
+				code = connect.CodeOf(err).String()
+			}
+			metrics.RPCRequests.WithLabelValues(procedure, code).Inc()
+			metrics.RPCDuration.WithLabelValues(procedure).Observe(time.Since(start).Seconds())
+			return res, err
+		}
+	}
+	return interceptor
+}
@@ -0,0 +1,46 @@
+package mail
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// LogSender 只将收件人和标题输出到日志, 正文中的验证和重置链接可以登录账号, 不写入日志
+type LogSender struct{}
+
+func (s *LogSender) Send(ctx context.Context, msg Message) error {
+	slog.Info("mail not delivered", "to", msg.To, "subject", msg.Subject)
+	return nil
+}
+
+// FileSender 将邮件写入目录, 可以直接用邮件客户端打开
+type FileSender struct {
+	dir  string
+	from string
+}
+
+func NewFileSender(dir, from string) (*FileSender, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileSender{dir: dir, from: from}, nil
+}
+
+func (s *FileSender) Send(ctx context.Context, msg Message) error {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102T150405"), hex.EncodeToString(b))
+	data, err := compose(s.from, msg)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(s.dir, name), data, 0o644)
+}
@@ -0,0 +1,62 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+const (
+	SenderSMTP = "smtp"
+	SenderFile = "file" // 每封邮件写入目录中的一个 .eml 文件, 用于本地开发
+	SenderLog  = "log"  // 只在日志中记录收件人和标题, 不发送邮件, 用于本地开发
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string // 纯文本
+}
+
+// Sender 发送事务邮件, 如邮箱验证和重置密码
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+type Config struct {
+	// Sender smtp, file 或 log, 必须设置, 避免生产环境因为漏配而不发送邮件
+	Sender string
+	From   string
+	// SMTPAddr host:port, 服务器支持时使用 STARTTLS, 465 端口使用隐式 TLS
+	SMTPAddr     string
+	SMTPUsername string
+	SMTPPassword string
+	// Dir file sender 写入的目录
+	Dir string
+}
+
+func New(config Config) (Sender, error) {
+	if config.From == "" {
+		config.From = "DNSARC <noreply@localhost>"
+	}
+	switch config.Sender {
+	case "":
+		return nil, errors.New("mail sender is not configured, use smtp, or file or log for local development")
+	case SenderLog:
+		slog.Warn("MAIL SENDER IS log: verification and password reset mails are NOT delivered, use it only for local development")
+		return &LogSender{}, nil
+	case SenderFile:
+		if config.Dir == "" {
+			return nil, errors.New("file mail sender requires a directory")
+		}
+		return NewFileSender(config.Dir, config.From)
+	case SenderSMTP:
+		if config.SMTPAddr == "" {
+			return nil, errors.New("smtp mail sender requires an address")
+		}
+		return NewSMTPSender(config.SMTPAddr, config.SMTPUsername, config.SMTPPassword, config.From)
+	default:
+		return nil, fmt.Errorf("unknown mail sender %q", config.Sender)
+	}
+}
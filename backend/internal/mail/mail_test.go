@@ -0,0 +1,52 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		err    bool
+	}{
+		{"empty sender", Config{}, true},
+		{"log", Config{Sender: SenderLog}, false},
+		{"file without dir", Config{Sender: SenderFile}, true},
+		{"file", Config{Sender: SenderFile, Dir: t.TempDir()}, false},
+		{"smtp without addr", Config{Sender: SenderSMTP}, true},
+		{"unknown", Config{Sender: "sendmail"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.config)
+			if (err != nil) != tt.err {
+				t.Errorf("New() error = %v, want error %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestLogSenderRedactsBody(t *testing.T) {
+	var buf bytes.Buffer
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, nil)))
+	err := (&LogSender{}).Send(context.Background(), Message{
+		To:      "user@example.com",
+		Subject: "Reset your DNSARC password",
+		Body:    "https://dnsarc.com/auth/reset-password?token=secret-token",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), "secret-token") {
+		t.Errorf("log contains the mail body: %s", buf.String())
+	}
+	if !strings.Contains(buf.String(), "user@example.com") {
+		t.Errorf("log does not contain the recipient: %s", buf.String())
+	}
+}
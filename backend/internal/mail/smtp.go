@@ -0,0 +1,120 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// SMTPSender 通过 SMTP 发送邮件, 服务器支持时使用 STARTTLS
+type SMTPSender struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     string
+}
+
+func NewSMTPSender(addr, username, password, from string) (*SMTPSender, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid smtp address %q: %w", addr, err)
+	}
+	if _, err := mail.ParseAddress(from); err != nil {
+		return nil, fmt.Errorf("invalid from address %q: %w", from, err)
+	}
+	return &SMTPSender{addr: addr, host: host, username: username, password: password, from: from}, nil
+}
+
+func (s *SMTPSender) Send(ctx context.Context, msg Message) error {
+	from, err := mail.ParseAddress(s.from)
+	if err != nil {
+		return err
+	}
+	data, err := compose(s.from, msg)
+	if err != nil {
+		return err
+	}
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+	if _, ok := conn.(*tls.Conn); !ok {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+				return err
+			}
+		}
+	}
+	if s.username != "" {
+		// PlainAuth 只允许在 TLS 或 localhost 上发送密码
+		if err := client.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(from.Address); err != nil {
+		return err
+	}
+	if err := client.Rcpt(msg.To); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// dial 465 端口使用隐式 TLS, 其他端口使用明文连接, 之后尝试 STARTTLS
+func (s *SMTPSender) dial(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: time.Second * 10}
+	if strings.HasSuffix(s.addr, ":465") {
+		return (&tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: s.host}}).DialContext(ctx, "tcp", s.addr)
+	}
+	return dialer.DialContext(ctx, "tcp", s.addr)
+}
+
+// compose 生成 RFC 5322 格式的纯文本邮件
+func compose(from string, msg Message) ([]byte, error) {
+	if strings.ContainsAny(msg.To, "\r\n") || strings.ContainsAny(msg.Subject, "\r\n") {
+		return nil, errors.New("invalid mail header")
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(strings.ReplaceAll(msg.Body, "\r\n", "\n"), "\n", "\r\n"))
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,121 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "dnsarc"
+
+// DNS 服务
+var (
+	DNSQueries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "dns",
+		Name:      "queries_total",
+		Help:      "DNS queries answered, by query type, response code and transport.",
+	}, []string{"qtype", "rcode", "transport"})
+
+	DNSQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "dns",
+		Name:      "query_duration_seconds",
+		Help:      "Time from receiving a DNS query to writing the response.",
+		Buckets:   []float64{0.0001, 0.00025, 0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+	}, []string{"transport"})
+
+	DNSCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "dns",
+		Name:      "cache_requests_total",
+		Help:      "Zone and record cache lookups, by cache and result (hit or miss).",
+	}, []string{"cache", "result"})
+
+	DNSBloomFilter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "dns",
+		Name:      "bloom_filter_total",
+		Help:      "Bloom filter checks for the queried zone, by result (hit or miss).",
+	}, []string{"result"})
+
+	DNSFlattenUpstreamDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "dns",
+		Name:      "flatten_upstream_duration_seconds",
+		Help:      "Upstream lookups made while flattening ALIAS records, by result.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"result"})
+
+	DNSFlattenCache = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "dns",
+		Name:      "flatten_cache_requests_total",
+		Help:      "Upstream answer cache lookups made while flattening ALIAS records, by result (hit or miss).",
+	}, []string{"result"})
+
+	EventLag = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "dns",
+		Name:      "event_lag_seconds",
+		Help:      "Time between publishing a change event and the DNS server applying it, by event type.",
+		Buckets:   []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30},
+	}, []string{"type"})
+)
+
+// API 服务
+var (
+	RPCRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "requests_total",
+		Help:      "Connect RPCs handled, by procedure and status code.",
+	}, []string{"procedure", "code"})
+
+	RPCDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "duration_seconds",
+		Help:      "Connect RPC handling time, by procedure.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"procedure"})
+)
+
+// RegisterDB 导出数据库连接池的状态
+func RegisterDB(db *sql.DB) {
+	prometheus.MustRegister(collectors.NewDBStatsCollector(db, namespace))
+}
+
+// Result 将布尔的命中结果转换为 label
+func Result(hit bool) string {
+	if hit {
+		return "hit"
+	}
+	return "miss"
+}
+
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// RegisterRRL 导出 response rate limiting 的累计计数
+func RegisterRRL(dropped, slipped func() float64) {
+	prometheus.MustRegister(
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "dns",
+			Name:      "rrl_dropped_total",
+			Help:      "UDP responses dropped by response rate limiting.",
+		}, dropped),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "dns",
+			Name:      "rrl_slipped_total",
+			Help:      "Truncated responses sent instead of dropping by response rate limiting.",
+		}, slipped),
+	)
+}
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	apitokenv1 "dnsarc/gen/apitoken/v1"
+)
+
+// APITokenPrefix 所有 API token 的前缀, 用于和 JWT 区分
+const APITokenPrefix = "dnsarc_"
+
+// APITokenScope API token 的权限
+type APITokenScope string
+
+const (
+	APITokenScopeRead  APITokenScope = "read"  // 只能调用只读的接口
+	APITokenScopeWrite APITokenScope = "write" // 可以调用除 token 管理以外的所有接口
+)
+
+func (s APITokenScope) ToProto() apitokenv1.Scope {
+	switch s {
+	case APITokenScopeRead:
+		return apitokenv1.Scope_SCOPE_READ
+	case APITokenScopeWrite:
+		return apitokenv1.Scope_SCOPE_WRITE
+	default:
+		return apitokenv1.Scope_SCOPE_UNSPECIFIED
+	}
+}
+
+// APIToken 用户创建的 personal access token, 只保存 token 的 sha256
+type APIToken struct {
+	ID         string        `gorm:"primaryKey"`
+	UserID     string        `json:"user_id" gorm:"index"`
+	Name       string        `json:"name"`
+	Prefix     string        `json:"prefix"` // token 的开头部分, 用于在列表中识别
+	TokenHash  string        `json:"-" gorm:"uniqueIndex"`
+	Scope      APITokenScope `json:"scope"`
+	ZoneIDs    []string      `json:"zone_ids" gorm:"serializer:json"` // 为空时可以访问用户的所有 zone
+	ExpiresAt  *time.Time    `json:"expires_at"`                      // 为空时不过期
+	LastUsedAt *time.Time    `json:"last_used_at"`
+	CreatedAt  time.Time     `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (APIToken) TableName() string {
+	return "api_tokens"
+}
+
+func (t *APIToken) BeforeCreate(tx *gorm.DB) (err error) {
+	t.ID = uuid.New().String()
+	return
+}
+
+// Expired 检查 token 是否已经过期
+func (t *APIToken) Expired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
+
+// CanAccessZone 检查 token 是否可以访问 zone
+func (t *APIToken) CanAccessZone(zoneID string) bool {
+	return len(t.ZoneIDs) == 0 || slices.Contains(t.ZoneIDs, zoneID)
+}
+
+func (t *APIToken) ToProto() *apitokenv1.ApiToken {
+	token := &apitokenv1.ApiToken{
+		Id:        t.ID,
+		Name:      t.Name,
+		Prefix:    t.Prefix,
+		Scope:     t.Scope.ToProto(),
+		ZoneIds:   t.ZoneIDs,
+		CreatedAt: t.CreatedAt.Format(time.RFC3339),
+	}
+	if t.ExpiresAt != nil {
+		token.ExpiresAt = t.ExpiresAt.Format(time.RFC3339)
+	}
+	if t.LastUsedAt != nil {
+		token.LastUsedAt = t.LastUsedAt.Format(time.RFC3339)
+	}
+	return token
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,9 +10,25 @@ import (
 	dns_recordv1 "dnsarc/gen/dns_record/v1"
 )
 
+const (
+	RecordTypeCNAME = "CNAME"
+	// RecordTypeALIAS 在任意名称 (包括 apex) 上展平为 A/AAAA 的别名记录, ANAME 是它的同义词
+	RecordTypeALIAS = "ALIAS"
+	RecordTypeANAME = "ANAME"
+)
+
+// NormalizeRecordType 统一记录类型为大写, ANAME 保存为 ALIAS
+func NormalizeRecordType(recordType string) string {
+	recordType = strings.ToUpper(strings.TrimSpace(recordType))
+	if recordType == RecordTypeANAME {
+		return RecordTypeALIAS
+	}
+	return recordType
+}
+
 type DNSRecord struct {
 	ID        string    `json:"id" gorm:"primaryKey"`
-	UserID    string    `json:"user_id" gorm:"index"`
+	UserID    string    `json:"user_id" gorm:"index"` // 创建记录的用户
 	ZoneID    string    `json:"zone_id" gorm:"index"`
 	ZoneName  string    `json:"zone_name" gorm:"index"` // 冗余字段，用于缓存
 	Name      string    `json:"name"`
@@ -19,11 +36,42 @@ type DNSRecord struct {
 	Content   string    `json:"content"`
 	Weight    int       `json:"weight"` // 权重, 用于负载均衡
 	TTL       int       `json:"ttl"`
-	Country   string    `json:"country"` // 国家, 用于 geo 负载均衡, 默认为空
+	Country   string    `json:"country"`                     // 国家, 用于 geo 负载均衡, 默认为空
+	SVCB      *SVCBData `json:"svcb" gorm:"serializer:json"` // SVCB/HTTPS 记录的结构化数据, content 为对应的文本格式
 	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
 }
 
+// SVCBData SVCB/HTTPS 记录 (RFC 9460), Priority 为 0 时是 AliasMode
+type SVCBData struct {
+	Priority      uint16   `json:"priority"`
+	Target        string   `json:"target"`
+	ALPN          []string `json:"alpn,omitempty"`
+	NoDefaultALPN bool     `json:"no_default_alpn,omitempty"`
+	Port          uint16   `json:"port,omitempty"`
+	IPv4Hint      []string `json:"ipv4hint,omitempty"`
+	IPv6Hint      []string `json:"ipv6hint,omitempty"`
+	ECH           string   `json:"ech,omitempty"` // base64 编码的 ECHConfigList
+}
+
+func (d *SVCBData) ToProto() *dns_recordv1.SVCBData {
+	if d == nil {
+		return nil
+	}
+	return &dns_recordv1.SVCBData{
+		Priority: uint32(d.Priority),
+		Target:   d.Target,
+		Params: &dns_recordv1.SvcParams{
+			Alpn:          d.ALPN,
+			NoDefaultAlpn: d.NoDefaultALPN,
+			Port:          uint32(d.Port),
+			Ipv4Hint:      d.IPv4Hint,
+			Ipv6Hint:      d.IPv6Hint,
+			Ech:           d.ECH,
+		},
+	}
+}
+
 func (DNSRecord) TableName() string {
 	return "dns_records"
 }
@@ -43,6 +91,7 @@ func (r *DNSRecord) ToProto() *dns_recordv1.DNSRecord {
 		Content:   r.Content,
 		Ttl:       int32(r.TTL),
 		Weight:    int32(r.Weight),
+		Svcb:      r.SVCB.ToProto(),
 		CreatedAt: r.CreatedAt.Format(time.RFC3339),
 		UpdatedAt: r.UpdatedAt.Format(time.RFC3339),
 	}
@@ -0,0 +1,170 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	organizationv1 "dnsarc/gen/organization/v1"
+)
+
+// OrgRole 组织成员的角色, 权限依次递增
+type OrgRole string
+
+const (
+	OrgRoleViewer OrgRole = "viewer" // 只能查看 zone, 记录和统计
+	OrgRoleEditor OrgRole = "editor" // 可以修改记录, 检查和验证 zone
+	OrgRoleAdmin  OrgRole = "admin"  // 可以添加删除 zone, 管理除 owner 以外的成员
+	OrgRoleOwner  OrgRole = "owner"  // 可以管理 owner, 重命名和删除组织
+)
+
+// orgRoles 按权限从低到高排列
+var orgRoles = []OrgRole{OrgRoleViewer, OrgRoleEditor, OrgRoleAdmin, OrgRoleOwner}
+
+// Valid 检查是否是已知的角色
+func (r OrgRole) Valid() bool {
+	return slices.Contains(orgRoles, r)
+}
+
+// AtLeast 检查角色是否至少拥有 min 的权限
+func (r OrgRole) AtLeast(min OrgRole) bool {
+	return r.Valid() && slices.Index(orgRoles, r) >= slices.Index(orgRoles, min)
+}
+
+func (r OrgRole) ToProto() organizationv1.Role {
+	switch r {
+	case OrgRoleViewer:
+		return organizationv1.Role_ROLE_VIEWER
+	case OrgRoleEditor:
+		return organizationv1.Role_ROLE_EDITOR
+	case OrgRoleAdmin:
+		return organizationv1.Role_ROLE_ADMIN
+	case OrgRoleOwner:
+		return organizationv1.Role_ROLE_OWNER
+	default:
+		return organizationv1.Role_ROLE_UNSPECIFIED
+	}
+}
+
+// OrgRoleFromProto 转换请求中的角色, 未知的角色返回空字符串
+func OrgRoleFromProto(role organizationv1.Role) OrgRole {
+	switch role {
+	case organizationv1.Role_ROLE_VIEWER:
+		return OrgRoleViewer
+	case organizationv1.Role_ROLE_EDITOR:
+		return OrgRoleEditor
+	case organizationv1.Role_ROLE_ADMIN:
+		return OrgRoleAdmin
+	case organizationv1.Role_ROLE_OWNER:
+		return OrgRoleOwner
+	default:
+		return ""
+	}
+}
+
+// PersonalOrganizationName 个人组织的默认名称
+const PersonalOrganizationName = "Personal"
+
+// Organization zone 属于组织, 用户通过成员角色访问组织的 zone
+type Organization struct {
+	ID   string `gorm:"primaryKey"`
+	Name string `json:"name"`
+	// PersonalUserID 每个用户都有一个个人组织, 没有指定组织时 zone 创建在这里, 个人组织不能删除
+	PersonalUserID *string   `json:"personal_user_id" gorm:"uniqueIndex"`
+	CreatedAt      time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func (Organization) TableName() string {
+	return "organizations"
+}
+
+func (o *Organization) BeforeCreate(tx *gorm.DB) (err error) {
+	if o.ID == "" {
+		o.ID = uuid.New().String()
+	}
+	return
+}
+
+// Personal 检查是否是用户的个人组织
+func (o *Organization) Personal() bool {
+	return o.PersonalUserID != nil
+}
+
+// ToProto role 是当前用户在组织中的角色
+func (o *Organization) ToProto(role OrgRole) *organizationv1.Organization {
+	return &organizationv1.Organization{
+		Id:        o.ID,
+		Name:      o.Name,
+		Personal:  o.Personal(),
+		Role:      role.ToProto(),
+		CreatedAt: o.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// OrganizationMember 组织成员, 每个用户在一个组织中只有一个角色
+type OrganizationMember struct {
+	ID             string    `gorm:"primaryKey"`
+	OrganizationID string    `json:"organization_id" gorm:"uniqueIndex:idx_organization_members_org_user"`
+	UserID         string    `json:"user_id" gorm:"uniqueIndex:idx_organization_members_org_user;index"`
+	Role           OrgRole   `json:"role"`
+	User           User      `json:"-" gorm:"foreignKey:UserID"`
+	CreatedAt      time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (OrganizationMember) TableName() string {
+	return "organization_members"
+}
+
+func (m *OrganizationMember) BeforeCreate(tx *gorm.DB) (err error) {
+	if m.ID == "" {
+		m.ID = uuid.New().String()
+	}
+	return
+}
+
+// ToProto 需要预加载 User
+func (m *OrganizationMember) ToProto() *organizationv1.Member {
+	return &organizationv1.Member{
+		UserId:    m.UserID,
+		Email:     m.User.Email,
+		Avatar:    m.User.Avatar,
+		Role:      m.Role.ToProto(),
+		CreatedAt: m.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// OrganizationInvitation 通过邮件发送的邀请, 只保存 token 的 sha256, 只有相同邮箱的用户可以接受
+type OrganizationInvitation struct {
+	ID             string     `gorm:"primaryKey"`
+	OrganizationID string     `json:"organization_id" gorm:"index"`
+	Email          string     `json:"email"`
+	Role           OrgRole    `json:"role"`
+	TokenHash      string     `json:"-" gorm:"uniqueIndex"`
+	InvitedBy      string     `json:"invited_by"` // 邀请人的邮箱
+	ExpiresAt      time.Time  `json:"expires_at"`
+	AcceptedAt     *time.Time `json:"accepted_at"`
+	CreatedAt      time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (OrganizationInvitation) TableName() string {
+	return "organization_invitations"
+}
+
+func (i *OrganizationInvitation) BeforeCreate(tx *gorm.DB) (err error) {
+	i.ID = uuid.New().String()
+	return
+}
+
+func (i *OrganizationInvitation) ToProto() *organizationv1.Invitation {
+	return &organizationv1.Invitation{
+		Id:        i.ID,
+		Email:     i.Email,
+		Role:      i.Role.ToProto(),
+		InvitedBy: i.InvitedBy,
+		ExpiresAt: i.ExpiresAt.Format(time.RFC3339),
+		CreatedAt: i.CreatedAt.Format(time.RFC3339),
+	}
+}
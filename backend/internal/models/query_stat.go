@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	// QueryStatBucket 查询统计的时间粒度
+	QueryStatBucket = time.Minute * 5
+	// QueryStatOtherName 超过名称数量上限时, 其余名称合并到这个名称下统计
+	QueryStatOtherName = "*other*"
+)
+
+// QueryStat 按照时间段聚合的查询计数, 由 DNS 服务定期写入
+type QueryStat struct {
+	Bucket  time.Time `json:"bucket" gorm:"primaryKey;index:idx_query_stats_zone_bucket,priority:2"`
+	ZoneID  string    `json:"zone_id" gorm:"primaryKey;index:idx_query_stats_zone_bucket,priority:1"`
+	Name    string    `json:"name" gorm:"primaryKey"`
+	QType   string    `json:"qtype" gorm:"primaryKey;column:qtype"`
+	RCode   string    `json:"rcode" gorm:"primaryKey;column:rcode"`
+	Country string    `json:"country" gorm:"primaryKey"` // ISO 3166 代码, 未知时为空
+	Count   int64     `json:"count"`
+}
+
+func (QueryStat) TableName() string {
+	return "query_stats"
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	grantv1 "dnsarc/gen/grant/v1"
+)
+
+// PrincipalType grant 限制的对象
+type PrincipalType string
+
+const (
+	PrincipalTypeUser     PrincipalType = "user"
+	PrincipalTypeAPIToken PrincipalType = "api_token"
+)
+
+func (t PrincipalType) ToProto() grantv1.PrincipalType {
+	switch t {
+	case PrincipalTypeUser:
+		return grantv1.PrincipalType_PRINCIPAL_TYPE_USER
+	case PrincipalTypeAPIToken:
+		return grantv1.PrincipalType_PRINCIPAL_TYPE_API_TOKEN
+	default:
+		return grantv1.PrincipalType_PRINCIPAL_TYPE_UNSPECIFIED
+	}
+}
+
+// PrincipalTypeFromProto 转换请求中的类型, 未知的类型返回空字符串
+func PrincipalTypeFromProto(t grantv1.PrincipalType) PrincipalType {
+	switch t {
+	case grantv1.PrincipalType_PRINCIPAL_TYPE_USER:
+		return PrincipalTypeUser
+	case grantv1.PrincipalType_PRINCIPAL_TYPE_API_TOKEN:
+		return PrincipalTypeAPIToken
+	default:
+		return ""
+	}
+}
+
+// RecordGrant 把用户或 API token 在一个 zone 中可以修改的记录限制在匹配的名称和类型内
+//
+// 对象在 zone 中有 grant 时只能修改至少一个 grant 匹配的记录, 没有 grant 时只受组织角色限制;
+// grant 只会收窄权限, 不会超过对象原有的角色和 token scope
+type RecordGrant struct {
+	ID            string        `gorm:"primaryKey"`
+	ZoneID        string        `json:"zone_id" gorm:"index:idx_record_grants_zone_principal"`
+	PrincipalType PrincipalType `json:"principal_type" gorm:"index:idx_record_grants_zone_principal"`
+	PrincipalID   string        `json:"principal_id" gorm:"index:idx_record_grants_zone_principal"`
+	// NamePattern 完整的记录名, *.dev.example.com 匹配 dev.example.com 下任意层级的名称, 不包括 dev.example.com 本身
+	NamePattern string    `json:"name_pattern"`
+	Types       []string  `json:"types" gorm:"serializer:json"` // 为空时不限制记录类型
+	CreatedBy   string    `json:"created_by"`                   // 创建 grant 的用户
+	CreatedAt   time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (RecordGrant) TableName() string {
+	return "record_grants"
+}
+
+func (g *RecordGrant) BeforeCreate(tx *gorm.DB) (err error) {
+	g.ID = uuid.New().String()
+	return
+}
+
+// Matches 检查 grant 是否允许修改 name 上 recordType 类型的记录
+func (g *RecordGrant) Matches(name, recordType string) bool {
+	if len(g.Types) > 0 && !slices.Contains(g.Types, recordType) {
+		return false
+	}
+	if suffix, ok := strings.CutPrefix(g.NamePattern, "*"); ok {
+		return strings.HasSuffix(name, suffix)
+	}
+	return name == g.NamePattern
+}
+
+func (g *RecordGrant) ToProto() *grantv1.RecordGrant {
+	return &grantv1.RecordGrant{
+		Id:            g.ID,
+		ZoneId:        g.ZoneID,
+		PrincipalType: g.PrincipalType.ToProto(),
+		PrincipalId:   g.PrincipalID,
+		NamePattern:   g.NamePattern,
+		Types:         g.Types,
+		CreatedBy:     g.CreatedBy,
+		CreatedAt:     g.CreatedAt.Format(time.RFC3339),
+	}
+}
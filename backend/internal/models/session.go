@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	authv1 "dnsarc/gen/auth/v1"
+)
+
+// Session 登录会话, 持有可以轮换的 refresh token, 只保存 token 的 sha256
+type Session struct {
+	ID               string `gorm:"primaryKey"`
+	UserID           string `json:"user_id" gorm:"index"`
+	RefreshTokenHash string `json:"-" gorm:"uniqueIndex"`
+	// PreviousTokenHash 轮换前的 refresh token, 再次使用说明 token 可能被盗用
+	PreviousTokenHash string `json:"-" gorm:"index"`
+	// AccessTokenID 最近签发的 access token 的 jti, 撤销会话时加入 denylist
+	AccessTokenID        string    `json:"-"`
+	AccessTokenExpiresAt time.Time `json:"-"`
+	// StepUpAt 最近一次通过两步验证的时间, 危险操作需要最近验证过
+	StepUpAt   *time.Time `json:"-"`
+	UserAgent  string     `json:"user_agent"`
+	IP         string     `json:"ip"`
+	ExpiresAt  time.Time  `json:"expires_at" gorm:"index"`
+	LastUsedAt time.Time  `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (Session) TableName() string {
+	return "sessions"
+}
+
+func (s *Session) BeforeCreate(tx *gorm.DB) (err error) {
+	s.ID = uuid.New().String()
+	return
+}
+
+// Expired 检查会话是否已经过期, 每次刷新都会延长有效期
+func (s *Session) Expired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
+
+func (s *Session) ToProto(currentID string) *authv1.Session {
+	return &authv1.Session{
+		Id:         s.ID,
+		UserAgent:  s.UserAgent,
+		Ip:         s.IP,
+		Current:    s.ID == currentID,
+		CreatedAt:  s.CreatedAt.Format(time.RFC3339),
+		LastUsedAt: s.LastUsedAt.Format(time.RFC3339),
+		ExpiresAt:  s.ExpiresAt.Format(time.RFC3339),
+	}
+}
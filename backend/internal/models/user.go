@@ -8,12 +8,23 @@ import (
 )
 
 type User struct {
-	ID        string    `json:"id" gorm:"primaryKey"`
-	Email     string    `json:"email" gorm:"uniqueIndex"`
-	Password  string    `json:"password"`
-	Avatar    string    `json:"avatar"`
-	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+	ID            string `json:"id" gorm:"primaryKey"`
+	Email         string `json:"email" gorm:"uniqueIndex"`
+	Password      string `json:"-"` // argon2id hash, 只使用 Google 登录时为空
+	EmailVerified bool   `json:"email_verified"`
+	Avatar        string `json:"avatar"`
+	// 连续登录失败的次数, 达到上限后锁定到 LockedUntil
+	FailedLogins int        `json:"-"`
+	LockedUntil  *time.Time `json:"-"`
+	// 两步验证, TOTPSecret 是 base32 编码的密钥, TOTPEnabledAt 为空时还没有启用
+	TOTPSecret    string     `json:"-"`
+	TOTPEnabledAt *time.Time `json:"-"`
+	// TOTPLastStep 最近一次成功使用的时间窗口, 同一个验证码不能使用两次
+	TOTPLastStep int64 `json:"-"`
+	// RecoveryCodes 未使用的恢复码的 sha256, 启用第一个两步验证方式时生成
+	RecoveryCodes []string  `json:"-" gorm:"serializer:json"`
+	CreatedAt     time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time `json:"updated_at" gorm:"autoUpdateTime"`
 }
 
 func (User) TableName() string {
@@ -26,3 +37,13 @@ func (u *User) BeforeCreate(tx *gorm.DB) (err error) {
 	}
 	return
 }
+
+// TOTPEnabled 检查是否启用了 TOTP
+func (u *User) TOTPEnabled() bool {
+	return u.TOTPEnabledAt != nil
+}
+
+// Locked 检查账号是否因为登录失败次数过多被锁定
+func (u *User) Locked(now time.Time) bool {
+	return u.LockedUntil != nil && now.Before(*u.LockedUntil)
+}
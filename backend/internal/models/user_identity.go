@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	authv1 "dnsarc/gen/auth/v1"
+)
+
+// UserIdentity 用户绑定的外部登录身份, 一个用户可以绑定多个 provider
+type UserIdentity struct {
+	ID        string    `gorm:"primaryKey"`
+	UserID    string    `json:"user_id" gorm:"index"`
+	Provider  string    `json:"provider" gorm:"uniqueIndex:idx_user_identities_provider_subject"`
+	Subject   string    `json:"subject" gorm:"uniqueIndex:idx_user_identities_provider_subject"` // provider 中的用户 ID
+	Email     string    `json:"email"`                                                           // 最近一次登录时 provider 返回的邮箱
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func (UserIdentity) TableName() string {
+	return "user_identities"
+}
+
+func (i *UserIdentity) BeforeCreate(tx *gorm.DB) (err error) {
+	i.ID = uuid.New().String()
+	return
+}
+
+func (i *UserIdentity) ToProto() *authv1.Identity {
+	return &authv1.Identity{
+		Id:        i.ID,
+		Provider:  i.Provider,
+		Email:     i.Email,
+		CreatedAt: i.CreatedAt.Format(time.RFC3339),
+	}
+}
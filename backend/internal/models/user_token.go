@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// UserTokenPurpose 邮件中发送的一次性 token 的用途
+type UserTokenPurpose string
+
+const (
+	UserTokenPurposeVerifyEmail   UserTokenPurpose = "verify_email"
+	UserTokenPurposeResetPassword UserTokenPurpose = "reset_password"
+)
+
+// UserToken 通过邮件发送的一次性 token, 只保存 token 的 sha256
+type UserToken struct {
+	ID        string           `gorm:"primaryKey"`
+	UserID    string           `json:"user_id" gorm:"index"`
+	Purpose   UserTokenPurpose `json:"purpose"`
+	TokenHash string           `json:"-" gorm:"uniqueIndex"`
+	ExpiresAt time.Time        `json:"expires_at" gorm:"index"`
+	UsedAt    *time.Time       `json:"used_at"`
+	CreatedAt time.Time        `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (UserToken) TableName() string {
+	return "user_tokens"
+}
+
+func (t *UserToken) BeforeCreate(tx *gorm.DB) (err error) {
+	t.ID = uuid.New().String()
+	return
+}
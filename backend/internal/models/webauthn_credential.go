@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	mfav1 "dnsarc/gen/mfa/v1"
+)
+
+// WebAuthnCredential 用户注册的安全密钥, 用作两步验证
+type WebAuthnCredential struct {
+	ID           string              `gorm:"primaryKey"`
+	UserID       string              `json:"user_id" gorm:"index"`
+	Name         string              `json:"name"`
+	CredentialID []byte              `json:"-" gorm:"uniqueIndex"`
+	Credential   webauthn.Credential `json:"-" gorm:"serializer:json"`
+	LastUsedAt   *time.Time          `json:"last_used_at"`
+	CreatedAt    time.Time           `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (WebAuthnCredential) TableName() string {
+	return "webauthn_credentials"
+}
+
+func (c *WebAuthnCredential) BeforeCreate(tx *gorm.DB) (err error) {
+	c.ID = uuid.New().String()
+	return
+}
+
+func (c *WebAuthnCredential) ToProto() *mfav1.WebAuthnCredential {
+	credential := &mfav1.WebAuthnCredential{
+		Id:        c.ID,
+		Name:      c.Name,
+		CreatedAt: c.CreatedAt.Format(time.RFC3339),
+	}
+	if c.LastUsedAt != nil {
+		credential.LastUsedAt = c.LastUsedAt.Format(time.RFC3339)
+	}
+	return credential
+}
@@ -2,19 +2,69 @@ package models
 
 import (
 	zonev1 "dnsarc/gen/zone/v1"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
+const (
+	// VerificationRecordPrefix TXT 验证记录的前缀, 完整记录为 _dnsarc-challenge.<zone>
+	VerificationRecordPrefix = "_dnsarc-challenge"
+	// VerificationValuePrefix TXT 验证记录内容的前缀
+	VerificationValuePrefix = "dnsarc-verification="
+)
+
+// ZoneStatus zone 的激活状态
+type ZoneStatus string
+
+const (
+	ZoneStatusPending     ZoneStatus = "pending"     // 新建, 还没有检查通过
+	ZoneStatusActive      ZoneStatus = "active"      // NS 或 TXT 验证通过, 正在提供解析
+	ZoneStatusNSMismatch  ZoneStatus = "ns_mismatch" // NS 没有指向我们
+	ZoneStatusDeactivated ZoneStatus = "deactivated" // 曾经 active, NS 已经移走或被其他用户接管
+	ZoneStatusSuspended   ZoneStatus = "suspended"   // 被管理员暂停, 不再检查
+)
+
+func (s ZoneStatus) ToProto() zonev1.ZoneStatus {
+	switch s {
+	case ZoneStatusPending:
+		return zonev1.ZoneStatus_ZONE_STATUS_PENDING
+	case ZoneStatusActive:
+		return zonev1.ZoneStatus_ZONE_STATUS_ACTIVE
+	case ZoneStatusNSMismatch:
+		return zonev1.ZoneStatus_ZONE_STATUS_NS_MISMATCH
+	case ZoneStatusDeactivated:
+		return zonev1.ZoneStatus_ZONE_STATUS_DEACTIVATED
+	case ZoneStatusSuspended:
+		return zonev1.ZoneStatus_ZONE_STATUS_SUSPENDED
+	default:
+		return zonev1.ZoneStatus_ZONE_STATUS_UNSPECIFIED
+	}
+}
+
 type Zone struct {
-	ID        string    `gorm:"primaryKey"`
-	UserID    string    `json:"user_id" gorm:"index"`
-	ZoneName  string    `json:"zone_name" gorm:"index"`
-	IsActive  bool      `json:"is_active"`
-	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+	ID             string     `gorm:"primaryKey"`
+	OrganizationID string     `json:"organization_id" gorm:"index"`
+	UserID         string     `json:"user_id" gorm:"index"` // 创建 zone 的用户, 访问权限由组织成员角色决定
+	ZoneName       string     `json:"zone_name" gorm:"index"`
+	IsActive       bool       `json:"is_active"` // 与 Status == active 保持一致, 用于 DNS 服务查询; 每个 zone_name 最多一个 active (部分唯一索引)
+	Status         ZoneStatus `json:"status" gorm:"index;default:pending"`
+	Nameservers    []string   `json:"nameservers" gorm:"serializer:json"` // 自定义 NS, 为空时使用默认的 NS1/NS2
+	SOAMBox        string     `json:"soa_mbox" gorm:"column:soa_mbox"`    // 自定义 SOA mbox, 为空时使用默认的 MBOX
+	// PendingNameservers active zone 新设置的自定义 NS, 检查都指向默认 NS 的地址后才替换 Nameservers
+	PendingNameservers []string `json:"pending_nameservers" gorm:"serializer:json"`
+	// TXT 所有权验证, 记录为 _dnsarc-challenge.<zone> TXT "dnsarc-verification=<token>"
+	VerificationToken string     `json:"verification_token"`
+	VerifiedAt        *time.Time `json:"verified_at"`
+	// 最近一次检查的结果
+	ObservedNS     []string   `json:"observed_ns" gorm:"column:observed_ns;serializer:json"`
+	Delegated      bool       `json:"delegated"` // 最近一次检查时 NS 是否指向我们
+	LastCheckedAt  *time.Time `json:"last_checked_at"`
+	LastCheckError string     `json:"last_check_error"`
+	CreatedAt      time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt      time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
 }
 
 func (Zone) TableName() string {
@@ -23,15 +73,97 @@ func (Zone) TableName() string {
 
 func (z *Zone) BeforeCreate(tx *gorm.DB) (err error) {
 	z.ID = uuid.New().String()
+	if z.Status == "" {
+		z.Status = ZoneStatusPending
+	}
 	return
 }
 
+// VerificationRecordName 返回 zone 的 TXT 验证记录名
+func (z *Zone) VerificationRecordName() string {
+	return VerificationRecordPrefix + "." + z.ZoneName
+}
+
+// VerificationValue 返回 TXT 验证记录应包含的内容
+func (z *Zone) VerificationValue() string {
+	return VerificationValuePrefix + z.VerificationToken
+}
+
+// EffectiveNameservers 返回 zone 实际使用的 NS 列表, 没有自定义时使用 defaults
+func (z *Zone) EffectiveNameservers(defaults ...string) []string {
+	if len(z.Nameservers) > 0 {
+		return z.Nameservers
+	}
+	nameservers := make([]string, 0, len(defaults))
+	for _, ns := range defaults {
+		if ns = strings.TrimSuffix(strings.ToLower(ns), "."); ns != "" {
+			nameservers = append(nameservers, ns)
+		}
+	}
+	return nameservers
+}
+
+// EffectiveMBox 返回 zone 实际使用的 SOA mbox, 没有自定义时使用 def
+func (z *Zone) EffectiveMBox(def string) string {
+	if z.SOAMBox != "" {
+		return z.SOAMBox
+	}
+	return strings.TrimSuffix(strings.ToLower(def), ".")
+}
+
 func (z *Zone) ToProto() *zonev1.Zone {
-	return &zonev1.Zone{
-		Id:        z.ID,
-		ZoneName:  z.ZoneName,
-		IsActive:  z.IsActive,
-		CreatedAt: z.CreatedAt.Format(time.RFC3339),
-		UpdatedAt: z.UpdatedAt.Format(time.RFC3339),
+	zone := &zonev1.Zone{
+		Id:                  z.ID,
+		OrganizationId:      z.OrganizationID,
+		ZoneName:            z.ZoneName,
+		IsActive:            z.IsActive,
+		Nameservers:         z.Nameservers,
+		PendingNameservers:  z.PendingNameservers,
+		SoaMbox:             z.SOAMBox,
+		VerificationToken:   z.VerificationToken,
+		VerificationRecord:  z.VerificationRecordName(),
+		Status:              z.Status.ToProto(),
+		ObservedNameservers: z.ObservedNS,
+		LastCheckError:      z.LastCheckError,
+		CreatedAt:           z.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:           z.UpdatedAt.Format(time.RFC3339),
+	}
+	if z.VerifiedAt != nil {
+		zone.VerifiedAt = z.VerifiedAt.Format(time.RFC3339)
+	}
+	if z.LastCheckedAt != nil {
+		zone.LastCheckedAt = z.LastCheckedAt.Format(time.RFC3339)
+	}
+	return zone
+}
+
+// ZoneCheck zone 检查历史
+type ZoneCheck struct {
+	ID         string     `gorm:"primaryKey"`
+	ZoneID     string     `json:"zone_id" gorm:"index"`
+	Status     ZoneStatus `json:"status"`
+	ObservedNS []string   `json:"observed_ns" gorm:"column:observed_ns;serializer:json"`
+	Error      string     `json:"error"`
+	CheckedAt  time.Time  `json:"checked_at" gorm:"index"`
+}
+
+func (ZoneCheck) TableName() string {
+	return "zone_checks"
+}
+
+func (c *ZoneCheck) BeforeCreate(tx *gorm.DB) (err error) {
+	if c.ID == "" {
+		c.ID = uuid.New().String()
+	}
+	return
+}
+
+func (c *ZoneCheck) ToProto() *zonev1.ZoneCheck {
+	return &zonev1.ZoneCheck{
+		Id:                  c.ID,
+		Status:              c.Status.ToProto(),
+		ObservedNameservers: c.ObservedNS,
+		Error:               c.Error,
+		CheckedAt:           c.CheckedAt.Format(time.RFC3339),
 	}
 }
@@ -0,0 +1,122 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
+)
+
+const githubAPI = "https://api.github.com"
+
+// GitHubProvider GitHub OAuth App, GitHub 不支持 OIDC 登录, 通过 API 获取用户和已验证的邮箱
+type GitHubProvider struct {
+	config ProviderConfig
+	oauth  *oauth2.Config
+}
+
+func NewGitHub(config ProviderConfig) *GitHubProvider {
+	scopes := config.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"read:user", "user:email"}
+	}
+	return &GitHubProvider{
+		config: config,
+		oauth: &oauth2.Config{
+			ClientID:     config.ClientID,
+			ClientSecret: config.ClientSecret,
+			RedirectURL:  config.RedirectURL,
+			Endpoint:     github.Endpoint,
+			Scopes:       scopes,
+		},
+	}
+}
+
+func (p *GitHubProvider) Name() string {
+	return p.config.Name
+}
+
+func (p *GitHubProvider) DisplayName() string {
+	return p.config.DisplayName
+}
+
+func (p *GitHubProvider) LoginURL() string {
+	return p.config.LoginURL
+}
+
+// AuthCodeURL GitHub 没有 ID token, 不使用 nonce
+func (p *GitHubProvider) AuthCodeURL(state, verifier, nonce string) string {
+	return p.oauth.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier))
+}
+
+func (p *GitHubProvider) Exchange(ctx context.Context, code, verifier, nonce string) (*Identity, error) {
+	token, err := p.oauth.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, err
+	}
+	client := p.oauth.Client(ctx, token)
+	var user struct {
+		ID        int64  `json:"id"`
+		Login     string `json:"login"`
+		Name      string `json:"name"`
+		AvatarURL string `json:"avatar_url"`
+	}
+	if err := githubGet(ctx, client, "/user", &user); err != nil {
+		return nil, err
+	}
+	if user.ID == 0 {
+		return nil, errors.New("github user has no id")
+	}
+	// /user 中的 email 是公开邮箱, 不一定经过验证, 使用主邮箱
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := githubGet(ctx, client, "/user/emails", &emails); err != nil {
+		return nil, err
+	}
+	identity := &Identity{
+		Provider: p.config.Name,
+		Subject:  strconv.FormatInt(user.ID, 10),
+		Name:     user.Name,
+		Avatar:   user.AvatarURL,
+	}
+	if identity.Name == "" {
+		identity.Name = user.Login
+	}
+	for _, email := range emails {
+		if email.Primary {
+			identity.Email = email.Email
+			identity.EmailVerified = email.Verified
+		}
+	}
+	return identity, nil
+}
+
+func githubGet(ctx context.Context, client *http.Client, path string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, githubAPI+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("github %s: %s: %s", path, resp.Status, body)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
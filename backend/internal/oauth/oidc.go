@@ -0,0 +1,109 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+// OIDCProvider 通过 discovery 配置的 OpenID Connect provider, 使用 PKCE 和 nonce, 并校验 ID token
+type OIDCProvider struct {
+	config   ProviderConfig
+	provider *oidc.Provider
+	oauth    *oauth2.Config
+	verifier *oidc.IDTokenVerifier
+}
+
+func NewOIDC(ctx context.Context, config ProviderConfig) (*OIDCProvider, error) {
+	if config.Issuer == "" {
+		return nil, fmt.Errorf("%s_ISSUER is required", envPrefix(config.Name))
+	}
+	// discovery 使用的 ctx 会被保存下来用于之后获取 JWKS, 不能使用会被取消的 ctx
+	provider, err := oidc.NewProvider(context.WithoutCancel(ctx), config.Issuer)
+	if err != nil {
+		return nil, err
+	}
+	scopes := config.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{oidc.ScopeOpenID, "email", "profile"}
+	}
+	return &OIDCProvider{
+		config:   config,
+		provider: provider,
+		oauth: &oauth2.Config{
+			ClientID:     config.ClientID,
+			ClientSecret: config.ClientSecret,
+			RedirectURL:  config.RedirectURL,
+			Endpoint:     provider.Endpoint(),
+			Scopes:       scopes,
+		},
+		verifier: provider.Verifier(&oidc.Config{ClientID: config.ClientID}),
+	}, nil
+}
+
+func (p *OIDCProvider) Name() string {
+	return p.config.Name
+}
+
+func (p *OIDCProvider) DisplayName() string {
+	return p.config.DisplayName
+}
+
+func (p *OIDCProvider) LoginURL() string {
+	return p.config.LoginURL
+}
+
+func (p *OIDCProvider) AuthCodeURL(state, verifier, nonce string) string {
+	return p.oauth.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier), oidc.Nonce(nonce))
+}
+
+func (p *OIDCProvider) Exchange(ctx context.Context, code, verifier, nonce string) (*Identity, error) {
+	token, err := p.oauth.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, err
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, errors.New("no id_token in token response")
+	}
+	idToken, err := p.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, err
+	}
+	if idToken.Nonce != nonce {
+		return nil, errors.New("id_token nonce mismatch")
+	}
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified any    `json:"email_verified"` // 部分 provider 返回字符串 "true"
+		Name          string `json:"name"`
+		Picture       string `json:"picture"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, err
+	}
+	identity := &Identity{
+		Provider:      p.config.Name,
+		Subject:       idToken.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified == true || claims.EmailVerified == "true",
+		Name:          claims.Name,
+		Avatar:        claims.Picture,
+	}
+	// ID token 中没有 email 时从 userinfo 获取
+	if identity.Email == "" {
+		userInfo, err := p.provider.UserInfo(ctx, oauth2.StaticTokenSource(token))
+		if err != nil {
+			return nil, err
+		}
+		if userInfo.Subject != identity.Subject {
+			return nil, errors.New("userinfo subject mismatch")
+		}
+		identity.Email = userInfo.Email
+		identity.EmailVerified = userInfo.EmailVerified
+	}
+	return identity, nil
+}
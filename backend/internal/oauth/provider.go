@@ -0,0 +1,157 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	ProviderGoogle = "google"
+	ProviderGitHub = "github"
+
+	googleIssuer = "https://accounts.google.com"
+)
+
+var (
+	ErrEmailNotVerified = errors.New("email is not verified by the provider")
+
+	// providerNamePattern 名称会出现在回调地址和环境变量中
+	providerNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,31}$`)
+
+	defaultDisplayNames = map[string]string{
+		ProviderGoogle: "Google",
+		ProviderGitHub: "GitHub",
+	}
+)
+
+// Identity 登录后从 provider 获取的用户信息
+type Identity struct {
+	Provider      string
+	Subject       string // provider 内唯一且不变的用户 ID
+	Email         string
+	EmailVerified bool
+	Name          string
+	Avatar        string
+}
+
+// Provider 一个可以用来登录的 OAuth2 / OIDC 身份提供方
+type Provider interface {
+	Name() string
+	DisplayName() string
+	// LoginURL API 服务上开始登录的地址, 设置 state cookie 后跳转到 provider
+	LoginURL() string
+	// AuthCodeURL 返回跳转到 provider 的登录地址, verifier 为 PKCE code verifier, nonce 用于 OIDC ID token
+	AuthCodeURL(state, verifier, nonce string) string
+	// Exchange 使用回调中的 code 换取用户信息
+	Exchange(ctx context.Context, code, verifier, nonce string) (*Identity, error)
+}
+
+// Registry 启动时配置的 provider, 按照配置的顺序展示
+type Registry struct {
+	providers []Provider
+}
+
+func (r *Registry) Get(name string) (Provider, bool) {
+	for _, p := range r.providers {
+		if p.Name() == name {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+func (r *Registry) List() []Provider {
+	return r.providers
+}
+
+// ProviderConfig 单个 provider 的配置, 从 <NAME>_* 环境变量读取
+type ProviderConfig struct {
+	Name         string
+	DisplayName  string
+	Issuer       string // OIDC issuer, google 默认为 https://accounts.google.com
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	LoginURL     string
+	Scopes       []string
+}
+
+// NewRegistry 根据 names (如 "google,github,okta") 创建 provider, github 使用 GitHub OAuth,
+// 其他名称都是 OIDC, 需要通过 discovery 获取 endpoint, 因此会访问 issuer
+func NewRegistry(ctx context.Context, names string, getenv func(string) string, callbackBaseURL string) (*Registry, error) {
+	registry := &Registry{}
+	for name := range strings.SplitSeq(names, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if !providerNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("invalid auth provider name %q", name)
+		}
+		if _, ok := registry.Get(name); ok {
+			return nil, fmt.Errorf("duplicate auth provider %q", name)
+		}
+		config := providerConfig(name, getenv, callbackBaseURL)
+		if config.ClientID == "" {
+			return nil, fmt.Errorf("auth provider %q requires %s_CLIENT_ID", name, envPrefix(name))
+		}
+		if config.RedirectURL == "" || config.LoginURL == "" {
+			return nil, fmt.Errorf("auth provider %q requires API_URL", name)
+		}
+		var provider Provider
+		var err error
+		if name == ProviderGitHub {
+			provider = NewGitHub(config)
+		} else {
+			provider, err = NewOIDC(ctx, config)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("auth provider %q: %w", name, err)
+		}
+		registry.providers = append(registry.providers, provider)
+	}
+	return registry, nil
+}
+
+func providerConfig(name string, getenv func(string) string, callbackBaseURL string) ProviderConfig {
+	prefix := envPrefix(name)
+	config := ProviderConfig{
+		Name:         name,
+		DisplayName:  getenv(prefix + "_DISPLAY_NAME"),
+		Issuer:       getenv(prefix + "_ISSUER"),
+		ClientID:     getenv(prefix + "_CLIENT_ID"),
+		ClientSecret: getenv(prefix + "_CLIENT_SECRET"),
+		RedirectURL:  getenv(prefix + "_REDIRECT_URL"),
+	}
+	if scopes := getenv(prefix + "_SCOPES"); scopes != "" {
+		config.Scopes = strings.Fields(strings.ReplaceAll(scopes, ",", " "))
+	}
+	// 登录和回调都在 API 服务的 /auth/<name>/ 下, 没有配置 API_URL 时从回调地址推断
+	base := strings.TrimSuffix(callbackBaseURL, "/") + "/auth/" + name
+	if callbackBaseURL == "" {
+		base, _ = strings.CutSuffix(config.RedirectURL, "/callback")
+	}
+	if config.RedirectURL == "" && callbackBaseURL != "" {
+		config.RedirectURL = base + "/callback"
+	}
+	if base != "" && base != config.RedirectURL {
+		config.LoginURL = base + "/login"
+	}
+	if name == ProviderGoogle {
+		config.Issuer = googleIssuer
+	}
+	if config.DisplayName == "" {
+		config.DisplayName = defaultDisplayNames[name]
+	}
+	if config.DisplayName == "" {
+		config.DisplayName = name
+	}
+	return config
+}
+
+func envPrefix(name string) string {
+	return strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+}
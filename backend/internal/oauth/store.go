@@ -0,0 +1,182 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// StateTTL 从开始登录到回调的最长时间
+	StateTTL = time.Minute * 10
+	// codeTTL 回调后前端换取 token 的时间
+	codeTTL = time.Minute
+)
+
+var (
+	ErrInvalidState = errors.New("invalid or expired oauth state")
+	ErrInvalidCode  = errors.New("invalid or expired authorization code")
+
+	errNotFound = errors.New("not found")
+)
+
+// LoginState 开始登录时保存, 回调时使用, 只能使用一次
+type LoginState struct {
+	Provider string `json:"provider"`
+	Verifier string `json:"verifier"` // PKCE code verifier
+	Nonce    string `json:"nonce"`
+	// LinkUserID 不为空时, 回调得到的身份需要由这个用户确认绑定, 而不是登录
+	LinkUserID string `json:"link_user_id,omitempty"`
+}
+
+// AuthCode 回调后发给前端的一次性 code, 前端通过 RPC 换取 token 或确认绑定, 避免 token 出现在 URL 中
+type AuthCode struct {
+	// UserID 登录的用户
+	UserID string `json:"user_id,omitempty"`
+	// LinkUserID 和 Identity 用于绑定, 只有 LinkUserID 本人可以使用
+	LinkUserID string    `json:"link_user_id,omitempty"`
+	Identity   *Identity `json:"identity,omitempty"`
+}
+
+// Store 将登录过程中的 state 和 code 保存在 Redis 中
+type Store struct {
+	rdb *redis.Client
+}
+
+func NewStore(rdb *redis.Client) *Store {
+	return &Store{rdb: rdb}
+}
+
+// BeginLogin 生成 state, PKCE verifier 和 nonce 并保存, 返回 state 参数
+func (s *Store) BeginLogin(ctx context.Context, provider, linkUserID string) (string, error) {
+	id, err := randomString()
+	if err != nil {
+		return "", err
+	}
+	nonce, err := randomString()
+	if err != nil {
+		return "", err
+	}
+	return id, s.save(ctx, stateKey(id), &LoginState{
+		Provider:   provider,
+		Verifier:   oauth2.GenerateVerifier(),
+		Nonce:      nonce,
+		LinkUserID: linkUserID,
+	}, StateTTL)
+}
+
+// GetLogin 读取 state 但不删除, 用于跳转到 provider
+func (s *Store) GetLogin(ctx context.Context, id string) (*LoginState, error) {
+	var state LoginState
+	if err := s.load(ctx, stateKey(id), &state, false); err != nil {
+		return nil, notFound(err, ErrInvalidState)
+	}
+	return &state, nil
+}
+
+// TakeLogin 取出并删除 state, 同一个 state 只能回调一次
+func (s *Store) TakeLogin(ctx context.Context, id string) (*LoginState, error) {
+	var state LoginState
+	if err := s.load(ctx, stateKey(id), &state, true); err != nil {
+		return nil, notFound(err, ErrInvalidState)
+	}
+	return &state, nil
+}
+
+// IssueCode 保存回调的结果, 返回一次性 code
+func (s *Store) IssueCode(ctx context.Context, code *AuthCode) (string, error) {
+	id, err := randomString()
+	if err != nil {
+		return "", err
+	}
+	return id, s.save(ctx, codeKey(id), code, codeTTL)
+}
+
+// TakeCode 取出并删除 code, 只能使用一次
+func (s *Store) TakeCode(ctx context.Context, id string) (*AuthCode, error) {
+	var code AuthCode
+	if err := s.load(ctx, codeKey(id), &code, true); err != nil {
+		return nil, notFound(err, ErrInvalidCode)
+	}
+	return &code, nil
+}
+
+func (s *Store) save(ctx context.Context, key string, v any, ttl time.Duration) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return s.rdb.Set(ctx, key, data, ttl).Err()
+}
+
+func (s *Store) load(ctx context.Context, key string, v any, del bool) error {
+	cmd := s.rdb.Get(ctx, key)
+	if del {
+		cmd = s.rdb.GetDel(ctx, key)
+	}
+	data, err := cmd.Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return errNotFound
+		}
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// notFound 不存在或已经过期时返回 target
+func notFound(err, target error) error {
+	if errors.Is(err, errNotFound) {
+		return target
+	}
+	return err
+}
+
+// state 和 code 都只保存 hash, Redis 中的数据不能直接用来完成登录
+func stateKey(id string) string {
+	return "oauth:state:" + hashID(id)
+}
+
+func codeKey(id string) string {
+	return "oauth:code:" + hashID(id)
+}
+
+func hashID(id string) string {
+	sum := sha256.Sum256([]byte(id))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// StateCookieName 将 state 绑定到开始登录的浏览器, 防止 login CSRF
+const StateCookieName = "dnsarc_oauth_state"
+
+// StateCookie 由返回 state 的 RPC 写入, 只在 API 服务的 /auth/<provider>/ 下发送;
+// 前端和 API 在同一个站点下, 跳转到登录地址和从 provider 回调都是顶级导航, SameSite=Lax 可以带上
+func StateCookie(provider Provider, value string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     StateCookieName,
+		Value:    value,
+		Path:     "/auth/" + provider.Name() + "/",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   !strings.HasPrefix(provider.LoginURL(), "http://"),
+		SameSite: http.SameSiteLaxMode,
+	}
+}
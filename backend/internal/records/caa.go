@@ -0,0 +1,114 @@
+package records
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	CAATagIssue     = "issue"
+	CAATagIssueWild = "issuewild"
+	CAATagIODEF     = "iodef"
+
+	// caaFlagCritical issuer critical 标志位 (RFC 8659 4.1)
+	caaFlagCritical = 128
+)
+
+var (
+	caaParamKeyPattern = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+	// validationmethods 的取值, 如 dns-01, http-01, tls-alpn-01 (RFC 8657 4)
+	caaMethodPattern = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+)
+
+// NormalizeCAA 校验 CAA 记录内容 `<flag> <tag> "<value>"`, 返回规范化后的内容
+//
+// 支持的 tag 为 issue, issuewild (RFC 8659) 和 iodef, issue 的参数支持
+// accounturi 和 validationmethods (RFC 8657)
+func NormalizeCAA(content string) (string, error) {
+	rr, err := dns.NewRR(". 0 IN CAA " + content)
+	if err != nil || rr == nil {
+		return "", errors.New(`CAA record must look like: 0 issue "letsencrypt.org"`)
+	}
+	caa := rr.(*dns.CAA)
+	if caa.Flag != 0 && caa.Flag != caaFlagCritical {
+		return "", fmt.Errorf("invalid CAA flag %d, must be 0 or 128", caa.Flag)
+	}
+	caa.Tag = strings.ToLower(caa.Tag)
+	switch caa.Tag {
+	case CAATagIssue, CAATagIssueWild:
+		if err := validateCAAIssue(caa.Value); err != nil {
+			return "", err
+		}
+	case CAATagIODEF:
+		if err := validateCAAIODEF(caa.Value); err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("unsupported CAA tag %q, must be one of issue, issuewild, iodef", caa.Tag)
+	}
+	return strings.TrimPrefix(caa.String(), caa.Hdr.String()), nil
+}
+
+// validateCAAIssue 校验 issue/issuewild 的值: [issuer-domain-name] *(";" key=value)
+//
+// 只有 ";" 时表示不允许任何 CA 签发
+func validateCAAIssue(value string) error {
+	parts := strings.Split(value, ";")
+	issuer := strings.TrimSpace(parts[0])
+	if issuer != "" {
+		if _, ok := dns.IsDomainName(issuer); !ok || strings.Contains(issuer, " ") {
+			return fmt.Errorf("invalid CAA issuer domain %q", issuer)
+		}
+	}
+	for _, param := range parts[1:] {
+		param = strings.TrimSpace(param)
+		if param == "" {
+			continue
+		}
+		key, val, ok := strings.Cut(param, "=")
+		key, val = strings.TrimSpace(key), strings.TrimSpace(val)
+		if !ok || !caaParamKeyPattern.MatchString(key) || val == "" || strings.ContainsAny(val, " \t") {
+			return fmt.Errorf("invalid CAA parameter %q, must be key=value", param)
+		}
+		switch strings.ToLower(key) {
+		case "accounturi":
+			u, err := url.Parse(val)
+			if err != nil || u.Scheme == "" {
+				return fmt.Errorf("invalid CAA accounturi %q", val)
+			}
+		case "validationmethods":
+			for _, method := range strings.Split(val, ",") {
+				if !caaMethodPattern.MatchString(method) {
+					return fmt.Errorf("invalid CAA validation method %q", method)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateCAAIODEF 校验 iodef 的值, 只支持 mailto:, http: 和 https: (RFC 8659 4.4)
+func validateCAAIODEF(value string) error {
+	u, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("invalid CAA iodef URL %q", value)
+	}
+	switch u.Scheme {
+	case "mailto":
+		if !strings.Contains(u.Opaque, "@") {
+			return fmt.Errorf("invalid CAA iodef email %q", value)
+		}
+	case "http", "https":
+		if u.Host == "" {
+			return fmt.Errorf("invalid CAA iodef URL %q", value)
+		}
+	default:
+		return fmt.Errorf("unsupported CAA iodef scheme %q, must be mailto, http or https", u.Scheme)
+	}
+	return nil
+}
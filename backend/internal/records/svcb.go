@@ -0,0 +1,165 @@
+package records
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	"dnsarc/internal/models"
+)
+
+const (
+	TypeSVCB  = "SVCB"
+	TypeHTTPS = "HTTPS"
+)
+
+// IsSVCB 判断记录类型是否使用 SVCB 的格式 (SVCB 和 HTTPS)
+func IsSVCB(recordType string) bool {
+	return recordType == TypeSVCB || recordType == TypeHTTPS
+}
+
+// ParseSVCB 从文本格式解析 SVCB/HTTPS 记录, 如 `1 . alpn="h3,h2" port=443`
+func ParseSVCB(recordType, content string) (*models.SVCBData, error) {
+	rr, err := dns.NewRR(". 0 IN " + recordType + " " + content)
+	if err != nil || rr == nil {
+		return nil, fmt.Errorf(`%s record must look like: 1 . alpn="h3,h2"`, recordType)
+	}
+	var svcb *dns.SVCB
+	switch rr := rr.(type) {
+	case *dns.SVCB:
+		svcb = rr
+	case *dns.HTTPS:
+		svcb = &rr.SVCB
+	}
+	data := &models.SVCBData{
+		Priority: svcb.Priority,
+		Target:   svcb.Target,
+	}
+	for _, kv := range svcb.Value {
+		switch kv := kv.(type) {
+		case *dns.SVCBAlpn:
+			data.ALPN = kv.Alpn
+		case *dns.SVCBNoDefaultAlpn:
+			data.NoDefaultALPN = true
+		case *dns.SVCBPort:
+			data.Port = kv.Port
+		case *dns.SVCBIPv4Hint:
+			for _, ip := range kv.Hint {
+				data.IPv4Hint = append(data.IPv4Hint, ip.String())
+			}
+		case *dns.SVCBIPv6Hint:
+			for _, ip := range kv.Hint {
+				data.IPv6Hint = append(data.IPv6Hint, ip.String())
+			}
+		case *dns.SVCBECHConfig:
+			data.ECH = base64.StdEncoding.EncodeToString(kv.ECH)
+		default:
+			return nil, fmt.Errorf("unsupported SvcParam %q, must be one of alpn, no-default-alpn, port, ipv4hint, ipv6hint, ech", kv.Key().String())
+		}
+	}
+	return data, nil
+}
+
+// NormalizeSVCB 校验 SVCB/HTTPS 记录并返回对应的文本格式, target 会被转换为小写的 FQDN
+func NormalizeSVCB(recordType string, data *models.SVCBData) (string, error) {
+	if data == nil {
+		return "", fmt.Errorf("%s record requires priority and target", recordType)
+	}
+	data.Target = dns.Fqdn(strings.ToLower(strings.TrimSpace(data.Target)))
+	if _, ok := dns.IsDomainName(data.Target); !ok {
+		return "", fmt.Errorf("invalid %s target %q", recordType, data.Target)
+	}
+	rr, err := SVCBRR(".", 0, recordType, data)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(rr.String(), rr.Header().String()), nil
+}
+
+// SVCBRR 根据结构化数据构造 owner 名下的 SVCB/HTTPS 记录
+func SVCBRR(owner string, ttl uint32, recordType string, data *models.SVCBData) (dns.RR, error) {
+	svcb := dns.SVCB{
+		Hdr: dns.RR_Header{
+			Name:   owner,
+			Rrtype: dns.TypeSVCB,
+			Class:  dns.ClassINET,
+			Ttl:    ttl,
+		},
+		Priority: data.Priority,
+		Target:   dns.Fqdn(data.Target),
+	}
+	params, err := svcParams(data)
+	if err != nil {
+		return nil, err
+	}
+	// AliasMode 只是指向另一个名称, 不能带有 SvcParams (RFC 9460 2.4.2)
+	if data.Priority == 0 && len(params) > 0 {
+		return nil, errors.New("AliasMode (priority 0) records must not have SvcParams")
+	}
+	svcb.Value = params
+	switch recordType {
+	case TypeHTTPS:
+		svcb.Hdr.Rrtype = dns.TypeHTTPS
+		return &dns.HTTPS{SVCB: svcb}, nil
+	case TypeSVCB:
+		return &svcb, nil
+	default:
+		return nil, fmt.Errorf("unsupported record type %q", recordType)
+	}
+}
+
+// svcParams 按照 key 的顺序构造 SvcParams
+func svcParams(data *models.SVCBData) ([]dns.SVCBKeyValue, error) {
+	params := make([]dns.SVCBKeyValue, 0)
+	if len(data.ALPN) > 0 {
+		for _, alpn := range data.ALPN {
+			if alpn == "" || len(alpn) > 255 {
+				return nil, fmt.Errorf("invalid alpn %q", alpn)
+			}
+		}
+		params = append(params, &dns.SVCBAlpn{Alpn: data.ALPN})
+	}
+	if data.NoDefaultALPN {
+		if len(data.ALPN) == 0 {
+			return nil, errors.New("no-default-alpn requires alpn")
+		}
+		params = append(params, &dns.SVCBNoDefaultAlpn{})
+	}
+	if data.Port != 0 {
+		params = append(params, &dns.SVCBPort{Port: data.Port})
+	}
+	if len(data.IPv4Hint) > 0 {
+		hint := make([]net.IP, 0, len(data.IPv4Hint))
+		for _, addr := range data.IPv4Hint {
+			ip := net.ParseIP(addr).To4()
+			if ip == nil {
+				return nil, fmt.Errorf("invalid ipv4hint %q", addr)
+			}
+			hint = append(hint, ip)
+		}
+		params = append(params, &dns.SVCBIPv4Hint{Hint: hint})
+	}
+	if data.ECH != "" {
+		ech, err := base64.StdEncoding.DecodeString(data.ECH)
+		if err != nil || len(ech) == 0 {
+			return nil, errors.New("ech must be a base64 encoded ECHConfigList")
+		}
+		params = append(params, &dns.SVCBECHConfig{ECH: ech})
+	}
+	if len(data.IPv6Hint) > 0 {
+		hint := make([]net.IP, 0, len(data.IPv6Hint))
+		for _, addr := range data.IPv6Hint {
+			ip := net.ParseIP(addr)
+			if ip == nil || ip.To4() != nil {
+				return nil, fmt.Errorf("invalid ipv6hint %q", addr)
+			}
+			hint = append(hint, ip)
+		}
+		params = append(params, &dns.SVCBIPv6Hint{Hint: hint})
+	}
+	return params, nil
+}
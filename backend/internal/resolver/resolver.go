@@ -0,0 +1,318 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/samber/lo"
+)
+
+var (
+	ErrNoUpstreams  = errors.New("no upstreams configured")
+	ErrNXDomain     = errors.New("domain does not exist")
+	ErrNotDelegated = errors.New("zone is not delegated")
+)
+
+// DefaultRootServers IANA 根服务器地址, 用于从根开始的迭代查询
+var DefaultRootServers = []string{
+	"198.41.0.4:53",     // a.root-servers.net
+	"170.247.170.2:53",  // b.root-servers.net
+	"192.33.4.12:53",    // c.root-servers.net
+	"199.7.91.13:53",    // d.root-servers.net
+	"192.203.230.10:53", // e.root-servers.net
+	"192.5.5.241:53",    // f.root-servers.net
+	"192.112.36.4:53",   // g.root-servers.net
+	"198.97.190.53:53",  // h.root-servers.net
+	"192.36.148.17:53",  // i.root-servers.net
+	"192.58.128.30:53",  // j.root-servers.net
+	"193.0.14.129:53",   // k.root-servers.net
+	"199.7.83.42:53",    // l.root-servers.net
+	"202.12.27.33:53",   // m.root-servers.net
+}
+
+type Config struct {
+	// Upstreams 递归解析器, 支持 udp://, tcp://, tls:// (DoT), https:// (DoH), 不带 scheme 时使用 udp
+	Upstreams []string
+	// Timeout 单次查询的超时时间
+	Timeout time.Duration
+	// RootServers 迭代查询的起点, 默认使用 IANA 根服务器
+	RootServers []string
+	// MaxReferrals 迭代查询最多跟随的 referral 次数
+	MaxReferrals int
+}
+
+// ParseConfig 从逗号分隔的字符串解析配置, 空值使用默认值
+func ParseConfig(upstreams, timeout, rootServers string) (Config, error) {
+	config := Config{
+		Upstreams:    splitList(upstreams),
+		Timeout:      time.Second * 3,
+		RootServers:  splitList(rootServers),
+		MaxReferrals: 16,
+	}
+	if len(config.Upstreams) == 0 {
+		config.Upstreams = []string{"udp://8.8.8.8:53", "udp://1.1.1.1:53"}
+	}
+	if len(config.RootServers) == 0 {
+		config.RootServers = DefaultRootServers
+	}
+	if timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid resolver timeout %q: %w", timeout, err)
+		}
+		config.Timeout = d
+	}
+	return config, nil
+}
+
+func splitList(s string) []string {
+	return lo.Compact(lo.Map(strings.Split(s, ","), func(item string, _ int) string {
+		return strings.TrimSpace(item)
+	}))
+}
+
+// Resolver 共享的 DNS 解析组件, 用于 zone 检查和 CNAME 展平
+type Resolver struct {
+	config    Config
+	upstreams []upstream
+	// nsPort 迭代查询时 nameserver 的端口, 测试时使用本地端口
+	nsPort string
+}
+
+func New(config Config) (*Resolver, error) {
+	if config.Timeout == 0 {
+		config.Timeout = time.Second * 3
+	}
+	if config.MaxReferrals == 0 {
+		config.MaxReferrals = 16
+	}
+	if len(config.RootServers) == 0 {
+		config.RootServers = DefaultRootServers
+	}
+	upstreams := make([]upstream, 0, len(config.Upstreams))
+	for _, spec := range config.Upstreams {
+		u, err := newUpstream(spec, config.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		upstreams = append(upstreams, u)
+	}
+	return &Resolver{config: config, upstreams: upstreams, nsPort: "53"}, nil
+}
+
+// Exchange 依次向 upstream 发送查询, 返回第一个成功的响应
+func (r *Resolver) Exchange(ctx context.Context, m *dns.Msg) (*dns.Msg, error) {
+	if len(r.upstreams) == 0 {
+		return nil, ErrNoUpstreams
+	}
+	var lastErr error
+	for _, u := range r.upstreams {
+		ctx, cancel := context.WithTimeout(ctx, r.config.Timeout)
+		resp, err := u.exchange(ctx, m)
+		cancel()
+		if err != nil {
+			slog.Warn("upstream exchange failed", "upstream", u.String(), "error", err)
+			lastErr = err
+			continue
+		}
+		// SERVFAIL 时尝试下一个 upstream
+		if resp.Rcode == dns.RcodeServerFailure {
+			lastErr = fmt.Errorf("upstream %s returned SERVFAIL", u.String())
+			continue
+		}
+		return resp, nil
+	}
+	return nil, lastErr
+}
+
+// Lookup 通过 upstream 递归查询 name 的 qtype 记录
+func (r *Resolver) Lookup(ctx context.Context, name string, qtype uint16) (*dns.Msg, error) {
+	m := new(dns.Msg)
+	m.SetQuestion(dns.Fqdn(name), qtype)
+	m.SetEdns0(dns.DefaultMsgSize, false)
+	return r.Exchange(ctx, m)
+}
+
+// LookupTXT 查询 name 的 TXT 记录, 多段字符串会被拼接
+func (r *Resolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	resp, err := r.Lookup(ctx, name, dns.TypeTXT)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Rcode == dns.RcodeNameError {
+		return nil, ErrNXDomain
+	}
+	if resp.Rcode != dns.RcodeSuccess {
+		return nil, fmt.Errorf("lookup %s TXT: %s", name, dns.RcodeToString[resp.Rcode])
+	}
+	txts := make([]string, 0)
+	for _, answer := range resp.Answer {
+		if txt, ok := answer.(*dns.TXT); ok {
+			txts = append(txts, strings.Join(txt.Txt, ""))
+		}
+	}
+	return txts, nil
+}
+
+// LookupHost 查询 name 的 A 和 AAAA 记录
+func (r *Resolver) LookupHost(ctx context.Context, name string) ([]string, error) {
+	addrs := make([]string, 0)
+	for _, qtype := range []uint16{dns.TypeA, dns.TypeAAAA} {
+		resp, err := r.Lookup(ctx, name, qtype)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Rcode == dns.RcodeNameError {
+			return nil, ErrNXDomain
+		}
+		for _, answer := range resp.Answer {
+			switch rr := answer.(type) {
+			case *dns.A:
+				addrs = append(addrs, rr.A.String())
+			case *dns.AAAA:
+				addrs = append(addrs, rr.AAAA.String())
+			}
+		}
+	}
+	return addrs, nil
+}
+
+// LookupDelegation 从根开始迭代查询, 返回父 zone 委派给 zone 的 NS 列表 (小写, 不带末尾的点)
+//
+// 与递归查询不同, 这里看到的是注册商实际配置的委派, 不受递归解析器缓存影响
+func (r *Resolver) LookupDelegation(ctx context.Context, zone string) ([]string, error) {
+	zone = dns.Fqdn(strings.ToLower(zone))
+	servers := r.config.RootServers
+	for range r.config.MaxReferrals {
+		m := new(dns.Msg)
+		m.SetQuestion(zone, dns.TypeNS)
+		m.RecursionDesired = false
+		resp, err := r.exchangeAny(ctx, m, servers)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Rcode == dns.RcodeNameError {
+			return nil, ErrNXDomain
+		}
+		if resp.Rcode != dns.RcodeSuccess {
+			return nil, fmt.Errorf("lookup %s NS: %s", zone, dns.RcodeToString[resp.Rcode])
+		}
+		// 父 zone 和子 zone 在同一组服务器上时, 会直接得到权威应答
+		if resp.Authoritative {
+			ns := nsHosts(resp.Answer, zone)
+			if len(ns) == 0 {
+				return nil, ErrNotDelegated
+			}
+			return ns, nil
+		}
+		owner, ns := referral(resp.Ns)
+		if len(ns) == 0 || !dns.IsSubDomain(owner, zone) {
+			return nil, ErrNotDelegated
+		}
+		if owner == zone {
+			return ns, nil
+		}
+		// 继续向下一级查询, 优先使用 glue
+		servers = r.referralServers(ctx, ns, resp.Extra)
+		if len(servers) == 0 {
+			return nil, fmt.Errorf("no reachable nameservers for %s", owner)
+		}
+	}
+	return nil, fmt.Errorf("too many referrals while resolving %s", zone)
+}
+
+// exchangeAny 向 servers 依次发送非递归查询, UDP 截断时使用 TCP 重试
+func (r *Resolver) exchangeAny(ctx context.Context, m *dns.Msg, servers []string) (*dns.Msg, error) {
+	udp := &dns.Client{Net: "udp", Timeout: r.config.Timeout}
+	tcp := &dns.Client{Net: "tcp", Timeout: r.config.Timeout}
+	var lastErr error
+	for _, server := range servers {
+		resp, _, err := udp.ExchangeContext(ctx, m, server)
+		if err == nil && resp.Truncated {
+			resp, _, err = tcp.ExchangeContext(ctx, m, server)
+		}
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if resp.Rcode == dns.RcodeServerFailure || resp.Rcode == dns.RcodeRefused {
+			lastErr = fmt.Errorf("server %s returned %s", server, dns.RcodeToString[resp.Rcode])
+			continue
+		}
+		return resp, nil
+	}
+	if lastErr == nil {
+		lastErr = errors.New("no servers to query")
+	}
+	return nil, lastErr
+}
+
+// referralServers 返回 referral 中 NS 的地址, 优先使用 glue
+//
+// 只有 AAAA glue 的委派也直接使用 glue, IPv4 地址排在前面, 没有 IPv6 连接时不用等待超时
+func (r *Resolver) referralServers(ctx context.Context, ns []string, extra []dns.RR) []string {
+	servers := make([]string, 0)
+	ipv6 := make([]string, 0)
+	for _, rr := range extra {
+		if !lo.Contains(ns, strings.TrimSuffix(strings.ToLower(rr.Header().Name), ".")) {
+			continue
+		}
+		switch glue := rr.(type) {
+		case *dns.A:
+			servers = append(servers, net.JoinHostPort(glue.A.String(), r.nsPort))
+		case *dns.AAAA:
+			ipv6 = append(ipv6, net.JoinHostPort(glue.AAAA.String(), r.nsPort))
+		}
+	}
+	if servers = append(servers, ipv6...); len(servers) > 0 {
+		return servers
+	}
+	// 没有 glue 时通过 upstream 解析 NS 的地址
+	for _, host := range ns {
+		addrs, err := r.LookupHost(ctx, host)
+		if err != nil {
+			slog.Warn("failed to resolve nameserver", "ns", host, "error", err)
+			continue
+		}
+		for _, addr := range addrs {
+			servers = append(servers, net.JoinHostPort(addr, r.nsPort))
+		}
+	}
+	return servers
+}
+
+// referral 从 authority section 中提取 referral 的 owner 和 NS 列表
+func referral(authority []dns.RR) (string, []string) {
+	owner := ""
+	ns := make([]string, 0)
+	for _, rr := range authority {
+		record, ok := rr.(*dns.NS)
+		if !ok {
+			continue
+		}
+		name := strings.ToLower(record.Hdr.Name)
+		if owner == "" {
+			owner = name
+		}
+		if name == owner {
+			ns = append(ns, strings.TrimSuffix(strings.ToLower(record.Ns), "."))
+		}
+	}
+	return owner, ns
+}
+
+func nsHosts(answer []dns.RR, zone string) []string {
+	ns := make([]string, 0)
+	for _, rr := range answer {
+		if record, ok := rr.(*dns.NS); ok && strings.EqualFold(record.Hdr.Name, zone) {
+			ns = append(ns, strings.TrimSuffix(strings.ToLower(record.Ns), "."))
+		}
+	}
+	return ns
+}
@@ -0,0 +1,267 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// testNameserver 测试用的 nameserver, 根据 question 返回委派, 权威应答或地址
+type testNameserver struct {
+	// delegations 子 zone 委派的 NS
+	delegations map[string][]string
+	// authoritative 权威应答的 NS, 父 zone 和子 zone 在同一组服务器上
+	authoritative map[string][]string
+	// hosts A 和 AAAA 记录, 同时作为委派的 glue
+	hosts map[string][]string
+	// glue 为 false 时委派不带 glue
+	glue bool
+	// truncate UDP 应答总是截断
+	truncate bool
+}
+
+func (s *testNameserver) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
+	m := new(dns.Msg)
+	m.SetReply(r)
+	q := r.Question[0]
+	name := strings.ToLower(q.Name)
+	if _, udp := w.RemoteAddr().(*net.UDPAddr); udp && s.truncate {
+		m.Truncated = true
+		_ = w.WriteMsg(m)
+		return
+	}
+	if ns, ok := s.authoritative[name]; ok && q.Qtype == dns.TypeNS {
+		m.Authoritative = true
+		for _, host := range ns {
+			m.Answer = append(m.Answer, &dns.NS{Hdr: header(name, dns.TypeNS), Ns: host})
+		}
+		_ = w.WriteMsg(m)
+		return
+	}
+	if addrs, ok := s.hosts[name]; ok && (q.Qtype == dns.TypeA || q.Qtype == dns.TypeAAAA) {
+		m.Answer = addressRRs(name, addrs, q.Qtype)
+		_ = w.WriteMsg(m)
+		return
+	}
+	for zone, ns := range s.delegations {
+		if !dns.IsSubDomain(zone, name) {
+			continue
+		}
+		for _, host := range ns {
+			m.Ns = append(m.Ns, &dns.NS{Hdr: header(zone, dns.TypeNS), Ns: host})
+			if s.glue {
+				m.Extra = append(m.Extra, addressRRs(host, s.hosts[host], dns.TypeA)...)
+				m.Extra = append(m.Extra, addressRRs(host, s.hosts[host], dns.TypeAAAA)...)
+			}
+		}
+		_ = w.WriteMsg(m)
+		return
+	}
+	m.Rcode = dns.RcodeNameError
+	_ = w.WriteMsg(m)
+}
+
+func header(name string, rrtype uint16) dns.RR_Header {
+	return dns.RR_Header{Name: name, Rrtype: rrtype, Class: dns.ClassINET, Ttl: 300}
+}
+
+func addressRRs(name string, addrs []string, qtype uint16) []dns.RR {
+	rrs := make([]dns.RR, 0)
+	for _, addr := range addrs {
+		ip := net.ParseIP(addr)
+		switch {
+		case ip.To4() != nil && qtype == dns.TypeA:
+			rrs = append(rrs, &dns.A{Hdr: header(name, dns.TypeA), A: ip})
+		case ip.To4() == nil && qtype == dns.TypeAAAA:
+			rrs = append(rrs, &dns.AAAA{Hdr: header(name, dns.TypeAAAA), AAAA: ip})
+		}
+	}
+	return rrs
+}
+
+// serve 在 addr 上启动 UDP 和 TCP 服务, 返回实际监听的地址
+func serve(t *testing.T, addr string, handler dns.Handler) string {
+	t.Helper()
+	pc, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := net.Listen("tcp", pc.LocalAddr().String())
+	if err != nil {
+		_ = pc.Close()
+		t.Fatal(err)
+	}
+	for _, server := range []*dns.Server{
+		{PacketConn: pc, Handler: handler},
+		{Listener: l, Handler: handler},
+	} {
+		started := make(chan struct{})
+		server.NotifyStartedFunc = func() { close(started) }
+		go func() {
+			_ = server.ActivateAndServe()
+		}()
+		<-started
+		t.Cleanup(func() {
+			_ = server.Shutdown()
+		})
+	}
+	return pc.LocalAddr().String()
+}
+
+func TestLookupDelegation(t *testing.T) {
+	// 所有 nameserver 使用同一个端口, glue 中只有地址
+	rootAddr := serve(t, "127.0.0.1:0", &testNameserver{
+		delegations: map[string][]string{
+			"com.": {"a.gtld.test."},
+			"net.": {"v6.gtld.test."},
+			"org.": {"noglue.gtld.test."},
+		},
+		hosts: map[string][]string{
+			"a.gtld.test.":  {"127.0.0.2"},
+			"v6.gtld.test.": {"::1"},
+		},
+		glue: true,
+	})
+	_, port, _ := net.SplitHostPort(rootAddr)
+	serve(t, "127.0.0.2:"+port, &testNameserver{
+		delegations: map[string][]string{
+			"example.com.": {"ns1.example.com.", "ns2.example.com."},
+		},
+		authoritative: map[string][]string{
+			"shared.com.": {"NS.Shared.COM."},
+		},
+		hosts: map[string][]string{
+			// ns1 没有在监听, 查询失败后使用 ns2
+			"ns1.example.com.": {"127.0.0.6"},
+			"ns2.example.com.": {"127.0.0.5"},
+		},
+		glue: true,
+	})
+	serve(t, "127.0.0.5:"+port, &testNameserver{
+		delegations: map[string][]string{
+			"sub.example.com.": {"ns.sub-host.test."},
+		},
+	})
+	serve(t, "127.0.0.3:"+port, &testNameserver{
+		delegations: map[string][]string{
+			"example.org.": {"ns1.org-host.test.", "ns2.org-host.test."},
+		},
+	})
+	// 没有 glue 时通过 upstream 解析 NS 的地址
+	upstreamAddr := serve(t, "127.0.0.4:"+port, &testNameserver{
+		hosts: map[string][]string{
+			"noglue.gtld.test.": {"127.0.0.3"},
+		},
+	})
+	ipv6 := true
+	if pc, err := net.ListenPacket("udp", net.JoinHostPort("::1", port)); err != nil {
+		ipv6 = false
+	} else {
+		_ = pc.Close()
+		serve(t, net.JoinHostPort("::1", port), &testNameserver{
+			delegations: map[string][]string{
+				"example.net.": {"ns.example.net."},
+			},
+		})
+	}
+
+	r, err := New(Config{
+		Upstreams:   []string{"udp://" + upstreamAddr},
+		Timeout:     time.Second,
+		RootServers: []string{rootAddr},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.nsPort = port
+
+	tests := []struct {
+		name string
+		zone string
+		want []string
+		err  error
+		ipv6 bool
+	}{
+		{"referral with glue", "Example.COM", []string{"ns1.example.com", "ns2.example.com"}, nil, false},
+		{"glue fallback after unreachable server", "sub.example.com.", []string{"ns.sub-host.test"}, nil, false},
+		{"ipv6 only glue", "example.net", []string{"ns.example.net"}, nil, true},
+		{"nameserver without glue", "example.org", []string{"ns1.org-host.test", "ns2.org-host.test"}, nil, false},
+		{"authoritative answer from parent", "shared.com", []string{"ns.shared.com"}, nil, false},
+		{"nxdomain", "missing.com", nil, ErrNXDomain, false},
+		{"tld without delegation", "example.invalid", nil, ErrNXDomain, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.ipv6 && !ipv6 {
+				t.Skip("no IPv6 loopback")
+			}
+			ns, err := r.LookupDelegation(context.Background(), tt.zone)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("err = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(ns, tt.want) {
+				t.Errorf("ns = %v, want %v", ns, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookupDelegationNotDelegated(t *testing.T) {
+	rootAddr := serve(t, "127.0.0.1:0", &testNameserver{
+		// 父 zone 的权威应答中没有 NS
+		authoritative: map[string][]string{"lame.com.": nil},
+	})
+	r, err := New(Config{Timeout: time.Second, RootServers: []string{rootAddr}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.LookupDelegation(context.Background(), "lame.com"); !errors.Is(err, ErrNotDelegated) {
+		t.Errorf("err = %v, want %v", err, ErrNotDelegated)
+	}
+}
+
+func TestLookupDelegationTruncated(t *testing.T) {
+	rootAddr := serve(t, "127.0.0.1:0", &testNameserver{
+		authoritative: map[string][]string{"example.com.": {"ns1.example.com."}},
+		truncate:      true,
+	})
+	r, err := New(Config{Timeout: time.Second, RootServers: []string{rootAddr}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ns, err := r.LookupDelegation(context.Background(), "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(ns, []string{"ns1.example.com"}) {
+		t.Errorf("ns = %v, want the TCP answer", ns)
+	}
+}
+
+func TestReferralServers(t *testing.T) {
+	r := &Resolver{nsPort: "53"}
+	extra := []dns.RR{
+		&dns.AAAA{Hdr: header("NS1.example.com.", dns.TypeAAAA), AAAA: net.ParseIP("2001:db8::1")},
+		&dns.A{Hdr: header("ns1.example.com.", dns.TypeA), A: net.ParseIP("192.0.2.1")},
+		// 不属于委派的 NS 的地址会被忽略
+		&dns.A{Hdr: header("other.example.org.", dns.TypeA), A: net.ParseIP("192.0.2.9")},
+		&dns.AAAA{Hdr: header("ns2.example.com.", dns.TypeAAAA), AAAA: net.ParseIP("2001:db8::2")},
+	}
+	got := r.referralServers(context.Background(), []string{"ns1.example.com", "ns2.example.com"}, extra)
+	want := []string{"192.0.2.1:53", "[2001:db8::1]:53", "[2001:db8::2]:53"}
+	if !slices.Equal(got, want) {
+		t.Errorf("servers = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,150 @@
+package resolver
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+type upstream interface {
+	exchange(ctx context.Context, m *dns.Msg) (*dns.Msg, error)
+	String() string
+}
+
+// newUpstream 解析 upstream 配置
+//
+//	8.8.8.8:53 / udp://8.8.8.8:53  UDP, 截断时使用 TCP 重试
+//	tcp://8.8.8.8:53               TCP
+//	tls://1.1.1.1:853?sni=one.one.one.one  DNS over TLS, 默认使用 host 作为 SNI
+//	https://dns.google/dns-query   DNS over HTTPS (RFC 8484)
+func newUpstream(spec string, timeout time.Duration) (upstream, error) {
+	if !strings.Contains(spec, "://") {
+		spec = "udp://" + spec
+	}
+	u, err := url.Parse(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid upstream %q: %w", spec, err)
+	}
+	switch u.Scheme {
+	case "udp", "tcp":
+		addr := withDefaultPort(u.Host, "53")
+		return &dnsUpstream{
+			spec: spec,
+			addr: addr,
+			client: &dns.Client{
+				Net:     u.Scheme,
+				Timeout: timeout,
+			},
+			tcp: &dns.Client{
+				Net:     "tcp",
+				Timeout: timeout,
+			},
+		}, nil
+	case "tls":
+		serverName := u.Query().Get("sni")
+		if serverName == "" {
+			serverName = u.Hostname()
+		}
+		client := &dns.Client{
+			Net:       "tcp-tls",
+			Timeout:   timeout,
+			TLSConfig: &tls.Config{ServerName: serverName, MinVersion: tls.VersionTLS12},
+		}
+		return &dnsUpstream{
+			spec:   spec,
+			addr:   withDefaultPort(u.Host, "853"),
+			client: client,
+			tcp:    client,
+		}, nil
+	case "https":
+		return &dohUpstream{
+			url:    spec,
+			client: &http.Client{Timeout: timeout},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported upstream scheme %q", u.Scheme)
+	}
+}
+
+func withDefaultPort(host, port string) string {
+	if _, _, err := net.SplitHostPort(host); err == nil {
+		return host
+	}
+	return net.JoinHostPort(strings.Trim(host, "[]"), port)
+}
+
+type dnsUpstream struct {
+	spec   string
+	addr   string
+	client *dns.Client
+	tcp    *dns.Client
+}
+
+func (u *dnsUpstream) exchange(ctx context.Context, m *dns.Msg) (*dns.Msg, error) {
+	resp, _, err := u.client.ExchangeContext(ctx, m, u.addr)
+	if err == nil && resp.Truncated && u.client.Net == "udp" {
+		resp, _, err = u.tcp.ExchangeContext(ctx, m, u.addr)
+	}
+	return resp, err
+}
+
+func (u *dnsUpstream) String() string {
+	return u.spec
+}
+
+type dohUpstream struct {
+	url    string
+	client *http.Client
+}
+
+func (u *dohUpstream) exchange(ctx context.Context, m *dns.Msg) (*dns.Msg, error) {
+	// RFC 8484 建议使用 ID 0 以便缓存
+	query := m.Copy()
+	query.Id = 0
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.url, bytes.NewReader(packed))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/dns-message")
+	req.Header.Set("Accept", "application/dns-message")
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			slog.Error("failed to close response body", "error", err)
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("doh upstream returned status %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, dns.MaxMsgSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := new(dns.Msg)
+	if err := reply.Unpack(body); err != nil {
+		return nil, err
+	}
+	reply.Id = m.Id
+	return reply, nil
+}
+
+func (u *dohUpstream) String() string {
+	return u.url
+}
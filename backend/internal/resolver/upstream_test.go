@@ -0,0 +1,138 @@
+package resolver
+
+import (
+	"context"
+	"net"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// transportEcho 用 TXT 返回收到查询的传输协议, big. 开头的名称在 UDP 上总是截断
+type transportEcho struct {
+	rcode int
+}
+
+func (s transportEcho) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
+	m := new(dns.Msg)
+	m.SetReply(r)
+	m.Rcode = s.rcode
+	q := r.Question[0]
+	transport := "tcp"
+	if _, udp := w.RemoteAddr().(*net.UDPAddr); udp {
+		transport = "udp"
+	}
+	if transport == "udp" && strings.HasPrefix(q.Name, "big.") {
+		m.Truncated = true
+	} else if s.rcode == dns.RcodeSuccess && q.Qtype == dns.TypeTXT {
+		m.Answer = append(m.Answer, &dns.TXT{Hdr: header(q.Name, dns.TypeTXT), Txt: []string{transport, "-answer"}})
+	}
+	_ = w.WriteMsg(m)
+}
+
+func TestNewUpstream(t *testing.T) {
+	tests := []struct {
+		spec string
+		want string // dnsUpstream 的 net 和地址, 或 DoH 的 URL
+		err  bool
+	}{
+		{"8.8.8.8", "udp 8.8.8.8:53", false},
+		{"8.8.8.8:5353", "udp 8.8.8.8:5353", false},
+		{"udp://1.1.1.1", "udp 1.1.1.1:53", false},
+		{"tcp://[2001:db8::1]", "tcp [2001:db8::1]:53", false},
+		{"tls://1.1.1.1?sni=one.one.one.one", "tcp-tls 1.1.1.1:853", false},
+		{"https://dns.google/dns-query", "https://dns.google/dns-query", false},
+		{"quic://dns.example", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			u, err := newUpstream(tt.spec, time.Second)
+			if tt.err {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := u.String()
+			if u, ok := u.(*dnsUpstream); ok {
+				got = u.client.Net + " " + u.addr
+			}
+			if got != tt.want {
+				t.Errorf("upstream = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	u, _ := newUpstream("tls://1.1.1.1?sni=one.one.one.one", time.Second)
+	if sni := u.(*dnsUpstream).client.TLSConfig.ServerName; sni != "one.one.one.one" {
+		t.Errorf("sni = %q", sni)
+	}
+}
+
+func TestUpstreamTransports(t *testing.T) {
+	addr := serve(t, "127.0.0.1:0", transportEcho{})
+	tests := []struct {
+		name     string
+		upstream string
+		qname    string
+		want     string
+	}{
+		{"udp", "udp://" + addr, "www.example.com", "udp-answer"},
+		{"udp without scheme", addr, "www.example.com", "udp-answer"},
+		{"udp truncated retries over tcp", "udp://" + addr, "big.example.com", "tcp-answer"},
+		{"tcp", "tcp://" + addr, "www.example.com", "tcp-answer"},
+		{"tcp large answer", "tcp://" + addr, "big.example.com", "tcp-answer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := New(Config{Upstreams: []string{tt.upstream}, Timeout: time.Second})
+			if err != nil {
+				t.Fatal(err)
+			}
+			txts, err := r.LookupTXT(context.Background(), tt.qname)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// 多段字符串会被拼接
+			if !slices.Equal(txts, []string{tt.want}) {
+				t.Errorf("txt = %v, want %v", txts, []string{tt.want})
+			}
+		})
+	}
+}
+
+func TestExchangeFallsBackOnServfail(t *testing.T) {
+	servfail := serve(t, "127.0.0.1:0", transportEcho{rcode: dns.RcodeServerFailure})
+	ok := serve(t, "127.0.0.1:0", transportEcho{})
+	r, err := New(Config{Upstreams: []string{servfail, "tcp://" + ok}, Timeout: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	txts, err := r.LookupTXT(context.Background(), "www.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(txts, []string{"tcp-answer"}) {
+		t.Errorf("txt = %v, want the second upstream's answer", txts)
+	}
+
+	r, err = New(Config{Upstreams: []string{servfail}, Timeout: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.LookupTXT(context.Background(), "www.example.com"); err == nil {
+		t.Error("expected an error when every upstream returns SERVFAIL")
+	}
+}
+
+func TestExchangeWithoutUpstreams(t *testing.T) {
+	r := &Resolver{}
+	if _, err := r.Lookup(context.Background(), "example.com", dns.TypeA); err != ErrNoUpstreams {
+		t.Errorf("err = %v, want %v", err, ErrNoUpstreams)
+	}
+}
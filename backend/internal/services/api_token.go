@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+
+	"dnsarc/internal/models"
+)
+
+// apiTokenTouchInterval last_used_at 的更新间隔, 避免每个请求都写数据库
+const apiTokenTouchInterval = time.Minute
+
+var ErrInvalidAPIToken = errors.New("invalid api token")
+
+type APITokenService struct {
+	db *gorm.DB
+}
+
+func NewAPITokenService(db *gorm.DB) *APITokenService {
+	return &APITokenService{db: db}
+}
+
+// GenerateToken 生成新的 token, 返回明文 token, 用于展示的前缀和保存到数据库的 hash
+func (s *APITokenService) GenerateToken() (token, prefix, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", "", err
+	}
+	token = models.APITokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return token, token[:len(models.APITokenPrefix)+6], hashToken(token), nil
+}
+
+// hashToken token 是随机生成的, 直接使用 sha256 即可
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyToken 查找 token 并检查是否过期, 同时记录最近使用时间
+func (s *APITokenService) VerifyToken(ctx context.Context, token string) (*models.APIToken, error) {
+	var apiToken models.APIToken
+	if err := s.db.WithContext(ctx).Where("token_hash = ?", hashToken(token)).First(&apiToken).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidAPIToken
+		}
+		return nil, err
+	}
+	now := time.Now()
+	if apiToken.Expired(now) {
+		return nil, ErrInvalidAPIToken
+	}
+	if apiToken.LastUsedAt == nil || now.Sub(*apiToken.LastUsedAt) > apiTokenTouchInterval {
+		go func() {
+			if err := s.db.Model(&models.APIToken{}).Where("id = ?", apiToken.ID).Update("last_used_at", now).Error; err != nil {
+				slog.Error("failed to update api token last used time", "token_id", apiToken.ID, "error", err)
+			}
+		}()
+	}
+	return &apiToken, nil
+}
@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"dnsarc/internal/models"
+)
+
+var (
+	ErrOrganizationNotFound = errors.New("organization not found")
+	ErrZoneNotFound         = errors.New("zone not found")
+	ErrRecordNotFound       = errors.New("record not found")
+	ErrRoleForbidden        = errors.New("your role in this organization does not allow this action")
+	ErrRecordNotGranted     = errors.New("your access grants in this zone do not cover this record")
+	ErrZoneRestricted       = errors.New("access grants limit you to specific records in this zone")
+)
+
+// Permission 组织内的操作
+type Permission string
+
+const (
+	PermissionViewZones          Permission = "zones:view"          // 查看 zone, 记录, 检查历史和统计
+	PermissionEditRecords        Permission = "records:edit"        // 添加修改删除记录, 检查 zone
+	PermissionManageZones        Permission = "zones:manage"        // 添加删除 zone, 修改 NS, 验证和接管
+	PermissionManageMembers      Permission = "members:manage"      // 邀请和移除成员
+	PermissionManageOrganization Permission = "organization:manage" // 重命名和删除组织
+)
+
+// permissionRoles 每个操作需要的最低角色
+var permissionRoles = map[Permission]models.OrgRole{
+	PermissionViewZones:          models.OrgRoleViewer,
+	PermissionEditRecords:        models.OrgRoleEditor,
+	PermissionManageZones:        models.OrgRoleAdmin,
+	PermissionManageMembers:      models.OrgRoleAdmin,
+	PermissionManageOrganization: models.OrgRoleOwner,
+}
+
+// Authorizer 所有 zone 和记录的访问都通过组织成员角色检查
+//
+// 不是成员时返回 not found, 不暴露其他组织的 zone 是否存在; 是成员但角色不够时返回 ErrRoleForbidden
+type Authorizer struct {
+	db *gorm.DB
+}
+
+func NewAuthorizer(db *gorm.DB) *Authorizer {
+	return &Authorizer{db: db}
+}
+
+// Role 返回用户在组织中的角色
+func (a *Authorizer) Role(ctx context.Context, userID, organizationID string) (models.OrgRole, error) {
+	var member models.OrganizationMember
+	if err := a.db.WithContext(ctx).Where("organization_id = ? AND user_id = ?", organizationID, userID).First(&member).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", ErrOrganizationNotFound
+		}
+		return "", err
+	}
+	return member.Role, nil
+}
+
+// Authorize 检查用户在组织中是否可以执行操作, 返回用户的角色
+func (a *Authorizer) Authorize(ctx context.Context, userID, organizationID string, permission Permission) (models.OrgRole, error) {
+	role, err := a.Role(ctx, userID, organizationID)
+	if err != nil {
+		return "", err
+	}
+	min, ok := permissionRoles[permission]
+	if !ok || !role.AtLeast(min) {
+		return role, ErrRoleForbidden
+	}
+	return role, nil
+}
+
+// Zone 返回用户可以执行操作的 zone
+func (a *Authorizer) Zone(ctx context.Context, userID, zoneID string, permission Permission) (*models.Zone, error) {
+	var zone models.Zone
+	if err := a.db.WithContext(ctx).Where("id = ? AND organization_id IN (?)", zoneID, a.memberOrganizations(userID)).First(&zone).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrZoneNotFound
+		}
+		return nil, err
+	}
+	if _, err := a.Authorize(ctx, userID, zone.OrganizationID, permission); err != nil {
+		return nil, err
+	}
+	return &zone, nil
+}
+
+// ZoneByName 按名称查找 zone, organizationID 为空时在用户的所有组织中查找, 多个组织有同名 zone 时优先 active 的
+func (a *Authorizer) ZoneByName(ctx context.Context, userID, organizationID, zoneName string, permission Permission) (*models.Zone, error) {
+	query := a.db.WithContext(ctx).Where("zone_name = ? AND organization_id IN (?)", zoneName, a.memberOrganizations(userID))
+	if organizationID != "" {
+		query = query.Where("organization_id = ?", organizationID)
+	}
+	var zone models.Zone
+	if err := query.Order("is_active DESC, created_at").First(&zone).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrZoneNotFound
+		}
+		return nil, err
+	}
+	if _, err := a.Authorize(ctx, userID, zone.OrganizationID, permission); err != nil {
+		return nil, err
+	}
+	return &zone, nil
+}
+
+// Record 返回用户可以执行操作的记录, 权限由记录所在的 zone 决定
+func (a *Authorizer) Record(ctx context.Context, userID, recordID string, permission Permission) (*models.DNSRecord, error) {
+	var record models.DNSRecord
+	if err := a.db.WithContext(ctx).Where("id = ?", recordID).First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, err
+	}
+	if _, err := a.Zone(ctx, userID, record.ZoneID, permission); err != nil {
+		if errors.Is(err, ErrZoneNotFound) {
+			return nil, ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return &record, nil
+}
+
+// Zones 返回用户可以查看的所有 zone, organizationID 不为空时只返回该组织的
+func (a *Authorizer) Zones(ctx context.Context, userID, organizationID string) ([]models.Zone, error) {
+	query := a.db.WithContext(ctx).Where("organization_id IN (?)", a.memberOrganizations(userID))
+	if organizationID != "" {
+		query = query.Where("organization_id = ?", organizationID)
+	}
+	var zones []models.Zone
+	if err := query.Order("zone_name").Find(&zones).Error; err != nil {
+		return nil, err
+	}
+	return zones, nil
+}
+
+// Principal 发起请求的对象, 使用 API token 时同时受用户和 token 的 grant 限制
+type Principal struct {
+	UserID     string
+	APITokenID string // 使用 JWT 时为空
+}
+
+// AuthorizeRecordChange 检查 grant 是否允许修改 zone 中 name 上 recordType 类型的记录
+//
+// 用户和 token 分别检查, 有 grant 的一方至少要有一个 grant 匹配
+func (a *Authorizer) AuthorizeRecordChange(ctx context.Context, principal Principal, zoneID, name, recordType string) error {
+	grants, err := a.grants(ctx, principal, zoneID)
+	if err != nil {
+		return err
+	}
+	restricted := map[models.PrincipalType]bool{}
+	allowed := map[models.PrincipalType]bool{}
+	for _, grant := range grants {
+		restricted[grant.PrincipalType] = true
+		if grant.Matches(name, recordType) {
+			allowed[grant.PrincipalType] = true
+		}
+	}
+	for principalType := range restricted {
+		if !allowed[principalType] {
+			return ErrRecordNotGranted
+		}
+	}
+	return nil
+}
+
+// Restricted 检查对象在 zone 中是否受 grant 限制, 受限制的对象不能管理 zone 本身, 否则可以通过删除 zone 绕过 grant
+func (a *Authorizer) Restricted(ctx context.Context, principal Principal, zoneID string) (bool, error) {
+	grants, err := a.grants(ctx, principal, zoneID)
+	if err != nil {
+		return false, err
+	}
+	return len(grants) > 0, nil
+}
+
+func (a *Authorizer) grants(ctx context.Context, principal Principal, zoneID string) ([]models.RecordGrant, error) {
+	principals := a.db.Where("principal_type = ? AND principal_id = ?", models.PrincipalTypeUser, principal.UserID)
+	if principal.APITokenID != "" {
+		principals = principals.Or("principal_type = ? AND principal_id = ?", models.PrincipalTypeAPIToken, principal.APITokenID)
+	}
+	var grants []models.RecordGrant
+	if err := a.db.WithContext(ctx).Where("zone_id = ?", zoneID).Where(principals).Find(&grants).Error; err != nil {
+		return nil, err
+	}
+	return grants, nil
+}
+
+// memberOrganizations 用户所在组织的子查询, 所有角色都可以查看
+func (a *Authorizer) memberOrganizations(userID string) *gorm.DB {
+	return a.db.Model(&models.OrganizationMember{}).Select("organization_id").Where("user_id = ?", userID)
+}
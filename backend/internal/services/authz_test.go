@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"dnsarc/internal/database/dbtest"
+	"dnsarc/internal/models"
+)
+
+// memberScope 所有 zone 查询都要限制在用户所在的组织中
+const memberScope = `organization_id IN (SELECT "organization_id" FROM "organization_members" WHERE user_id = 'user-a')`
+
+func newTestAuthorizer(t *testing.T, role models.OrgRole) (*Authorizer, *dbtest.DB) {
+	t.Helper()
+	db := dbtest.New(t)
+	db.Set("organization_members", []models.OrganizationMember{{OrganizationID: "org-a", UserID: "user-a", Role: role}})
+	db.Set("zones", []models.Zone{{ID: "zone-a", OrganizationID: "org-a", ZoneName: "example.com"}})
+	db.Set("dns_records", []models.DNSRecord{{ID: "record-a", ZoneID: "zone-a", Name: "www.example.com", Type: "A"}})
+	return NewAuthorizer(db.DB), db
+}
+
+func TestAuthorizerRolePermissions(t *testing.T) {
+	all := []Permission{PermissionViewZones, PermissionEditRecords, PermissionManageZones, PermissionManageMembers, PermissionManageOrganization}
+	allowed := map[models.OrgRole][]Permission{
+		models.OrgRoleViewer: {PermissionViewZones},
+		models.OrgRoleEditor: {PermissionViewZones, PermissionEditRecords},
+		models.OrgRoleAdmin:  {PermissionViewZones, PermissionEditRecords, PermissionManageZones, PermissionManageMembers},
+		models.OrgRoleOwner:  all,
+	}
+	ctx := context.Background()
+	for role, permissions := range allowed {
+		for _, permission := range all {
+			a, _ := newTestAuthorizer(t, role)
+			want := slices.Contains(permissions, permission)
+			zone, err := a.Zone(ctx, "user-a", "zone-a", permission)
+			switch {
+			case want && (err != nil || zone.ID != "zone-a"):
+				t.Errorf("%s %s: Zone() = %v, %v, want zone-a", role, permission, zone, err)
+			case !want && !errors.Is(err, ErrRoleForbidden):
+				t.Errorf("%s %s: err = %v, want %v", role, permission, err, ErrRoleForbidden)
+			}
+		}
+	}
+}
+
+func TestAuthorizerViewerCannotEditRecord(t *testing.T) {
+	a, _ := newTestAuthorizer(t, models.OrgRoleViewer)
+	ctx := context.Background()
+	if _, err := a.Record(ctx, "user-a", "record-a", PermissionViewZones); err != nil {
+		t.Fatalf("viewer cannot view the record: %v", err)
+	}
+	if _, err := a.Record(ctx, "user-a", "record-a", PermissionEditRecords); !errors.Is(err, ErrRoleForbidden) {
+		t.Errorf("err = %v, want %v", err, ErrRoleForbidden)
+	}
+}
+
+func TestAuthorizerOtherOrganization(t *testing.T) {
+	// user-a 只是 org-a 的成员, 查询 org-b 的 zone 时数据库按照成员关系过滤后没有结果
+	a, db := newTestAuthorizer(t, models.OrgRoleOwner)
+	db.Set("zones", nil)
+	db.Set("dns_records", []models.DNSRecord{{ID: "record-b", ZoneID: "zone-b", Name: "www.example.org", Type: "A"}})
+	ctx := context.Background()
+
+	if _, err := a.Zone(ctx, "user-a", "zone-b", PermissionViewZones); !errors.Is(err, ErrZoneNotFound) {
+		t.Errorf("Zone() err = %v, want %v", err, ErrZoneNotFound)
+	}
+	// 记录所在的 zone 不可见时同样返回 not found, 不暴露记录是否存在
+	if _, err := a.Record(ctx, "user-a", "record-b", PermissionViewZones); !errors.Is(err, ErrRecordNotFound) {
+		t.Errorf("Record() err = %v, want %v", err, ErrRecordNotFound)
+	}
+	if _, err := a.ZoneByName(ctx, "user-a", "", "example.org", PermissionViewZones); !errors.Is(err, ErrZoneNotFound) {
+		t.Errorf("ZoneByName() err = %v, want %v", err, ErrZoneNotFound)
+	}
+	for _, want := range []string{
+		`SELECT * FROM "zones" WHERE id = 'zone-b' AND ` + memberScope,
+		`SELECT * FROM "zones" WHERE zone_name = 'example.org' AND ` + memberScope,
+	} {
+		if !containsQuery(db.Queries(), want) {
+			t.Errorf("queries = %q, want one starting with %q", db.Queries(), want)
+		}
+	}
+}
+
+func TestAuthorizerZones(t *testing.T) {
+	a, db := newTestAuthorizer(t, models.OrgRoleViewer)
+	ctx := context.Background()
+	zones, err := a.Zones(ctx, "user-a", "")
+	if err != nil || len(zones) != 1 {
+		t.Fatalf("Zones() = %v, %v", zones, err)
+	}
+	if _, err := a.Zones(ctx, "user-a", "org-b"); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`SELECT * FROM "zones" WHERE ` + memberScope + ` ORDER BY zone_name`,
+		`SELECT * FROM "zones" WHERE ` + memberScope + ` AND organization_id = 'org-b' ORDER BY zone_name`,
+	} {
+		if !containsQuery(db.Queries(), want) {
+			t.Errorf("queries = %q, want %q", db.Queries(), want)
+		}
+	}
+}
+
+func TestAuthorizeRecordChange(t *testing.T) {
+	userGrant := models.RecordGrant{ZoneID: "zone-a", PrincipalType: models.PrincipalTypeUser, PrincipalID: "user-a", NamePattern: "*.dev.example.com"}
+	tokenGrant := models.RecordGrant{ZoneID: "zone-a", PrincipalType: models.PrincipalTypeAPIToken, PrincipalID: "token-a", NamePattern: "*.example.com", Types: []string{"TXT"}}
+	jwt := Principal{UserID: "user-a"}
+	token := Principal{UserID: "user-a", APITokenID: "token-a"}
+	tests := []struct {
+		name       string
+		principal  Principal
+		grants     []models.RecordGrant // 数据库对这个对象返回的 grant
+		recordName string
+		recordType string
+		allowed    bool
+	}{
+		{"no grants", jwt, nil, "www.example.com", "A", true},
+		{"user grant matches", jwt, []models.RecordGrant{userGrant}, "api.dev.example.com", "A", true},
+		{"user grant does not match", jwt, []models.RecordGrant{userGrant}, "www.example.com", "A", false},
+		{"token grant only", token, []models.RecordGrant{tokenGrant}, "www.example.com", "TXT", true},
+		{"token grant wrong type", token, []models.RecordGrant{tokenGrant}, "www.example.com", "A", false},
+		// 用户和 token 的 grant 分别收窄, 需要同时满足
+		{"both match", token, []models.RecordGrant{userGrant, tokenGrant}, "api.dev.example.com", "TXT", true},
+		{"user allows, token does not", token, []models.RecordGrant{userGrant, tokenGrant}, "api.dev.example.com", "A", false},
+		{"token allows, user does not", token, []models.RecordGrant{userGrant, tokenGrant}, "www.example.com", "TXT", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := dbtest.New(t)
+			db.Set("record_grants", tt.grants)
+			a := NewAuthorizer(db.DB)
+			err := a.AuthorizeRecordChange(context.Background(), tt.principal, "zone-a", tt.recordName, tt.recordType)
+			if tt.allowed && err != nil {
+				t.Errorf("err = %v, want allowed", err)
+			}
+			if !tt.allowed && !errors.Is(err, ErrRecordNotGranted) {
+				t.Errorf("err = %v, want %v", err, ErrRecordNotGranted)
+			}
+			restricted, err := a.Restricted(context.Background(), tt.principal, "zone-a")
+			if err != nil || restricted != (len(tt.grants) > 0) {
+				t.Errorf("Restricted() = %v, %v, want %v", restricted, err, len(tt.grants) > 0)
+			}
+		})
+	}
+}
+
+func TestRecordGrantsQuery(t *testing.T) {
+	db := dbtest.New(t)
+	a := NewAuthorizer(db.DB)
+	ctx := context.Background()
+	if err := a.AuthorizeRecordChange(ctx, Principal{UserID: "user-a"}, "zone-a", "www.example.com", "A"); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.AuthorizeRecordChange(ctx, Principal{UserID: "user-a", APITokenID: "token-a"}, "zone-a", "www.example.com", "A"); err != nil {
+		t.Fatal(err)
+	}
+	queries := db.Queries()
+	want := []string{
+		`SELECT * FROM "record_grants" WHERE zone_id = 'zone-a' AND (principal_type = 'user' AND principal_id = 'user-a')`,
+		`SELECT * FROM "record_grants" WHERE zone_id = 'zone-a' AND ((principal_type = 'user' AND principal_id = 'user-a') OR (principal_type = 'api_token' AND principal_id = 'token-a'))`,
+	}
+	if len(queries) != len(want) {
+		t.Fatalf("queries = %q", queries)
+	}
+	for i := range want {
+		if queries[i] != want[i] {
+			t.Errorf("query %d = %s, want %s", i, queries[i], want[i])
+		}
+	}
+}
+
+func containsQuery(queries []string, prefix string) bool {
+	for _, query := range queries {
+		if strings.HasPrefix(query, prefix) {
+			return true
+		}
+	}
+	return false
+}
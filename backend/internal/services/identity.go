@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"dnsarc/internal/models"
+	"dnsarc/internal/oauth"
+)
+
+var ErrIdentityLinked = errors.New("this account is already linked to another user")
+
+// IdentityService 管理用户绑定的第三方登录身份
+type IdentityService struct {
+	db *gorm.DB
+}
+
+func NewIdentityService(db *gorm.DB) *IdentityService {
+	return &IdentityService{db: db}
+}
+
+// UserForIdentity 查找身份绑定的用户; 没有绑定时按照已验证的邮箱绑定到已有用户或创建新用户
+func (s *IdentityService) UserForIdentity(ctx context.Context, identity *oauth.Identity) (*models.User, error) {
+	var user models.User
+	var existing models.UserIdentity
+	err := s.db.WithContext(ctx).Where("provider = ? AND subject = ?", identity.Provider, identity.Subject).First(&existing).Error
+	if err == nil {
+		if err := s.db.WithContext(ctx).Where("id = ?", existing.UserID).First(&user).Error; err != nil {
+			return nil, err
+		}
+		if identity.Email != "" && identity.Email != existing.Email {
+			if err := s.db.WithContext(ctx).Model(&existing).Update("email", identity.Email).Error; err != nil {
+				return nil, err
+			}
+		}
+		return &user, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	// 只有 provider 验证过的邮箱才能用来绑定或创建账号
+	if identity.Email == "" || !identity.EmailVerified {
+		return nil, oauth.ErrEmailNotVerified
+	}
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("email = ?", identity.Email).First(&user).Error; err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			user = models.User{
+				Email:         identity.Email,
+				EmailVerified: true,
+				Avatar:        identity.Avatar,
+			}
+			if err := tx.Create(&user).Error; err != nil {
+				return err
+			}
+		} else if !user.EmailVerified {
+			// 邮箱没有验证的账号可能是其他人用这个邮箱注册的, 清除密码避免对方继续登录
+			if err := tx.Model(&user).Updates(map[string]any{"email_verified": true, "password": ""}).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(&models.UserIdentity{
+			UserID:   user.ID,
+			Provider: identity.Provider,
+			Subject:  identity.Subject,
+			Email:    identity.Email,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// Link 将身份绑定到已登录的用户, 已经绑定到其他用户时返回错误
+func (s *IdentityService) Link(ctx context.Context, userID string, identity *oauth.Identity) error {
+	var existing models.UserIdentity
+	err := s.db.WithContext(ctx).Where("provider = ? AND subject = ?", identity.Provider, identity.Subject).First(&existing).Error
+	if err == nil {
+		if existing.UserID != userID {
+			return ErrIdentityLinked
+		}
+		return nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return s.db.WithContext(ctx).Create(&models.UserIdentity{
+		UserID:   userID,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+	}).Error
+}
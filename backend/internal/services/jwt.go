@@ -4,54 +4,114 @@ import (
 	"errors"
 	"time"
 
+	"github.com/go-jose/go-jose/v4"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
-type JwtService struct {
-	secret string
+// AccessTokenTTL access token 的有效期, 过期后使用 refresh token 换取新的
+const AccessTokenTTL = time.Minute * 15
+
+var ErrInvalidToken = errors.New("invalid token")
+
+// AccessClaims access token 的 claims, sid 是登录会话的 ID
+type AccessClaims struct {
+	SessionID string `json:"sid"`
+	jwt.RegisteredClaims
 }
 
-func NewJwtService(secret string) *JwtService {
-	return &JwtService{secret: secret}
+// AccessToken 签发的 access token 和它的 jti
+type AccessToken struct {
+	Token     string
+	ID        string
+	ExpiresAt time.Time
 }
 
-func (s *JwtService) GenerateToken(userID string, expiration time.Duration) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"sub": userID,
-		"exp": time.Now().Add(expiration).Unix(),
-	})
+type JwtService struct {
+	secret []byte
+	// keys 第一个用于签名, 其他只用于验证轮换前签发的 token; 为空时使用 secret 签名 HS256
+	keys []*SigningKey
+}
 
-	return token.SignedString([]byte(s.secret))
+func NewJwtService(secret string, keys []*SigningKey) *JwtService {
+	return &JwtService{secret: []byte(secret), keys: keys}
 }
 
-func (s *JwtService) VerifyToken(tokenString string) (string, error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (any, error) {
-		// 验证签名方法
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
-		}
-		return []byte(s.secret), nil
-	})
+func (s *JwtService) GenerateToken(userID, sessionID string) (*AccessToken, error) {
+	now := time.Now()
+	claims := &AccessClaims{
+		SessionID: sessionID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			Subject:   userID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(AccessTokenTTL)),
+		},
+	}
 
+	var token string
+	var err error
+	if len(s.keys) == 0 {
+		token, err = jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+	} else {
+		key := s.keys[0]
+		t := jwt.NewWithClaims(key.method, claims)
+		t.Header["kid"] = key.ID
+		token, err = t.SignedString(key.private)
+	}
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	return &AccessToken{Token: token, ID: claims.ID, ExpiresAt: claims.ExpiresAt.Time}, nil
+}
 
+func (s *JwtService) VerifyToken(tokenString string) (*AccessClaims, error) {
+	var claims AccessClaims
+	token, err := jwt.ParseWithClaims(tokenString, &claims, s.key, jwt.WithValidMethods(s.methods()), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, err
+	}
 	if !token.Valid {
-		return "", errors.New("invalid token")
+		return nil, ErrInvalidToken
+	}
+	// 以前签发的 token 没有 jti 和 sid, 无法撤销, 不再接受
+	if claims.Subject == "" || claims.ID == "" || claims.SessionID == "" {
+		return nil, ErrInvalidToken
 	}
+	return &claims, nil
+}
 
-	// 获取claims
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return "", errors.New("invalid token claims")
+// JWKS 返回所有签名密钥的公钥, 其他服务可以用来验证 access token
+func (s *JwtService) JWKS() jose.JSONWebKeySet {
+	set := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{}}
+	for _, key := range s.keys {
+		set.Keys = append(set.Keys, key.JWK())
 	}
+	return set
+}
 
-	// 获取用户ID
-	userID, ok := claims["sub"].(string)
-	if !ok {
-		return "", errors.New("invalid user ID in token")
+func (s *JwtService) key(token *jwt.Token) (any, error) {
+	// 验证签名方法
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		return s.secret, nil
 	}
+	kid, _ := token.Header["kid"].(string)
+	for _, key := range s.keys {
+		if key.ID == kid && key.method.Alg() == token.Method.Alg() {
+			return key.public, nil
+		}
+	}
+	return nil, errors.New("unknown signing key")
+}
 
-	return userID, nil
+// methods 允许的签名算法, 配置了 secret 时也接受 HS256, 用于从 HS256 迁移到非对称密钥
+func (s *JwtService) methods() []string {
+	var methods []string
+	if len(s.secret) > 0 {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	for _, key := range s.keys {
+		methods = append(methods, key.method.Alg())
+	}
+	return methods
 }
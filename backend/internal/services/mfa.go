@@ -0,0 +1,652 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/redis/go-redis/v9"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"dnsarc/internal/models"
+)
+
+const (
+	// StepUpWindow 通过两步验证后可以执行危险操作的时间
+	StepUpWindow = time.Minute * 5
+
+	mfaIssuer          = "DNSARC"
+	mfaChallengeTTL    = time.Minute * 5
+	totpEnrollmentTTL  = time.Minute * 10
+	webAuthnSessionTTL = time.Minute * 5
+	// 连续失败 maxMFAAttempts 次后 mfaAttemptsWindow 内不能再尝试
+	maxMFAAttempts    = 5
+	mfaAttemptsWindow = time.Minute * 15
+	recoveryCodeCount = 10
+)
+
+var (
+	ErrInvalidMFACode      = errors.New("invalid two-factor code")
+	ErrMFATooManyAttempts  = errors.New("too many failed two-factor attempts, try again later")
+	ErrMFAChallengeExpired = errors.New("two-factor challenge expired, please sign in again")
+	ErrStepUpRequired      = errors.New("two-factor confirmation required")
+	ErrMFANotEnabled       = errors.New("two-factor authentication is not enabled")
+	ErrTOTPEnabled         = errors.New("authenticator app is already enabled")
+	ErrWebAuthnUnavailable = errors.New("security keys are not available")
+	ErrNoWebAuthnKeys      = errors.New("no security keys registered")
+	ErrInvalidWebAuthn     = errors.New("invalid security key response")
+)
+
+// MFAFactor 用户提交的一种两步验证方式, 只需要填写其中一个
+type MFAFactor struct {
+	TOTPCode         string
+	RecoveryCode     string
+	WebAuthnResponse string
+}
+
+// MFAService 管理两步验证: TOTP, WebAuthn 安全密钥和恢复码, 以及登录时的验证和危险操作前的 step-up
+type MFAService struct {
+	db  *gorm.DB
+	rdb *redis.Client
+	// webAuthn 为空时不支持安全密钥
+	webAuthn *webauthn.WebAuthn
+}
+
+func NewMFAService(db *gorm.DB, rdb *redis.Client, webAuthn *webauthn.WebAuthn) *MFAService {
+	return &MFAService{db: db, rdb: rdb, webAuthn: webAuthn}
+}
+
+// NewWebAuthn 安全密钥绑定到前端的域名, RP ID 是 FRONTEND_URL 的 host
+func NewWebAuthn(frontendURL string) (*webauthn.WebAuthn, error) {
+	u, err := url.Parse(frontendURL)
+	if err != nil || u.Hostname() == "" {
+		return nil, fmt.Errorf("invalid frontend url %q", frontendURL)
+	}
+	return webauthn.New(&webauthn.Config{
+		RPID:          u.Hostname(),
+		RPDisplayName: mfaIssuer,
+		RPOrigins:     []string{u.Scheme + "://" + u.Host},
+	})
+}
+
+// WebAuthnAvailable 是否配置了安全密钥
+func (s *MFAService) WebAuthnAvailable() bool {
+	return s.webAuthn != nil
+}
+
+// Methods 返回用户启用的两步验证方式
+func (s *MFAService) Methods(ctx context.Context, user *models.User) (totp, webAuthn bool, err error) {
+	var count int64
+	if err := s.db.WithContext(ctx).Model(&models.WebAuthnCredential{}).Where("user_id = ?", user.ID).Count(&count).Error; err != nil {
+		return false, false, err
+	}
+	return user.TOTPEnabled(), count > 0, nil
+}
+
+// Enabled 检查用户是否启用了任意一种两步验证
+func (s *MFAService) Enabled(ctx context.Context, user *models.User) (bool, error) {
+	totp, webAuthn, err := s.Methods(ctx, user)
+	return totp || webAuthn, err
+}
+
+// BeginChallenge 密码或第三方登录成功后, 启用了两步验证的用户先得到一个 challenge token
+func (s *MFAService) BeginChallenge(ctx context.Context, userID string) (string, error) {
+	token, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	return token, s.rdb.Set(ctx, challengeKey(token), userID, mfaChallengeTTL).Err()
+}
+
+// BeginChallengeWebAuthn 返回登录时使用安全密钥的 options
+func (s *MFAService) BeginChallengeWebAuthn(ctx context.Context, token string) ([]byte, error) {
+	user, err := s.challengeUser(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return s.beginAssertion(ctx, user, challengeKey(token))
+}
+
+// CompleteChallenge 校验两步验证, 成功后 challenge token 失效, 返回登录的用户
+func (s *MFAService) CompleteChallenge(ctx context.Context, token string, factor *MFAFactor) (string, error) {
+	user, err := s.challengeUser(ctx, token)
+	if err != nil {
+		return "", err
+	}
+	if err := s.verify(ctx, user, factor, challengeKey(token)); err != nil {
+		return "", err
+	}
+	if err := s.endChallenge(ctx, token); err != nil {
+		return "", err
+	}
+	return user.ID, nil
+}
+
+// endChallenge 删除 challenge token, 并发的请求只有一个可以成功
+func (s *MFAService) endChallenge(ctx context.Context, token string) error {
+	n, err := s.rdb.Del(ctx, challengeKey(token)).Result()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrMFAChallengeExpired
+	}
+	return nil
+}
+
+func (s *MFAService) challengeUser(ctx context.Context, token string) (*models.User, error) {
+	userID, err := s.rdb.Get(ctx, challengeKey(token)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrMFAChallengeExpired
+		}
+		return nil, err
+	}
+	return s.user(ctx, userID)
+}
+
+// BeginStepUp 返回 step-up 时使用安全密钥的 options, 没有安全密钥时返回 nil
+func (s *MFAService) BeginStepUp(ctx context.Context, userID, sessionID string) ([]byte, error) {
+	user, err := s.user(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	options, err := s.beginAssertion(ctx, user, stepUpKey(sessionID))
+	if errors.Is(err, ErrWebAuthnUnavailable) || errors.Is(err, ErrNoWebAuthnKeys) {
+		return nil, nil
+	}
+	return options, err
+}
+
+// StepUp 校验两步验证并记录到当前会话, StepUpWindow 内可以执行危险操作
+func (s *MFAService) StepUp(ctx context.Context, userID, sessionID string, factor *MFAFactor) error {
+	user, err := s.user(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if enabled, err := s.Enabled(ctx, user); err != nil {
+		return err
+	} else if !enabled {
+		return ErrMFANotEnabled
+	}
+	if err := s.verify(ctx, user, factor, stepUpKey(sessionID)); err != nil {
+		return err
+	}
+	return s.MarkStepUp(ctx, sessionID)
+}
+
+// MarkStepUp 记录会话刚刚通过了两步验证, 登录时通过两步验证的新会话也可以直接执行危险操作
+func (s *MFAService) MarkStepUp(ctx context.Context, sessionID string) error {
+	return s.db.WithContext(ctx).Model(&models.Session{}).Where("id = ?", sessionID).Update("step_up_at", time.Now()).Error
+}
+
+// RequireStepUp 启用了两步验证的用户在执行危险操作前需要最近通过验证; API token 没有会话, 无法 step-up
+func (s *MFAService) RequireStepUp(ctx context.Context, userID, sessionID string) error {
+	user, err := s.user(ctx, userID)
+	if err != nil {
+		return err
+	}
+	enabled, err := s.Enabled(ctx, user)
+	if err != nil || !enabled {
+		return err
+	}
+	if sessionID == "" {
+		return ErrStepUpRequired
+	}
+	var session models.Session
+	if err := s.db.WithContext(ctx).Where("id = ? AND user_id = ?", sessionID, userID).First(&session).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrStepUpRequired
+		}
+		return err
+	}
+	if !stepUpFresh(session.StepUpAt, time.Now()) {
+		return ErrStepUpRequired
+	}
+	return nil
+}
+
+// stepUpFresh 会话是否在 StepUpWindow 内通过了两步验证
+func stepUpFresh(stepUpAt *time.Time, now time.Time) bool {
+	return stepUpAt != nil && now.Sub(*stepUpAt) <= StepUpWindow
+}
+
+// BeginTOTPEnrollment 生成新的 TOTP 密钥, 用户输入验证码确认后才启用
+func (s *MFAService) BeginTOTPEnrollment(ctx context.Context, user *models.User) (secret, otpauthURL string, err error) {
+	if user.TOTPEnabled() {
+		return "", "", ErrTOTPEnabled
+	}
+	secret, err = GenerateTOTPSecret()
+	if err != nil {
+		return "", "", err
+	}
+	if err := s.rdb.Set(ctx, totpEnrollmentKey(user.ID), secret, totpEnrollmentTTL).Err(); err != nil {
+		return "", "", err
+	}
+	return secret, TOTPURL(mfaIssuer, user.Email, secret), nil
+}
+
+// ConfirmTOTPEnrollment 校验验证码并启用 TOTP, 第一次启用两步验证时返回新生成的恢复码
+func (s *MFAService) ConfirmTOTPEnrollment(ctx context.Context, user *models.User, code string) ([]string, error) {
+	if user.TOTPEnabled() {
+		return nil, ErrTOTPEnabled
+	}
+	if err := s.checkAttempts(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	secret, err := s.rdb.Get(ctx, totpEnrollmentKey(user.ID)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrMFAChallengeExpired
+		}
+		return nil, err
+	}
+	step, ok := ValidateTOTP(secret, strings.TrimSpace(code), time.Now())
+	if !ok {
+		return nil, s.recordFailure(ctx, user.ID)
+	}
+	if err := s.db.WithContext(ctx).Model(&models.User{}).Where("id = ?", user.ID).Updates(map[string]any{
+		"totp_secret":     secret,
+		"totp_enabled_at": time.Now(),
+		"totp_last_step":  step,
+	}).Error; err != nil {
+		return nil, err
+	}
+	// TOTP 已经启用, 删除失败时留下的 secret 会按 TTL 过期, 启用后也不会再被确认, 不影响这次注册
+	if err := s.rdb.Del(ctx, totpEnrollmentKey(user.ID)).Err(); err != nil {
+		slog.Warn("failed to delete totp enrollment", "user_id", user.ID, "error", err)
+	}
+	return s.ensureRecoveryCodes(ctx, user)
+}
+
+// DisableTOTP 关闭 TOTP, 没有其他两步验证方式时同时删除恢复码
+func (s *MFAService) DisableTOTP(ctx context.Context, userID string) error {
+	if err := s.db.WithContext(ctx).Model(&models.User{}).Where("id = ?", userID).Updates(map[string]any{
+		"totp_secret":     "",
+		"totp_enabled_at": nil,
+		"totp_last_step":  0,
+	}).Error; err != nil {
+		return err
+	}
+	return s.pruneRecoveryCodes(ctx, userID)
+}
+
+// BeginWebAuthnRegistration 返回注册安全密钥的 options, 已经注册的密钥不能重复注册
+func (s *MFAService) BeginWebAuthnRegistration(ctx context.Context, user *models.User) ([]byte, error) {
+	if s.webAuthn == nil {
+		return nil, ErrWebAuthnUnavailable
+	}
+	webAuthnUser, err := s.webAuthnUser(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	creation, session, err := s.webAuthn.BeginRegistration(webAuthnUser,
+		webauthn.WithExclusions(webauthn.Credentials(webAuthnUser.WebAuthnCredentials()).CredentialDescriptors()),
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementDiscouraged),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.saveWebAuthnSession(ctx, webAuthnRegistrationKey(user.ID), session); err != nil {
+		return nil, err
+	}
+	return json.Marshal(creation)
+}
+
+// FinishWebAuthnRegistration 校验浏览器返回的注册结果并保存安全密钥, 第一次启用两步验证时返回新生成的恢复码
+func (s *MFAService) FinishWebAuthnRegistration(ctx context.Context, user *models.User, name, response string) (*models.WebAuthnCredential, []string, error) {
+	if s.webAuthn == nil {
+		return nil, nil, ErrWebAuthnUnavailable
+	}
+	session, err := s.takeWebAuthnSession(ctx, webAuthnRegistrationKey(user.ID))
+	if err != nil {
+		return nil, nil, err
+	}
+	parsed, err := protocol.ParseCredentialCreationResponseBytes([]byte(response))
+	if err != nil {
+		return nil, nil, ErrInvalidWebAuthn
+	}
+	webAuthnUser, err := s.webAuthnUser(ctx, user)
+	if err != nil {
+		return nil, nil, err
+	}
+	credential, err := s.webAuthn.CreateCredential(webAuthnUser, *session, parsed)
+	if err != nil {
+		slog.Info("failed to verify webauthn registration", "user_id", user.ID, "error", err)
+		return nil, nil, ErrInvalidWebAuthn
+	}
+	record := &models.WebAuthnCredential{
+		UserID:       user.ID,
+		Name:         name,
+		CredentialID: credential.ID,
+		Credential:   *credential,
+	}
+	if err := s.db.WithContext(ctx).Create(record).Error; err != nil {
+		return nil, nil, err
+	}
+	codes, err := s.ensureRecoveryCodes(ctx, user)
+	if err != nil {
+		return nil, nil, err
+	}
+	return record, codes, nil
+}
+
+// DeleteWebAuthnCredential 删除安全密钥, 不存在时返回 gorm.ErrRecordNotFound
+func (s *MFAService) DeleteWebAuthnCredential(ctx context.Context, userID, id string) error {
+	result := s.db.WithContext(ctx).Where("user_id = ? AND id = ?", userID, id).Delete(&models.WebAuthnCredential{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return s.pruneRecoveryCodes(ctx, userID)
+}
+
+// RegenerateRecoveryCodes 生成新的恢复码, 旧的全部失效
+func (s *MFAService) RegenerateRecoveryCodes(ctx context.Context, user *models.User) ([]string, error) {
+	if enabled, err := s.Enabled(ctx, user); err != nil {
+		return nil, err
+	} else if !enabled {
+		return nil, ErrMFANotEnabled
+	}
+	return s.setRecoveryCodes(ctx, user.ID)
+}
+
+// verify 校验一种两步验证方式, 连续失败会被限制
+func (s *MFAService) verify(ctx context.Context, user *models.User, factor *MFAFactor, webAuthnKey string) error {
+	if err := s.checkAttempts(ctx, user.ID); err != nil {
+		return err
+	}
+	var ok bool
+	var err error
+	switch {
+	case factor.TOTPCode != "":
+		ok, err = s.verifyTOTP(ctx, user, factor.TOTPCode)
+	case factor.RecoveryCode != "":
+		ok, err = s.consumeRecoveryCode(ctx, user.ID, factor.RecoveryCode)
+	case factor.WebAuthnResponse != "":
+		ok, err = s.verifyAssertion(ctx, user, webAuthnKey, factor.WebAuthnResponse)
+	}
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return s.recordFailure(ctx, user.ID)
+	}
+	return s.rdb.Del(ctx, mfaAttemptsKey(user.ID)).Err()
+}
+
+func (s *MFAService) checkAttempts(ctx context.Context, userID string) error {
+	n, err := s.rdb.Get(ctx, mfaAttemptsKey(userID)).Int()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+	if n >= maxMFAAttempts {
+		return ErrMFATooManyAttempts
+	}
+	return nil
+}
+
+// recordFailure 记录一次失败并返回 ErrInvalidMFACode
+func (s *MFAService) recordFailure(ctx context.Context, userID string) error {
+	n, err := s.rdb.Incr(ctx, mfaAttemptsKey(userID)).Result()
+	if err != nil {
+		return err
+	}
+	if n == 1 {
+		if err := s.rdb.Expire(ctx, mfaAttemptsKey(userID), mfaAttemptsWindow).Err(); err != nil {
+			return err
+		}
+	}
+	return ErrInvalidMFACode
+}
+
+// verifyTOTP 校验验证码, 同一个时间窗口的验证码只能使用一次
+func (s *MFAService) verifyTOTP(ctx context.Context, user *models.User, code string) (bool, error) {
+	if !user.TOTPEnabled() {
+		return false, nil
+	}
+	step, ok := validateTOTPAfter(user.TOTPSecret, strings.TrimSpace(code), user.TOTPLastStep, time.Now())
+	if !ok {
+		return false, nil
+	}
+	// user 可能是之前读取的, 并发的请求通过条件更新保证只有一个成功
+	result := s.db.WithContext(ctx).Model(&models.User{}).Where("id = ? AND totp_last_step < ?", user.ID, step).Update("totp_last_step", step)
+	return result.RowsAffected > 0, result.Error
+}
+
+// consumeRecoveryCode 校验并删除恢复码, 每个恢复码只能使用一次
+func (s *MFAService) consumeRecoveryCode(ctx context.Context, userID, code string) (bool, error) {
+	ok := false
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user models.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", userID).First(&user).Error; err != nil {
+			return err
+		}
+		var codes []string
+		if codes, ok = removeRecoveryCode(user.RecoveryCodes, code); !ok {
+			return nil
+		}
+		return tx.Model(&user).Select("RecoveryCodes").Updates(&models.User{RecoveryCodes: codes}).Error
+	})
+	return ok, err
+}
+
+// removeRecoveryCode 从恢复码的 hash 中删除 code 对应的一项, 没有找到时返回 false
+func removeRecoveryCode(hashes []string, code string) ([]string, bool) {
+	i := slices.Index(hashes, hashToken(normalizeRecoveryCode(code)))
+	if i < 0 {
+		return hashes, false
+	}
+	return slices.Delete(slices.Clone(hashes), i, i+1), true
+}
+
+// ensureRecoveryCodes 还没有恢复码时生成, 已经有时返回 nil
+func (s *MFAService) ensureRecoveryCodes(ctx context.Context, user *models.User) ([]string, error) {
+	if len(user.RecoveryCodes) > 0 {
+		return nil, nil
+	}
+	return s.setRecoveryCodes(ctx, user.ID)
+}
+
+func (s *MFAService) setRecoveryCodes(ctx context.Context, userID string) ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes[i] = code
+	}
+	hashes := lo.Map(codes, func(code string, _ int) string {
+		return hashToken(normalizeRecoveryCode(code))
+	})
+	if err := s.db.WithContext(ctx).Model(&models.User{ID: userID}).Select("RecoveryCodes").Updates(&models.User{RecoveryCodes: hashes}).Error; err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// pruneRecoveryCodes 关闭最后一种两步验证方式后删除恢复码
+func (s *MFAService) pruneRecoveryCodes(ctx context.Context, userID string) error {
+	user, err := s.user(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if enabled, err := s.Enabled(ctx, user); err != nil || enabled {
+		return err
+	}
+	return s.db.WithContext(ctx).Model(user).Select("RecoveryCodes").Updates(&models.User{RecoveryCodes: []string{}}).Error
+}
+
+func (s *MFAService) beginAssertion(ctx context.Context, user *models.User, key string) ([]byte, error) {
+	if s.webAuthn == nil {
+		return nil, ErrWebAuthnUnavailable
+	}
+	webAuthnUser, err := s.webAuthnUser(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if len(webAuthnUser.credentials) == 0 {
+		return nil, ErrNoWebAuthnKeys
+	}
+	assertion, session, err := s.webAuthn.BeginLogin(webAuthnUser)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.saveWebAuthnSession(ctx, webAuthnAssertionKey(key), session); err != nil {
+		return nil, err
+	}
+	return json.Marshal(assertion)
+}
+
+// verifyAssertion 校验安全密钥的签名并更新签名计数
+func (s *MFAService) verifyAssertion(ctx context.Context, user *models.User, key, response string) (bool, error) {
+	if s.webAuthn == nil {
+		return false, ErrWebAuthnUnavailable
+	}
+	session, err := s.takeWebAuthnSession(ctx, webAuthnAssertionKey(key))
+	if err != nil {
+		return false, err
+	}
+	parsed, err := protocol.ParseCredentialRequestResponseBytes([]byte(response))
+	if err != nil {
+		return false, nil
+	}
+	webAuthnUser, err := s.webAuthnUser(ctx, user)
+	if err != nil {
+		return false, err
+	}
+	credential, err := s.webAuthn.ValidateLogin(webAuthnUser, *session, parsed)
+	if err != nil {
+		slog.Info("failed to verify webauthn assertion", "user_id", user.ID, "error", err)
+		return false, nil
+	}
+	// 签名计数没有增加, 说明密钥可能被复制
+	if credential.Authenticator.CloneWarning {
+		slog.Warn("webauthn credential may be cloned", "user_id", user.ID)
+		return false, nil
+	}
+	if err := s.db.WithContext(ctx).Model(&models.WebAuthnCredential{}).Where("credential_id = ?", credential.ID).
+		Select("Credential", "LastUsedAt").Updates(&models.WebAuthnCredential{Credential: *credential, LastUsedAt: lo.ToPtr(time.Now())}).Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *MFAService) saveWebAuthnSession(ctx context.Context, key string, session *webauthn.SessionData) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	return s.rdb.Set(ctx, key, data, webAuthnSessionTTL).Err()
+}
+
+// takeWebAuthnSession 读取并删除 challenge, 每个 challenge 只能使用一次
+func (s *MFAService) takeWebAuthnSession(ctx context.Context, key string) (*webauthn.SessionData, error) {
+	data, err := s.rdb.GetDel(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrMFAChallengeExpired
+		}
+		return nil, err
+	}
+	var session webauthn.SessionData
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (s *MFAService) user(ctx context.Context, userID string) (*models.User, error) {
+	var user models.User
+	if err := s.db.WithContext(ctx).Where("id = ?", userID).First(&user).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (s *MFAService) webAuthnUser(ctx context.Context, user *models.User) (*webAuthnUser, error) {
+	var credentials []models.WebAuthnCredential
+	if err := s.db.WithContext(ctx).Where("user_id = ?", user.ID).Find(&credentials).Error; err != nil {
+		return nil, err
+	}
+	return &webAuthnUser{user: user, credentials: credentials}, nil
+}
+
+// webAuthnUser 实现 webauthn.User
+type webAuthnUser struct {
+	user        *models.User
+	credentials []models.WebAuthnCredential
+}
+
+func (u *webAuthnUser) WebAuthnID() []byte {
+	return []byte(u.user.ID)
+}
+
+func (u *webAuthnUser) WebAuthnName() string {
+	return u.user.Email
+}
+
+func (u *webAuthnUser) WebAuthnDisplayName() string {
+	return u.user.Email
+}
+
+func (u *webAuthnUser) WebAuthnCredentials() []webauthn.Credential {
+	return lo.Map(u.credentials, func(credential models.WebAuthnCredential, _ int) webauthn.Credential {
+		return credential.Credential
+	})
+}
+
+// generateRecoveryCode 生成 xxxxx-xxxxx 格式的恢复码, 50 位随机数
+func generateRecoveryCode() (string, error) {
+	const alphabet = "abcdefghijklmnopqrstuvwxyz234567"
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		b[i] = alphabet[int(b[i])%len(alphabet)]
+	}
+	return string(b[:5]) + "-" + string(b[5:]), nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
+
+func challengeKey(token string) string {
+	return "mfa:challenge:" + hashToken(token)
+}
+
+func stepUpKey(sessionID string) string {
+	return "step-up:" + sessionID
+}
+
+func totpEnrollmentKey(userID string) string {
+	return "mfa:totp-enrollment:" + userID
+}
+
+func webAuthnRegistrationKey(userID string) string {
+	return "mfa:webauthn-registration:" + userID
+}
+
+func webAuthnAssertionKey(key string) string {
+	return "mfa:webauthn-assertion:" + key
+}
+
+func mfaAttemptsKey(userID string) string {
+	return "mfa:attempts:" + userID
+}
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestRedis(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() {
+		_ = rdb.Close()
+	})
+	return mr, rdb
+}
+
+func TestRemoveRecoveryCode(t *testing.T) {
+	codes := []string{"abcde-fghij", "klmno-pqrst", "uvwxy-z2345"}
+	hashes := make([]string, len(codes))
+	for i, code := range codes {
+		hashes[i] = hashToken(normalizeRecoveryCode(code))
+	}
+
+	// 输入时忽略大小写, 空格和连字符
+	remaining, ok := removeRecoveryCode(hashes, " KLMNO pqrst")
+	if !ok {
+		t.Fatal("recovery code not accepted")
+	}
+	if len(remaining) != 2 || slices.Contains(remaining, hashes[1]) {
+		t.Errorf("remaining = %v, want the other two codes", remaining)
+	}
+	if len(hashes) != 3 || hashes[1] != hashToken("klmnopqrst") {
+		t.Error("input hashes modified")
+	}
+	// 用过的恢复码不能再用
+	if _, ok := removeRecoveryCode(remaining, "klmno-pqrst"); ok {
+		t.Error("used recovery code accepted twice")
+	}
+	if _, ok := removeRecoveryCode(remaining, "zzzzz-zzzzz"); ok {
+		t.Error("unknown recovery code accepted")
+	}
+	if _, ok := removeRecoveryCode(nil, "abcde-fghij"); ok {
+		t.Error("recovery code accepted without any codes")
+	}
+}
+
+func TestGenerateRecoveryCode(t *testing.T) {
+	code, err := generateRecoveryCode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(code) != 11 || code[5] != '-' || normalizeRecoveryCode(code) != code[:5]+code[6:] {
+		t.Errorf("code = %q, want xxxxx-xxxxx", code)
+	}
+}
+
+func TestMFAChallengeExpires(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	s := &MFAService{rdb: rdb}
+	ctx := context.Background()
+	token, err := s.BeginChallenge(ctx, "user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ttl := mr.TTL(challengeKey(token)); ttl != mfaChallengeTTL {
+		t.Errorf("challenge ttl = %s, want %s", ttl, mfaChallengeTTL)
+	}
+	mr.FastForward(mfaChallengeTTL + time.Second)
+	if _, err := s.challengeUser(ctx, token); !errors.Is(err, ErrMFAChallengeExpired) {
+		t.Errorf("challengeUser() err = %v, want %v", err, ErrMFAChallengeExpired)
+	}
+	if err := s.endChallenge(ctx, token); !errors.Is(err, ErrMFAChallengeExpired) {
+		t.Errorf("endChallenge() err = %v, want %v", err, ErrMFAChallengeExpired)
+	}
+}
+
+func TestMFAChallengeSingleUse(t *testing.T) {
+	_, rdb := newTestRedis(t)
+	s := &MFAService{rdb: rdb}
+	ctx := context.Background()
+	token, err := s.BeginChallenge(ctx, "user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.endChallenge(ctx, token); err != nil {
+		t.Fatalf("endChallenge() err = %v", err)
+	}
+	// 并发提交的另一个请求或者重放的请求
+	if err := s.endChallenge(ctx, token); !errors.Is(err, ErrMFAChallengeExpired) {
+		t.Errorf("second endChallenge() err = %v, want %v", err, ErrMFAChallengeExpired)
+	}
+	if _, err := s.challengeUser(ctx, token); !errors.Is(err, ErrMFAChallengeExpired) {
+		t.Errorf("challengeUser() err = %v, want %v", err, ErrMFAChallengeExpired)
+	}
+	if _, err := s.challengeUser(ctx, "unknown"); !errors.Is(err, ErrMFAChallengeExpired) {
+		t.Errorf("challengeUser(unknown) err = %v, want %v", err, ErrMFAChallengeExpired)
+	}
+}
+
+func TestMFAAttempts(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	s := &MFAService{rdb: rdb}
+	ctx := context.Background()
+	for i := range maxMFAAttempts {
+		if err := s.checkAttempts(ctx, "user-1"); err != nil {
+			t.Fatalf("attempt %d: %v", i, err)
+		}
+		if err := s.recordFailure(ctx, "user-1"); !errors.Is(err, ErrInvalidMFACode) {
+			t.Fatalf("recordFailure() err = %v, want %v", err, ErrInvalidMFACode)
+		}
+	}
+	if err := s.checkAttempts(ctx, "user-1"); !errors.Is(err, ErrMFATooManyAttempts) {
+		t.Errorf("checkAttempts() err = %v, want %v", err, ErrMFATooManyAttempts)
+	}
+	if err := s.checkAttempts(ctx, "user-2"); err != nil {
+		t.Errorf("other user limited: %v", err)
+	}
+	mr.FastForward(mfaAttemptsWindow)
+	if err := s.checkAttempts(ctx, "user-1"); err != nil {
+		t.Errorf("still limited after the window: %v", err)
+	}
+}
+
+func TestStepUpFresh(t *testing.T) {
+	now := time.Now()
+	at := func(d time.Duration) *time.Time {
+		v := now.Add(-d)
+		return &v
+	}
+	tests := []struct {
+		name     string
+		stepUpAt *time.Time
+		want     bool
+	}{
+		{"never", nil, false},
+		{"just now", at(0), true},
+		{"inside window", at(StepUpWindow - time.Second), true},
+		{"at window end", at(StepUpWindow), true},
+		{"expired", at(StepUpWindow + time.Second), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stepUpFresh(tt.stepUpAt, now); got != tt.want {
+				t.Errorf("stepUpFresh() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
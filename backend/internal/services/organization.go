@@ -0,0 +1,294 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"dnsarc/internal/models"
+)
+
+var (
+	ErrLastOwner            = errors.New("an organization needs at least one owner")
+	ErrPersonalOrganization = errors.New("personal organizations cannot be deleted")
+	ErrOrganizationHasZones = errors.New("delete the zones of this organization first")
+	ErrMemberNotFound       = errors.New("member not found")
+	ErrAlreadyMember        = errors.New("user is already a member of this organization")
+	ErrInvalidInvitation    = errors.New("invalid or expired invitation")
+	ErrInvitationEmail      = errors.New("this invitation was sent to a different email address")
+)
+
+const (
+	// InvitationTTL 邀请的有效期
+	InvitationTTL = time.Hour * 24 * 7
+)
+
+// OrganizationService 管理组织, 成员和邀请, 调用前需要先通过 Authorizer 检查操作权限
+type OrganizationService struct {
+	db *gorm.DB
+}
+
+func NewOrganizationService(db *gorm.DB) *OrganizationService {
+	return &OrganizationService{db: db}
+}
+
+// Personal 返回用户的个人组织, 不存在时创建
+func (s *OrganizationService) Personal(ctx context.Context, userID string) (*models.Organization, error) {
+	var organization models.Organization
+	err := s.db.WithContext(ctx).Where("personal_user_id = ?", userID).First(&organization).Error
+	if err == nil {
+		return &organization, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		organization = models.Organization{Name: models.PersonalOrganizationName, PersonalUserID: &userID}
+		// 并发创建时只有一个成功, 其他的使用已经创建的
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&organization)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return tx.Where("personal_user_id = ?", userID).First(&organization).Error
+		}
+		return tx.Create(&models.OrganizationMember{OrganizationID: organization.ID, UserID: userID, Role: models.OrgRoleOwner}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &organization, nil
+}
+
+// Create 创建组织, 创建者是 owner
+func (s *OrganizationService) Create(ctx context.Context, userID, name string) (*models.Organization, error) {
+	organization := models.Organization{Name: name}
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&organization).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.OrganizationMember{OrganizationID: organization.ID, UserID: userID, Role: models.OrgRoleOwner}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &organization, nil
+}
+
+// Delete 删除组织和它的成员和邀请, 组织中还有 zone 时不能删除
+func (s *OrganizationService) Delete(ctx context.Context, organizationID string) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var organization models.Organization
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", organizationID).First(&organization).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrOrganizationNotFound
+			}
+			return err
+		}
+		if organization.Personal() {
+			return ErrPersonalOrganization
+		}
+		var count int64
+		if err := tx.Model(&models.Zone{}).Where("organization_id = ?", organizationID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrOrganizationHasZones
+		}
+		if err := tx.Where("organization_id = ?", organizationID).Delete(&models.OrganizationInvitation{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("organization_id = ?", organizationID).Delete(&models.OrganizationMember{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&organization).Error
+	})
+}
+
+// UpdateRole 修改成员的角色, actorRole 是操作者的角色, 只有 owner 可以授予或撤销 owner
+func (s *OrganizationService) UpdateRole(ctx context.Context, organizationID string, actorRole models.OrgRole, userID string, role models.OrgRole) (*models.OrganizationMember, error) {
+	var member *models.OrganizationMember
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		members, err := lockMembers(tx, organizationID)
+		if err != nil {
+			return err
+		}
+		member, err = findMember(members, userID)
+		if err != nil {
+			return err
+		}
+		if (member.Role == models.OrgRoleOwner || role == models.OrgRoleOwner) && actorRole != models.OrgRoleOwner {
+			return ErrRoleForbidden
+		}
+		if member.Role == models.OrgRoleOwner && role != models.OrgRoleOwner && countOwners(members) == 1 {
+			return ErrLastOwner
+		}
+		member.Role = role
+		return tx.Model(member).Update("role", role).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.db.WithContext(ctx).Where("id = ?", member.UserID).First(&member.User).Error; err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// RemoveMember 移除成员, 成员可以自己退出; 只有 owner 可以移除 owner, 最后一个 owner 不能退出
+func (s *OrganizationService) RemoveMember(ctx context.Context, organizationID string, actorRole models.OrgRole, userID string) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		members, err := lockMembers(tx, organizationID)
+		if err != nil {
+			return err
+		}
+		member, err := findMember(members, userID)
+		if err != nil {
+			return err
+		}
+		if member.Role == models.OrgRoleOwner {
+			if actorRole != models.OrgRoleOwner {
+				return ErrRoleForbidden
+			}
+			if countOwners(members) == 1 {
+				return ErrLastOwner
+			}
+		}
+		if err := tx.Delete(member).Error; err != nil {
+			return err
+		}
+		// 删除用户和用户的 API token 在组织 zone 中的 grant
+		zones := tx.Model(&models.Zone{}).Select("id").Where("organization_id = ?", organizationID)
+		apiTokens := tx.Model(&models.APIToken{}).Select("id").Where("user_id = ?", userID)
+		return tx.Where("zone_id IN (?)", zones).
+			Where(tx.Where("principal_type = ? AND principal_id = ?", models.PrincipalTypeUser, userID).
+				Or("principal_type = ? AND principal_id IN (?)", models.PrincipalTypeAPIToken, apiTokens)).
+			Delete(&models.RecordGrant{}).Error
+	})
+}
+
+// Invite 创建邀请并返回明文 token, 同一个邮箱之前的邀请失效
+func (s *OrganizationService) Invite(ctx context.Context, organizationID string, actorRole models.OrgRole, invitedBy, email string, role models.OrgRole) (*models.OrganizationInvitation, string, error) {
+	if role == models.OrgRoleOwner && actorRole != models.OrgRoleOwner {
+		return nil, "", ErrRoleForbidden
+	}
+	var count int64
+	if err := s.db.WithContext(ctx).Model(&models.OrganizationMember{}).
+		Joins("JOIN users ON users.id = organization_members.user_id").
+		Where("organization_members.organization_id = ? AND LOWER(users.email) = ?", organizationID, strings.ToLower(email)).
+		Count(&count).Error; err != nil {
+		return nil, "", err
+	}
+	if count > 0 {
+		return nil, "", ErrAlreadyMember
+	}
+	token, err := randomToken()
+	if err != nil {
+		return nil, "", err
+	}
+	invitation := models.OrganizationInvitation{
+		OrganizationID: organizationID,
+		Email:          email,
+		Role:           role,
+		TokenHash:      hashToken(token),
+		InvitedBy:      invitedBy,
+		ExpiresAt:      time.Now().Add(InvitationTTL),
+	}
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("organization_id = ? AND email = ? AND accepted_at IS NULL", organizationID, email).Delete(&models.OrganizationInvitation{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&invitation).Error
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return &invitation, token, nil
+}
+
+// AcceptInvitation 接受邀请, 只有邀请的邮箱对应的用户可以接受, 每个邀请只能使用一次
+func (s *OrganizationService) AcceptInvitation(ctx context.Context, user *models.User, token string) (*models.Organization, models.OrgRole, error) {
+	var invitation models.OrganizationInvitation
+	if err := s.db.WithContext(ctx).Where("token_hash = ?", hashToken(token)).First(&invitation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, "", ErrInvalidInvitation
+		}
+		return nil, "", err
+	}
+	if !strings.EqualFold(invitation.Email, user.Email) {
+		return nil, "", ErrInvitationEmail
+	}
+	var organization models.Organization
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		// 使用条件更新, 并发的请求只有一个可以成功
+		result := tx.Model(&models.OrganizationInvitation{}).
+			Where("id = ? AND accepted_at IS NULL AND expires_at > ?", invitation.ID, now).
+			Update("accepted_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrInvalidInvitation
+		}
+		if err := tx.Where("id = ?", invitation.OrganizationID).First(&organization).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidInvitation
+			}
+			return err
+		}
+		result = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.OrganizationMember{
+			OrganizationID: invitation.OrganizationID,
+			UserID:         user.ID,
+			Role:           invitation.Role,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrAlreadyMember
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return &organization, invitation.Role, nil
+}
+
+// Prune 删除过期和已经接受的邀请
+func (s *OrganizationService) Prune(ctx context.Context) error {
+	return s.db.WithContext(ctx).Where("expires_at < ? OR accepted_at < ?", time.Now().Add(-time.Hour*24), time.Now().Add(-InvitationTTL)).Delete(&models.OrganizationInvitation{}).Error
+}
+
+// lockMembers 锁定组织的所有成员, 避免并发修改时移除最后一个 owner
+func lockMembers(tx *gorm.DB, organizationID string) ([]models.OrganizationMember, error) {
+	var members []models.OrganizationMember
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("organization_id = ?", organizationID).Find(&members).Error; err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func findMember(members []models.OrganizationMember, userID string) (*models.OrganizationMember, error) {
+	for i := range members {
+		if members[i].UserID == userID {
+			return &members[i], nil
+		}
+	}
+	return nil, ErrMemberNotFound
+}
+
+func countOwners(members []models.OrganizationMember) int {
+	count := 0
+	for _, member := range members {
+		if member.Role == models.OrgRoleOwner {
+			count++
+		}
+	}
+	return count
+}
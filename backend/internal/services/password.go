@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// argon2id 参数, 参考 RFC 9106 和 OWASP 的推荐值
+const (
+	argon2Memory  = 64 * 1024
+	argon2Time    = 3
+	argon2Threads = 2
+	argon2KeyLen  = 32
+	argon2SaltLen = 16
+
+	MinPasswordLength = 8
+	MaxPasswordLength = 128
+)
+
+var ErrInvalidPasswordHash = errors.New("invalid password hash")
+
+// hashSlots 限制同时计算的 argon2id 数量, 每次计算需要 64 MiB 内存, 超过 CPU 数量也不会更快
+var hashSlots = make(chan struct{}, max(runtime.NumCPU()/argon2Threads, 1))
+
+// acquireHashSlot 等待空闲的计算位置, ctx 结束时返回错误
+func acquireHashSlot(ctx context.Context) (func(), error) {
+	select {
+	case hashSlots <- struct{}{}:
+		return func() { <-hashSlots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// HashPassword 使用 argon2id 计算密码的 hash, 格式为 $argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash>
+func HashPassword(ctx context.Context, password string) (string, error) {
+	release, err := acquireHashSlot(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	salt := make([]byte, argon2SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, argon2Time, argon2Memory, argon2Threads, argon2KeyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, argon2Memory, argon2Time, argon2Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// VerifyPassword 使用 hash 中保存的参数计算并比较, 参数调整后旧的 hash 仍然可以验证
+func VerifyPassword(ctx context.Context, password, encoded string) (bool, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return false, ErrInvalidPasswordHash
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false, ErrInvalidPasswordHash
+	}
+	var memory, time uint32
+	var threads uint8
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil {
+		return false, ErrInvalidPasswordHash
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, ErrInvalidPasswordHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return false, ErrInvalidPasswordHash
+	}
+	release, err := acquireHashSlot(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer release()
+	actual := argon2.IDKey([]byte(password), salt, time, memory, threads, uint32(len(key)))
+	return subtle.ConstantTimeCompare(actual, key) == 1, nil
+}
+
+// ValidatePassword 检查密码长度, 上限用于限制 hash 的计算量
+func ValidatePassword(password string) error {
+	if len(password) < MinPasswordLength || len(password) > MaxPasswordLength {
+		return fmt.Errorf("password must be between %d and %d characters", MinPasswordLength, MaxPasswordLength)
+	}
+	return nil
+}
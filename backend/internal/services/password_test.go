@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestHashPassword(t *testing.T) {
+	ctx := context.Background()
+	hash, err := HashPassword(ctx, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := VerifyPassword(ctx, "correct horse", hash); err != nil || !ok {
+		t.Errorf("VerifyPassword() = %v, %v, want true", ok, err)
+	}
+	if ok, err := VerifyPassword(ctx, "wrong horse", hash); err != nil || ok {
+		t.Errorf("VerifyPassword() = %v, %v, want false", ok, err)
+	}
+	if _, err := VerifyPassword(ctx, "correct horse", "$bcrypt$x"); !errors.Is(err, ErrInvalidPasswordHash) {
+		t.Errorf("err = %v, want %v", err, ErrInvalidPasswordHash)
+	}
+}
+
+func TestHashPasswordWaitsForSlot(t *testing.T) {
+	// 占满所有计算位置后, 请求在 ctx 结束时返回
+	for range cap(hashSlots) {
+		hashSlots <- struct{}{}
+	}
+	defer func() {
+		for range cap(hashSlots) {
+			<-hashSlots
+		}
+	}()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := HashPassword(ctx, "correct horse"); !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want %v", err, context.Canceled)
+	}
+	if _, err := VerifyPassword(ctx, "correct horse", "$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$a2V5"); !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,237 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"dnsarc/internal/models"
+)
+
+const (
+	// refreshTokenTTL 会话在没有刷新的情况下保持的时间, 每次刷新都会延长
+	refreshTokenTTL = time.Hour * 24 * 30
+	// refreshReuseGrace 轮换后短时间内旧 token 再次使用通常是并发刷新, 不当作盗用
+	refreshReuseGrace = time.Second * 30
+
+	denylistKeyPrefix = "auth:denylist:"
+)
+
+var ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+
+// SessionTokens 登录或刷新后返回给客户端的 token
+type SessionTokens struct {
+	Session      *models.Session
+	AccessToken  *AccessToken
+	RefreshToken string
+}
+
+// SessionService 管理登录会话: refresh token 的轮换, 会话撤销和 access token 的 denylist
+type SessionService struct {
+	db         *gorm.DB
+	rdb        *redis.Client
+	jwtService *JwtService
+}
+
+func NewSessionService(db *gorm.DB, rdb *redis.Client, jwtService *JwtService) *SessionService {
+	return &SessionService{db: db, rdb: rdb, jwtService: jwtService}
+}
+
+// Create 创建新的会话并签发 access token 和 refresh token
+func (s *SessionService) Create(ctx context.Context, userID, userAgent, ip string) (*SessionTokens, error) {
+	refreshToken, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	session := &models.Session{
+		UserID:           userID,
+		RefreshTokenHash: hashToken(refreshToken),
+		UserAgent:        userAgent,
+		IP:               ip,
+		ExpiresAt:        now.Add(refreshTokenTTL),
+		LastUsedAt:       now,
+	}
+	var accessToken *AccessToken
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(session).Error; err != nil {
+			return err
+		}
+		// access token 需要会话的 ID
+		if accessToken, err = s.jwtService.GenerateToken(userID, session.ID); err != nil {
+			return err
+		}
+		session.AccessTokenID, session.AccessTokenExpiresAt = accessToken.ID, accessToken.ExpiresAt
+		return tx.Model(session).Updates(map[string]any{
+			"access_token_id":         accessToken.ID,
+			"access_token_expires_at": accessToken.ExpiresAt,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &SessionTokens{Session: session, AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+// Refresh 使用 refresh token 换取新的 access token, refresh token 同时轮换, 旧的不能再使用
+func (s *SessionService) Refresh(ctx context.Context, refreshToken, userAgent, ip string) (*SessionTokens, error) {
+	hash := hashToken(refreshToken)
+	now := time.Now()
+	var session models.Session
+	if err := s.db.WithContext(ctx).Where("refresh_token_hash = ?", hash).First(&session).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		return nil, s.checkReuse(ctx, hash, now)
+	}
+	if session.Expired(now) {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	newRefreshToken, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	accessToken, err := s.jwtService.GenerateToken(session.UserID, session.ID)
+	if err != nil {
+		return nil, err
+	}
+	previous := session
+	updates := rotateSession(&session, newRefreshToken, accessToken, userAgent, ip, now)
+	// 只有 refresh token 没有被并发轮换或撤销时才更新
+	result := s.db.WithContext(ctx).Model(&models.Session{}).
+		Where("id = ? AND refresh_token_hash = ?", session.ID, hash).
+		Updates(updates)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrInvalidRefreshToken
+	}
+	// 每个会话只有最新的 access token 有效
+	if err := s.deny(ctx, previous.AccessTokenID, previous.AccessTokenExpiresAt); err != nil {
+		slog.Error("failed to deny previous access token", "session_id", session.ID, "error", err)
+	}
+	return &SessionTokens{Session: &session, AccessToken: accessToken, RefreshToken: newRefreshToken}, nil
+}
+
+// rotateSession 轮换会话的 refresh token 和 access token 并延长有效期, 返回需要更新的字段;
+// 旧的 refresh token 保存在 PreviousTokenHash 中用于发现重复使用
+func rotateSession(session *models.Session, refreshToken string, accessToken *AccessToken, userAgent, ip string, now time.Time) map[string]any {
+	session.PreviousTokenHash, session.RefreshTokenHash = session.RefreshTokenHash, hashToken(refreshToken)
+	session.AccessTokenID, session.AccessTokenExpiresAt = accessToken.ID, accessToken.ExpiresAt
+	session.UserAgent, session.IP = userAgent, ip
+	session.ExpiresAt, session.LastUsedAt = now.Add(refreshTokenTTL), now
+	return map[string]any{
+		"refresh_token_hash":      session.RefreshTokenHash,
+		"previous_token_hash":     session.PreviousTokenHash,
+		"access_token_id":         session.AccessTokenID,
+		"access_token_expires_at": session.AccessTokenExpiresAt,
+		"user_agent":              session.UserAgent,
+		"ip":                      session.IP,
+		"expires_at":              session.ExpiresAt,
+		"last_used_at":            session.LastUsedAt,
+	}
+}
+
+// checkReuse 已经轮换过的 refresh token 再次使用, 说明 token 可能被盗用, 撤销整个会话
+func (s *SessionService) checkReuse(ctx context.Context, hash string, now time.Time) error {
+	var session models.Session
+	if err := s.db.WithContext(ctx).Where("previous_token_hash = ?", hash).First(&session).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrInvalidRefreshToken
+		}
+		return err
+	}
+	if !refreshReused(&session, now) {
+		return ErrInvalidRefreshToken
+	}
+	slog.Warn("refresh token reused, revoking session", "session_id", session.ID, "user_id", session.UserID)
+	if err := s.revoke(ctx, s.db.Where("id = ?", session.ID)); err != nil {
+		return err
+	}
+	return ErrInvalidRefreshToken
+}
+
+// refreshReused 轮换后 refreshReuseGrace 内再次使用旧 token 通常是并发刷新, 之后再使用才当作盗用
+func refreshReused(session *models.Session, now time.Time) bool {
+	return now.Sub(session.LastUsedAt) >= refreshReuseGrace
+}
+
+// Revoke 撤销用户的一个会话, 会话不存在时返回 gorm.ErrRecordNotFound
+func (s *SessionService) Revoke(ctx context.Context, userID, sessionID string) error {
+	return s.revoke(ctx, s.db.Where("user_id = ? AND id = ?", userID, sessionID))
+}
+
+// RevokeAll 撤销用户的所有会话, exceptID 不为空时保留这个会话
+func (s *SessionService) RevokeAll(ctx context.Context, userID, exceptID string) error {
+	err := s.revoke(ctx, s.db.Where("user_id = ? AND id <> ?", userID, exceptID))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	return err
+}
+
+// revoke 删除会话并把它们的 access token 加入 denylist;
+// 行锁保证删除前不会有并发的刷新签发新的 access token
+func (s *SessionService) revoke(ctx context.Context, scope *gorm.DB) error {
+	var sessions []models.Session
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(scope).Find(&sessions).Error; err != nil {
+			return err
+		}
+		if len(sessions) == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		ids := make([]string, len(sessions))
+		for i, session := range sessions {
+			ids[i] = session.ID
+		}
+		return tx.Where("id IN ?", ids).Delete(&models.Session{}).Error
+	})
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if err := s.deny(ctx, session.AccessTokenID, session.AccessTokenExpiresAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Denied 检查 access token 是否已经被撤销
+func (s *SessionService) Denied(ctx context.Context, claims *AccessClaims) (bool, error) {
+	n, err := s.rdb.Exists(ctx, denylistKeyPrefix+claims.ID).Result()
+	return n > 0, err
+}
+
+// deny 把 access token 加入 denylist, 直到它过期
+func (s *SessionService) deny(ctx context.Context, jti string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if jti == "" || ttl <= 0 {
+		return nil
+	}
+	return s.rdb.Set(ctx, denylistKeyPrefix+jti, 1, ttl).Err()
+}
+
+// Prune 删除过期的会话
+func (s *SessionService) Prune(ctx context.Context) error {
+	return s.db.WithContext(ctx).Where("expires_at < ?", time.Now()).Delete(&models.Session{}).Error
+}
+
+// randomToken 生成 256 位的随机 token
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"dnsarc/internal/models"
+)
+
+func TestRotateSession(t *testing.T) {
+	jwtService := NewJwtService("test-secret", nil)
+	now := time.Now()
+	first, err := jwtService.GenerateToken("user-1", "session-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	session := models.Session{
+		ID:                   "session-1",
+		UserID:               "user-1",
+		RefreshTokenHash:     hashToken("refresh-1"),
+		AccessTokenID:        first.ID,
+		AccessTokenExpiresAt: first.ExpiresAt,
+		ExpiresAt:            now.Add(time.Hour),
+		LastUsedAt:           now.Add(-time.Hour),
+	}
+	second, err := jwtService.GenerateToken("user-1", "session-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	updates := rotateSession(&session, "refresh-2", second, "agent", "203.0.113.7", now)
+
+	if session.RefreshTokenHash != hashToken("refresh-2") || session.PreviousTokenHash != hashToken("refresh-1") {
+		t.Errorf("hashes = %s, %s, want the new token and the rotated one", session.RefreshTokenHash, session.PreviousTokenHash)
+	}
+	if session.AccessTokenID != second.ID || session.AccessTokenID == first.ID {
+		t.Errorf("access token id = %s, want %s", session.AccessTokenID, second.ID)
+	}
+	if !session.ExpiresAt.Equal(now.Add(refreshTokenTTL)) || !session.LastUsedAt.Equal(now) {
+		t.Errorf("expires at %s, last used at %s, want extended from %s", session.ExpiresAt, session.LastUsedAt, now)
+	}
+	// 更新的字段和内存中的会话一致
+	want := map[string]any{
+		"refresh_token_hash":      session.RefreshTokenHash,
+		"previous_token_hash":     session.PreviousTokenHash,
+		"access_token_id":         session.AccessTokenID,
+		"access_token_expires_at": session.AccessTokenExpiresAt,
+		"user_agent":              "agent",
+		"ip":                      "203.0.113.7",
+		"expires_at":              session.ExpiresAt,
+		"last_used_at":            now,
+	}
+	if len(updates) != len(want) {
+		t.Errorf("updates = %v, want %v", updates, want)
+	}
+	for key, value := range want {
+		if updates[key] != value {
+			t.Errorf("updates[%s] = %v, want %v", key, updates[key], value)
+		}
+	}
+
+	// 再次轮换后最早的 token 不再被记录, 使用时只会被当作无效的 token
+	rotateSession(&session, "refresh-3", second, "agent", "203.0.113.7", now)
+	if session.PreviousTokenHash != hashToken("refresh-2") {
+		t.Errorf("previous hash = %s, want the second token", session.PreviousTokenHash)
+	}
+}
+
+func TestRefreshReused(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		lastUsed time.Duration
+		want     bool
+	}{
+		{"concurrent refresh", 0, false},
+		{"inside grace", refreshReuseGrace - time.Second, false},
+		{"grace ended", refreshReuseGrace, true},
+		{"long after rotation", time.Hour, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := &models.Session{LastUsedAt: now.Add(-tt.lastUsed)}
+			if got := refreshReused(session, now); got != tt.want {
+				t.Errorf("refreshReused() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDenyPreviousAccessToken(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	jwtService := NewJwtService("test-secret", nil)
+	s := &SessionService{rdb: rdb, jwtService: jwtService}
+	ctx := context.Background()
+
+	previous, err := jwtService.GenerateToken("user-1", "session-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, err := jwtService.GenerateToken("user-1", "session-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.deny(ctx, previous.ID, previous.ExpiresAt); err != nil {
+		t.Fatal(err)
+	}
+	previousClaims, err := jwtService.VerifyToken(previous.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if denied, err := s.Denied(ctx, previousClaims); err != nil || !denied {
+		t.Errorf("previous access token Denied() = %v, %v, want true", denied, err)
+	}
+	currentClaims, err := jwtService.VerifyToken(current.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if denied, err := s.Denied(ctx, currentClaims); err != nil || denied {
+		t.Errorf("current access token Denied() = %v, %v, want false", denied, err)
+	}
+	// denylist 只保留到 access token 过期
+	if ttl := mr.TTL(denylistKeyPrefix + previous.ID); ttl <= 0 || ttl > time.Until(previous.ExpiresAt)+time.Second {
+		t.Errorf("denylist ttl = %s, want until %s", ttl, previous.ExpiresAt)
+	}
+	if err := s.deny(ctx, "expired", time.Now().Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.deny(ctx, "", time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if keys := mr.Keys(); len(keys) != 1 {
+		t.Errorf("keys = %v, want only the previous access token", keys)
+	}
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// SigningKey 签名 access token 的非对称密钥, ID 是公钥的 JWK thumbprint (RFC 7638)
+type SigningKey struct {
+	ID      string
+	method  jwt.SigningMethod
+	private crypto.Signer
+	public  crypto.PublicKey
+}
+
+// LoadSigningKeys 读取逗号分隔的 PEM 私钥文件, 第一个用于签名, 其他只用于验证.
+// 轮换时把新密钥放在最前面, 旧密钥保留到它签发的 access token 全部过期
+func LoadSigningKeys(paths string) ([]*SigningKey, error) {
+	var keys []*SigningKey
+	for path := range strings.SplitSeq(paths, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := ParseSigningKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// ParseSigningKey 解析 PEM 格式的私钥, 支持 Ed25519, ECDSA P-256/P-384 和 2048 位以上的 RSA
+func ParseSigningKey(data []byte) (*SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+	var private any
+	var err error
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		private, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		private, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		private, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key := &SigningKey{}
+	switch k := private.(type) {
+	case ed25519.PrivateKey:
+		key.method, key.private = jwt.SigningMethodEdDSA, k
+	case *ecdsa.PrivateKey:
+		switch k.Curve {
+		case elliptic.P256():
+			key.method = jwt.SigningMethodES256
+		case elliptic.P384():
+			key.method = jwt.SigningMethodES384
+		default:
+			return nil, errors.New("unsupported ECDSA curve, use P-256 or P-384")
+		}
+		key.private = k
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < 2048 {
+			return nil, errors.New("RSA key must be at least 2048 bits")
+		}
+		key.method, key.private = jwt.SigningMethodRS256, k
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", private)
+	}
+	key.public = key.private.Public()
+
+	jwk := key.JWK()
+	thumbprint, err := jwk.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+	key.ID = base64.RawURLEncoding.EncodeToString(thumbprint)
+	return key, nil
+}
+
+// JWK 公钥的 JWK 表示
+func (k *SigningKey) JWK() jose.JSONWebKey {
+	return jose.JSONWebKey{
+		Key:       k.public,
+		KeyID:     k.ID,
+		Algorithm: k.method.Alg(),
+		Use:       "sig",
+	}
+}
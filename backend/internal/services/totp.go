@@ -0,0 +1,83 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// TOTP 参数使用 RFC 6238 的默认值, 所有常见的验证器应用都支持
+const (
+	totpPeriod = 30
+	totpDigits = 6
+	// totpSkew 允许前后各一个时间窗口的时钟偏差
+	totpSkew = 1
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret 生成 160 位的随机密钥, 返回 base32 编码
+func GenerateTOTPSecret() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(b), nil
+}
+
+// TOTPURL 验证器应用扫描的 otpauth:// 地址
+func TOTPURL(issuer, account, secret string) string {
+	query := url.Values{
+		"secret":    {secret},
+		"issuer":    {issuer},
+		"algorithm": {"SHA1"},
+		"digits":    {fmt.Sprint(totpDigits)},
+		"period":    {fmt.Sprint(totpPeriod)},
+	}
+	return (&url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: query.Encode(),
+	}).String()
+}
+
+// ValidateTOTP 校验验证码, 成功时返回验证码所在的时间窗口, 用于拒绝重复使用
+func ValidateTOTP(secret, code string, now time.Time) (int64, bool) {
+	key, err := totpEncoding.DecodeString(secret)
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+	step := now.Unix() / totpPeriod
+	for i := -totpSkew; i <= totpSkew; i++ {
+		if subtle.ConstantTimeCompare([]byte(hotp(key, step+int64(i))), []byte(code)) == 1 {
+			return step + int64(i), true
+		}
+	}
+	return 0, false
+}
+
+// validateTOTPAfter 和 ValidateTOTP 相同, 但是不接受 lastStep 及之前的时间窗口, 同一个验证码不能使用两次
+func validateTOTPAfter(secret, code string, lastStep int64, now time.Time) (int64, bool) {
+	step, ok := ValidateTOTP(secret, code, now)
+	if !ok || step <= lastStep {
+		return 0, false
+	}
+	return step, true
+}
+
+// hotp RFC 4226
+func hotp(key []byte, counter int64) string {
+	mac := hmac.New(sha1.New, key)
+	_ = binary.Write(mac, binary.BigEndian, counter)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, value%1_000_000)
+}
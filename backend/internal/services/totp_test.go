@@ -0,0 +1,106 @@
+package services
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+// RFC 6238 附录 B 的 SHA-1 测试向量, 8 位验证码的后 6 位就是 6 位的验证码
+var rfc6238Secret = totpEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestHOTPRFC6238(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		if got := hotp([]byte("12345678901234567890"), tt.unix/totpPeriod); got != tt.want {
+			t.Errorf("hotp(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+		step, ok := ValidateTOTP(rfc6238Secret, tt.want, time.Unix(tt.unix, 0))
+		if !ok || step != tt.unix/totpPeriod {
+			t.Errorf("ValidateTOTP(%d) = %d, %v, want %d, true", tt.unix, step, ok, tt.unix/totpPeriod)
+		}
+	}
+}
+
+func TestValidateTOTPSkew(t *testing.T) {
+	key := []byte("12345678901234567890")
+	now := time.Unix(1111111111, 0)
+	step := now.Unix() / totpPeriod
+	tests := []struct {
+		name   string
+		offset int64
+		ok     bool
+	}{
+		{"current", 0, true},
+		{"previous", -1, true},
+		{"next", 1, true},
+		{"two before", -2, false},
+		{"two after", 2, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ValidateTOTP(rfc6238Secret, hotp(key, step+tt.offset), now)
+			if ok != tt.ok {
+				t.Fatalf("ValidateTOTP() ok = %v, want %v", ok, tt.ok)
+			}
+			if ok && got != step+tt.offset {
+				t.Errorf("step = %d, want %d", got, step+tt.offset)
+			}
+		})
+	}
+	for _, code := range []string{"", "28708", "0287082", "abcdef"} {
+		if _, ok := ValidateTOTP(rfc6238Secret, code, now); ok {
+			t.Errorf("ValidateTOTP(%q) accepted", code)
+		}
+	}
+	if _, ok := ValidateTOTP("not base32!", hotp(key, step), now); ok {
+		t.Error("invalid secret accepted")
+	}
+}
+
+func TestValidateTOTPAfterRejectsReplay(t *testing.T) {
+	key := []byte("12345678901234567890")
+	now := time.Unix(1111111111, 0)
+	step := now.Unix() / totpPeriod
+	code := hotp(key, step)
+
+	got, ok := validateTOTPAfter(rfc6238Secret, code, step-1, now)
+	if !ok || got != step {
+		t.Fatalf("first use = %d, %v, want %d, true", got, ok, step)
+	}
+	// 同一个验证码在时间窗口内再次提交
+	if _, ok := validateTOTPAfter(rfc6238Secret, code, got, now.Add(time.Second*10)); ok {
+		t.Error("replayed code accepted")
+	}
+	// 已经使用了更新的时间窗口后, 旧的验证码也不能再用
+	if _, ok := validateTOTPAfter(rfc6238Secret, hotp(key, step-1), step, now); ok {
+		t.Error("code from an earlier step accepted")
+	}
+	if _, ok := validateTOTPAfter(rfc6238Secret, hotp(key, step+1), step, now); !ok {
+		t.Error("code from the next step rejected")
+	}
+}
+
+func TestTOTPURL(t *testing.T) {
+	u, err := url.Parse(TOTPURL("DNSARC", "user@example.com", rfc6238Secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" || u.Path != "/DNSARC:user@example.com" {
+		t.Errorf("url = %s", u)
+	}
+	query := u.Query()
+	if query.Get("secret") != rfc6238Secret || query.Get("digits") != "6" || query.Get("period") != "30" {
+		t.Errorf("query = %v", query)
+	}
+}
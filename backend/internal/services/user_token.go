@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"dnsarc/internal/models"
+)
+
+var ErrInvalidUserToken = errors.New("invalid or expired token")
+
+// UserTokenService 管理通过邮件发送的一次性 token
+type UserTokenService struct {
+	db *gorm.DB
+}
+
+func NewUserTokenService(db *gorm.DB) *UserTokenService {
+	return &UserTokenService{db: db}
+}
+
+// Issue 生成新的 token, 同一用途之前的 token 全部失效
+func (s *UserTokenService) Issue(ctx context.Context, userID string, purpose models.UserTokenPurpose, ttl time.Duration) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ? AND purpose = ? AND used_at IS NULL", userID, purpose).Delete(&models.UserToken{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.UserToken{
+			UserID:    userID,
+			Purpose:   purpose,
+			TokenHash: hashToken(token),
+			ExpiresAt: time.Now().Add(ttl),
+		}).Error
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// Consume 校验并使用 token, 每个 token 只能成功使用一次
+func (s *UserTokenService) Consume(ctx context.Context, token string, purpose models.UserTokenPurpose) (*models.UserToken, error) {
+	var userToken models.UserToken
+	if err := s.db.WithContext(ctx).Where("token_hash = ? AND purpose = ?", hashToken(token), purpose).First(&userToken).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidUserToken
+		}
+		return nil, err
+	}
+	now := time.Now()
+	// 使用条件更新, 并发的请求只有一个可以成功
+	result := s.db.WithContext(ctx).Model(&models.UserToken{}).
+		Where("id = ? AND used_at IS NULL AND expires_at > ?", userToken.ID, now).
+		Update("used_at", now)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrInvalidUserToken
+	}
+	userToken.UsedAt = &now
+	return &userToken, nil
+}
+
+// Prune 删除过期的 token
+func (s *UserTokenService) Prune(ctx context.Context) error {
+	return s.db.WithContext(ctx).Where("expires_at < ?", time.Now().Add(-time.Hour*24)).Delete(&models.UserToken{}).Error
+}
@@ -0,0 +1,224 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"dnsarc/internal/event"
+	"dnsarc/internal/models"
+	"dnsarc/internal/resolver"
+)
+
+var (
+	ErrZoneSuspended       = errors.New("zone is suspended")
+	ErrZoneActiveElsewhere = errors.New("zone is active for another owner, takeover required")
+)
+
+// ZoneChecker 检查 zone 的 NS 委派, 维护 zone 的激活状态
+type ZoneChecker struct {
+	db                 *gorm.DB
+	rdb                *redis.Client
+	resolver           *resolver.Resolver
+	verifier           *ZoneVerifier
+	defaultNameservers []string
+}
+
+func NewZoneChecker(db *gorm.DB, rdb *redis.Client, resolver *resolver.Resolver, defaultNameservers ...string) *ZoneChecker {
+	return &ZoneChecker{
+		db:                 db,
+		rdb:                rdb,
+		resolver:           resolver,
+		verifier:           NewZoneVerifier(resolver),
+		defaultNameservers: defaultNameservers,
+	}
+}
+
+// Check 检查 zone 的 NS 委派, 记录检查结果并更新 zone 状态
+//
+// 状态变化:
+//   - NS 指向我们 (且没有被其他用户占用) -> active
+//   - NS 没有指向我们: 之前委派给我们的 active zone -> deactivated, 其他 -> ns_mismatch
+//   - TXT 验证激活但还没有切换 NS 的 zone, TXT 记录仍然存在时保持 active, 否则 -> ns_mismatch
+//   - 查询失败 (包括自定义 NS 检查失败) 不改变状态, 只记录错误
+func (c *ZoneChecker) Check(ctx context.Context, zone *models.Zone) (*models.ZoneCheck, error) {
+	if zone.Status == models.ZoneStatusSuspended {
+		return nil, ErrZoneSuspended
+	}
+	now := time.Now()
+	check := &models.ZoneCheck{
+		ZoneID:     zone.ID,
+		Status:     zone.Status,
+		ObservedNS: make([]string, 0),
+		CheckedAt:  now,
+	}
+	previous := zone.Status
+	delegated := zone.Delegated
+
+	// 先切换已经生效的自定义 NS, 注册商可能已经改成了新的 NS
+	if _, err := c.ApplyPendingNameservers(ctx, zone); err != nil {
+		return nil, err
+	}
+
+	// 从根开始迭代查询, 得到注册商实际配置的委派
+	ns, err := c.resolver.LookupDelegation(ctx, zone.ZoneName)
+	if err != nil && !errors.Is(err, resolver.ErrNotDelegated) {
+		slog.Warn("failed to lookup ns", "zone", zone.ZoneName, "error", err)
+		check.Error = err.Error()
+	} else {
+		check.ObservedNS = append(check.ObservedNS, ns...)
+		delegated, err = c.isDelegated(ctx, zone, check.ObservedNS)
+		switch {
+		case err != nil:
+			check.Error = err.Error()
+			delegated = zone.Delegated
+		case delegated:
+			// 同名 zone 已经被其他用户激活时, 需要通过 TXT 验证接管, 在保存时检查
+			check.Status = models.ZoneStatusActive
+		case zone.Status == models.ZoneStatusActive && zone.Delegated:
+			slog.Info("zone delegation moved away, deactivating", "zone", zone.ZoneName, "ns", check.ObservedNS)
+			check.Status = models.ZoneStatusDeactivated
+		case zone.Status == models.ZoneStatusActive:
+			// TXT 验证激活, 还在等待 NS 切换, 每次检查都重新验证 TXT 记录
+			if err := c.verifier.VerifyTXT(ctx, *zone); err != nil {
+				check.Error = err.Error()
+				if errors.Is(err, ErrVerificationRecordNotFound) {
+					slog.Info("zone verification record removed, deactivating", "zone", zone.ZoneName)
+					check.Status = models.ZoneStatusNSMismatch
+				}
+			}
+		default:
+			check.Status = models.ZoneStatusNSMismatch
+		}
+	}
+
+	err = c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if check.Status == models.ZoneStatusActive && !zone.IsActive {
+			activeElsewhere, err := ZoneActiveElsewhere(tx, zone)
+			if err != nil {
+				return err
+			}
+			if activeElsewhere {
+				check.Status = zone.Status
+				check.Error = ErrZoneActiveElsewhere.Error()
+			}
+		}
+		zone.Status = check.Status
+		zone.IsActive = check.Status == models.ZoneStatusActive
+		zone.ObservedNS = check.ObservedNS
+		zone.Delegated = delegated
+		zone.LastCheckedAt = &now
+		zone.LastCheckError = check.Error
+		if err := tx.Create(check).Error; err != nil {
+			return err
+		}
+		return tx.Model(zone).Select("status", "is_active", "observed_ns", "delegated", "last_checked_at", "last_check_error").Updates(zone).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if previous != check.Status {
+		slog.Info("zone status changed", "zone", zone.ZoneName, "from", previous, "to", check.Status)
+		switch {
+		case check.Status == models.ZoneStatusActive:
+			go func() {
+				event.PublishEvent(context.WithoutCancel(ctx), c.rdb, event.Event{
+					Type:     event.EventTypeZoneCreate,
+					ZoneName: zone.ZoneName,
+				})
+			}()
+		case previous == models.ZoneStatusActive:
+			go func() {
+				event.PublishEvent(context.WithoutCancel(ctx), c.rdb, event.Event{
+					Type:     event.EventTypeZoneDelete,
+					ZoneName: zone.ZoneName,
+				})
+			}()
+		}
+	}
+	return check, nil
+}
+
+// ApplyPendingNameservers 待生效的自定义 NS 都指向默认 NS 的地址后替换 Nameservers, 返回是否已经替换
+//
+// 检查没有通过时保留 PendingNameservers, 下次检查时重试
+func (c *ZoneChecker) ApplyPendingNameservers(ctx context.Context, zone *models.Zone) (bool, error) {
+	if len(zone.PendingNameservers) == 0 {
+		return false, nil
+	}
+	pending := *zone
+	pending.Nameservers = zone.PendingNameservers
+	if ok, _ := c.checkVanityNameservers(ctx, &pending); !ok {
+		return false, nil
+	}
+	slog.Info("pending nameservers applied", "zone", zone.ZoneName, "nameservers", zone.PendingNameservers)
+	zone.Nameservers = zone.PendingNameservers
+	zone.PendingNameservers = nil
+	if err := c.db.WithContext(ctx).Model(zone).Select("nameservers", "pending_nameservers").Updates(zone).Error; err != nil {
+		return false, err
+	}
+	go func() {
+		event.PublishEvent(context.WithoutCancel(ctx), c.rdb, event.Event{
+			Type:     event.EventTypeZoneUpdate,
+			ZoneName: zone.ZoneName,
+		})
+	}()
+	return true, nil
+}
+
+// ZoneActiveElsewhere 锁定同名的所有 zone, 检查是否已经被其他 zone 激活
+//
+// 必须和激活在同一个事务中, 两个组织同时激活时后一个会等待前一个提交; zones 上 zone_name 的部分唯一索引是最后的保障
+func ZoneActiveElsewhere(tx *gorm.DB, zone *models.Zone) (bool, error) {
+	var zones []models.Zone
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "is_active").Where("zone_name = ?", zone.ZoneName).Order("id").Find(&zones).Error; err != nil {
+		return false, err
+	}
+	return lo.ContainsBy(zones, func(z models.Zone) bool {
+		return z.IsActive && z.ID != zone.ID
+	}), nil
+}
+
+// isDelegated 检查观察到的 NS 是否指向我们
+func (c *ZoneChecker) isDelegated(ctx context.Context, zone *models.Zone, observed []string) (bool, error) {
+	expected := zone.EffectiveNameservers(c.defaultNameservers...)
+	if len(lo.Intersect(expected, observed)) == 0 {
+		return false, nil
+	}
+	if len(zone.Nameservers) > 0 {
+		return c.checkVanityNameservers(ctx, zone)
+	}
+	return true, nil
+}
+
+// checkVanityNameservers 检查自定义 NS 是否都指向默认 NS 的地址
+func (c *ZoneChecker) checkVanityNameservers(ctx context.Context, zone *models.Zone) (bool, error) {
+	ourAddrs := make([]string, 0)
+	for _, ns := range c.defaultNameservers {
+		addrs, err := c.resolver.LookupHost(ctx, ns)
+		if err != nil {
+			slog.Warn("failed to lookup default nameserver", "ns", ns, "error", err)
+			return false, err
+		}
+		ourAddrs = append(ourAddrs, addrs...)
+	}
+	for _, ns := range zone.Nameservers {
+		addrs, err := c.resolver.LookupHost(ctx, ns)
+		if err != nil || len(addrs) == 0 {
+			slog.Warn("failed to lookup vanity nameserver", "zone", zone.ZoneName, "ns", ns, "error", err)
+			return false, errors.New("vanity nameserver " + ns + " does not resolve")
+		}
+		if extra, _ := lo.Difference(addrs, ourAddrs); len(extra) > 0 {
+			slog.Warn("vanity nameserver does not point to us", "zone", zone.ZoneName, "ns", ns, "addrs", extra)
+			return false, errors.New("vanity nameserver " + ns + " does not point to our nameservers")
+		}
+	}
+	return true, nil
+}
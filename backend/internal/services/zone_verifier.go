@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"dnsarc/internal/models"
+	"dnsarc/internal/resolver"
+)
+
+var ErrVerificationRecordNotFound = errors.New("verification record not found")
+
+// ZoneVerifier 通过 TXT 记录验证用户对 zone 的所有权
+type ZoneVerifier struct {
+	resolver *resolver.Resolver
+}
+
+func NewZoneVerifier(resolver *resolver.Resolver) *ZoneVerifier {
+	return &ZoneVerifier{resolver: resolver}
+}
+
+// GenerateVerificationToken 生成随机的验证 token
+func GenerateVerificationToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// VerifyTXT 查询 _dnsarc-challenge.<zone> 的 TXT 记录, 检查是否包含 zone 的验证 token
+func (v *ZoneVerifier) VerifyTXT(ctx context.Context, zone models.Zone) error {
+	if zone.VerificationToken == "" {
+		return errors.New("zone has no verification token")
+	}
+	txts, err := v.resolver.LookupTXT(ctx, zone.VerificationRecordName())
+	if err != nil {
+		if errors.Is(err, resolver.ErrNXDomain) {
+			return ErrVerificationRecordNotFound
+		}
+		return fmt.Errorf("failed to query verification record: %w", err)
+	}
+	if lo.Contains(txts, zone.VerificationValue()) {
+		return nil
+	}
+	return ErrVerificationRecordNotFound
+}
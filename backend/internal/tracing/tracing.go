@@ -0,0 +1,67 @@
+package tracing
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "dnsarc"
+
+// Setup 初始化全局的 TracerProvider 和 propagator, 返回关闭时调用的 shutdown
+//
+// 只有配置了 endpoint (OTEL_EXPORTER_OTLP_ENDPOINT, 如 http://localhost:4318) 时才通过 OTLP/HTTP 导出,
+// 采样率等其他配置使用 OTEL_* 标准环境变量, 如 OTEL_TRACES_SAMPLER=parentbased_traceidratio
+func Setup(ctx context.Context, serviceName, endpoint string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// OTEL_SERVICE_NAME 和 OTEL_RESOURCE_ATTRIBUTES 可以覆盖默认的服务名
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceName(serviceName)),
+		resource.WithFromEnv(),
+		resource.WithHost(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	slog.Info("tracing enabled", "service", serviceName, "endpoint", endpoint)
+	return provider.Shutdown, nil
+}
+
+// Start 使用全局的 TracerProvider 创建 span
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// Inject 将 ctx 中的 trace context 写入 carrier, 用于跨进程传递
+func Inject(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+	return carrier
+}
+
+// Extract 从 carrier 中恢复 trace context
+func Extract(ctx context.Context, carrier map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(carrier))
+}
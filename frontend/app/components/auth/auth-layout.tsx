@@ -0,0 +1,32 @@
+import { ArrowLeftIcon } from "lucide-react";
+import type { ReactNode } from "react";
+import { Link } from "react-router";
+import { Button } from "~/components/ui/button";
+
+export function AuthLayout({
+	title,
+	description,
+	children,
+}: {
+	title: string;
+	description: string;
+	children: ReactNode;
+}) {
+	return (
+		<div className="h-dvh flex flex-col p-4">
+			<div>
+				<Button variant="ghost" asChild>
+					<Link to="/auth" viewTransition>
+						<ArrowLeftIcon />
+						Back to sign in
+					</Link>
+				</Button>
+			</div>
+			<div className="flex flex-col items-center justify-center flex-1">
+				<h1 className="text-xl font-bold mb-4">{title}</h1>
+				<p className="text-muted-foreground mb-8">{description}</p>
+				{children}
+			</div>
+		</div>
+	);
+}
@@ -0,0 +1,128 @@
+import { useMutation, useQuery, useQueryClient } from "@tanstack/react-query";
+import { LinkIcon, UnlinkIcon } from "lucide-react";
+import { useEffect, useRef } from "react";
+import { useSearchParams } from "react-router";
+import { toast } from "sonner";
+import { ProviderIcon } from "~/components/auth/provider-icon";
+import { Button } from "~/components/ui/button";
+import { authClient } from "~/connect";
+import { errorMessage } from "~/lib/errors";
+
+export function LinkedAccounts() {
+	const queryClient = useQueryClient();
+	const [searchParams, setSearchParams] = useSearchParams();
+	const { data: providers } = useQuery({
+		queryKey: ["login-providers"],
+		queryFn: async () => {
+			const res = await authClient.listLoginProviders({});
+			return res.providers;
+		},
+	});
+	const { data: identities } = useQuery({
+		queryKey: ["identities"],
+		queryFn: async () => {
+			const res = await authClient.listIdentities({});
+			return res.identities;
+		},
+	});
+	const link = useMutation({
+		mutationFn: async (provider: string) => {
+			const res = await authClient.getLinkURL({ provider });
+			window.location.href = res.url;
+		},
+		onError(err) {
+			toast.error(errorMessage(err));
+		},
+	});
+	const unlink = useMutation({
+		mutationFn: async (id: string) => {
+			await authClient.unlinkIdentity({ id });
+		},
+		onSuccess() {
+			queryClient.invalidateQueries({ queryKey: ["identities"] });
+		},
+		onError(err) {
+			toast.error(errorMessage(err));
+		},
+	});
+	const completeLink = useMutation({
+		mutationFn: async (code: string) => {
+			await authClient.completeLink({ code });
+		},
+		onSuccess() {
+			toast.success("Account linked");
+			queryClient.invalidateQueries({ queryKey: ["identities"] });
+		},
+		onError(err) {
+			toast.error(errorMessage(err));
+		},
+	});
+	const { mutate: mutateCompleteLink } = completeLink;
+	// the link code is single-use, so submit each one only once even if the effect runs twice
+	const submittedCode = useRef("");
+	// the API server redirects back here with a one-time code after linking
+	useEffect(() => {
+		const code = searchParams.get("link_code");
+		const error = searchParams.get("error");
+		if (!code && !error) {
+			return;
+		}
+		if (code && submittedCode.current !== code) {
+			submittedCode.current = code;
+			mutateCompleteLink(code);
+		} else if (error) {
+			toast.error(error);
+		}
+		setSearchParams({}, { replace: true });
+	}, [searchParams, setSearchParams, mutateCompleteLink]);
+
+	return (
+		<div className="flex flex-col gap-2 p-4 border rounded-2xl">
+			<h2 className="text-sm font-bold">Linked accounts</h2>
+			{providers?.map((provider) => {
+				const linked = identities?.filter(
+					(identity) => identity.provider === provider.name,
+				);
+				return (
+					<div
+						key={provider.name}
+						className="flex items-center justify-between gap-2"
+					>
+						<div className="flex items-center gap-2 text-sm">
+							<ProviderIcon name={provider.name} />
+							<span className="font-medium">{provider.displayName}</span>
+							<span className="text-muted-foreground">
+								{linked?.map((identity) => identity.email).join(", ")}
+							</span>
+						</div>
+						{linked?.length ? (
+							<Button
+								size="sm"
+								variant="ghost"
+								disabled={unlink.isPending}
+								onClick={() => {
+									for (const identity of linked) {
+										unlink.mutate(identity.id);
+									}
+								}}
+							>
+								<UnlinkIcon className="size-4" />
+								Unlink
+							</Button>
+						) : (
+							<Button
+								size="sm"
+								variant="outline"
+								disabled={link.isPending}
+								onClick={() => link.mutate(provider.name)}
+							>
+								<LinkIcon className="size-4" />
+								Link
+							</Button>
+						)}
+					</div>
+				);
+			})}
+		</div>
+	);
+}
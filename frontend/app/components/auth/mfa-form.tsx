@@ -0,0 +1,140 @@
+import { zodResolver } from "@hookform/resolvers/zod";
+import { useMutation } from "@tanstack/react-query";
+import { KeyRoundIcon } from "lucide-react";
+import { useState } from "react";
+import { useForm } from "react-hook-form";
+import { toast } from "sonner";
+import { z } from "zod";
+import { Button } from "~/components/ui/button";
+import {
+	Form,
+	FormControl,
+	FormField,
+	FormItem,
+	FormMessage,
+} from "~/components/ui/form";
+import { Input } from "~/components/ui/input";
+import { errorMessage } from "~/lib/errors";
+import { getAssertion } from "~/lib/webauthn";
+
+export interface MFAFactor {
+	totpCode?: string;
+	recoveryCode?: string;
+	webauthnResponseJson?: string;
+}
+
+const schema = z.object({
+	code: z.string().trim().min(6, { message: "Enter your code." }).max(32),
+});
+
+// MFAForm asks for a second factor: an authenticator code, a recovery code or a security key
+export function MFAForm({
+	totp,
+	webauthn,
+	beginWebAuthn,
+	onVerify,
+}: {
+	totp: boolean;
+	webauthn: boolean;
+	beginWebAuthn: () => Promise<string>;
+	onVerify: (factor: MFAFactor) => Promise<void>;
+}) {
+	const [recovery, setRecovery] = useState(!totp && !webauthn);
+	const form = useForm<z.infer<typeof schema>>({
+		resolver: zodResolver(schema),
+		defaultValues: {
+			code: "",
+		},
+	});
+	const verify = useMutation({
+		mutationFn: onVerify,
+		onError(err) {
+			form.reset();
+			toast.error(errorMessage(err));
+		},
+	});
+	const securityKey = useMutation({
+		mutationFn: async () => {
+			const options = await beginWebAuthn();
+			await onVerify({ webauthnResponseJson: await getAssertion(options) });
+		},
+		onError(err) {
+			toast.error(errorMessage(err));
+		},
+	});
+	const pending = verify.isPending || securityKey.isPending;
+	const showCode = totp || recovery;
+	return (
+		<div className="w-full max-w-sm space-y-3">
+			{webauthn && (
+				<Button
+					size="lg"
+					className="w-full h-12"
+					disabled={pending}
+					onClick={() => securityKey.mutate()}
+				>
+					<KeyRoundIcon />
+					Use security key
+				</Button>
+			)}
+			{showCode && (
+				<Form {...form}>
+					<form
+						onSubmit={form.handleSubmit(({ code }) =>
+							verify.mutate(
+								recovery ? { recoveryCode: code } : { totpCode: code },
+							),
+						)}
+						className="space-y-3"
+					>
+						<FormField
+							control={form.control}
+							name="code"
+							render={({ field }) => (
+								<FormItem>
+									<FormControl>
+										<Input
+											{...field}
+											autoFocus
+											autoComplete="one-time-code"
+											inputMode={recovery ? "text" : "numeric"}
+											placeholder={
+												recovery
+													? "Recovery code"
+													: "6-digit code from your app"
+											}
+										/>
+									</FormControl>
+									<FormMessage />
+								</FormItem>
+							)}
+						/>
+						<Button
+							type="submit"
+							size="lg"
+							variant={webauthn ? "outline" : "default"}
+							className="w-full h-12"
+							disabled={pending}
+						>
+							Verify
+						</Button>
+					</form>
+				</Form>
+			)}
+			{(totp || webauthn) && (
+				<div className="text-sm text-muted-foreground text-center">
+					<button
+						type="button"
+						className="hover:underline"
+						onClick={() => {
+							form.reset();
+							setRecovery(!recovery);
+						}}
+					>
+						{recovery ? "Use another method" : "Use a recovery code"}
+					</button>
+				</div>
+			)}
+		</div>
+	);
+}
@@ -0,0 +1,132 @@
+import { zodResolver } from "@hookform/resolvers/zod";
+import { useMutation } from "@tanstack/react-query";
+import { useState } from "react";
+import { useForm } from "react-hook-form";
+import { Link, useNavigate } from "react-router";
+import { toast } from "sonner";
+import { z } from "zod";
+import { Button } from "~/components/ui/button";
+import {
+	Form,
+	FormControl,
+	FormField,
+	FormItem,
+	FormMessage,
+} from "~/components/ui/form";
+import { Input } from "~/components/ui/input";
+import { authClient } from "~/connect";
+import { errorMessage } from "~/lib/errors";
+import { useAuthStore } from "~/stores/auth";
+
+const schema = z.object({
+	email: z.email({ message: "Invalid email address." }),
+	password: z
+		.string()
+		.min(8, { message: "Password must be at least 8 characters." })
+		.max(128),
+});
+
+export function PasswordLoginForm() {
+	const [mode, setMode] = useState<"login" | "register">("login");
+	const navigate = useNavigate();
+	const form = useForm<z.infer<typeof schema>>({
+		resolver: zodResolver(schema),
+		defaultValues: {
+			email: "",
+			password: "",
+		},
+	});
+	const mutation = useMutation({
+		mutationFn: async (values: z.infer<typeof schema>) => {
+			if (mode === "register") {
+				await authClient.register(values);
+				return null;
+			}
+			return await authClient.login(values);
+		},
+		onSuccess(response) {
+			if (response?.mfa) {
+				navigate("/auth/mfa", { state: { challenge: response.mfa } });
+				return;
+			}
+			if (response) {
+				useAuthStore.getState().signIn(response.token, response.refreshToken);
+				toast.success("Login successful");
+				navigate("/dash");
+				return;
+			}
+			form.reset();
+			toast.success("Check your email to verify your account");
+		},
+		onError(err) {
+			toast.error(errorMessage(err));
+		},
+	});
+	return (
+		<Form {...form}>
+			<form
+				onSubmit={form.handleSubmit((values) => mutation.mutate(values))}
+				className="w-full max-w-sm space-y-3"
+			>
+				<FormField
+					control={form.control}
+					name="email"
+					render={({ field }) => (
+						<FormItem>
+							<FormControl>
+								<Input
+									{...field}
+									type="email"
+									autoComplete="email"
+									placeholder="you@example.com"
+								/>
+							</FormControl>
+							<FormMessage />
+						</FormItem>
+					)}
+				/>
+				<FormField
+					control={form.control}
+					name="password"
+					render={({ field }) => (
+						<FormItem>
+							<FormControl>
+								<Input
+									{...field}
+									type="password"
+									autoComplete={
+										mode === "login" ? "current-password" : "new-password"
+									}
+									placeholder="Password"
+								/>
+							</FormControl>
+							<FormMessage />
+						</FormItem>
+					)}
+				/>
+				<Button
+					type="submit"
+					size="lg"
+					className="w-full h-12"
+					disabled={mutation.isPending}
+				>
+					{mode === "login" ? "Sign in" : "Create account"}
+				</Button>
+				<div className="flex justify-between text-sm text-muted-foreground">
+					<button
+						type="button"
+						className="hover:underline"
+						onClick={() => setMode(mode === "login" ? "register" : "login")}
+					>
+						{mode === "login"
+							? "Create an account"
+							: "Already have an account?"}
+					</button>
+					<Link to="/auth/forgot-password" className="hover:underline">
+						Forgot password?
+					</Link>
+				</div>
+			</form>
+		</Form>
+	);
+}
@@ -0,0 +1,14 @@
+import { KeyRoundIcon } from "lucide-react";
+import { FaGithub } from "react-icons/fa";
+import { FcGoogle } from "react-icons/fc";
+
+export function ProviderIcon({ name }: { name: string }) {
+	switch (name) {
+		case "google":
+			return <FcGoogle className="size-4" />;
+		case "github":
+			return <FaGithub className="size-4" />;
+		default:
+			return <KeyRoundIcon className="size-4" />;
+	}
+}
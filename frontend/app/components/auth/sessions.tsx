@@ -0,0 +1,94 @@
+import { useMutation, useQuery, useQueryClient } from "@tanstack/react-query";
+import { LogOutIcon, MonitorSmartphoneIcon } from "lucide-react";
+import { toast } from "sonner";
+import { Badge } from "~/components/ui/badge";
+import { Button } from "~/components/ui/button";
+import { authClient } from "~/connect";
+import { errorMessage } from "~/lib/errors";
+
+export function Sessions() {
+	const queryClient = useQueryClient();
+	const { data: sessions } = useQuery({
+		queryKey: ["sessions"],
+		queryFn: async () => {
+			const res = await authClient.listSessions({});
+			return res.sessions;
+		},
+	});
+	const revoke = useMutation({
+		mutationFn: async (id: string) => {
+			await authClient.revokeSession({ id });
+		},
+		onSuccess() {
+			queryClient.invalidateQueries({ queryKey: ["sessions"] });
+		},
+		onError(err) {
+			toast.error(errorMessage(err));
+		},
+	});
+	const revokeOthers = useMutation({
+		mutationFn: async () => {
+			await authClient.revokeOtherSessions({});
+		},
+		onSuccess() {
+			toast.success("Signed out of all other devices");
+			queryClient.invalidateQueries({ queryKey: ["sessions"] });
+		},
+		onError(err) {
+			toast.error(errorMessage(err));
+		},
+	});
+	const hasOthers = sessions?.some((session) => !session.current);
+
+	return (
+		<div className="flex flex-col gap-2 p-4 border rounded-2xl">
+			<div className="flex items-center justify-between gap-2">
+				<h2 className="text-sm font-bold">Sessions</h2>
+				{hasOthers && (
+					<Button
+						size="sm"
+						variant="outline"
+						disabled={revokeOthers.isPending}
+						onClick={() => revokeOthers.mutate()}
+					>
+						<LogOutIcon className="size-4" />
+						Sign out other devices
+					</Button>
+				)}
+			</div>
+			{sessions?.map((session) => (
+				<div
+					key={session.id}
+					className="flex items-center justify-between gap-2"
+				>
+					<div className="flex items-center gap-2 text-sm min-w-0">
+						<MonitorSmartphoneIcon className="size-4 shrink-0" />
+						<div className="flex flex-col min-w-0">
+							<span className="font-medium truncate">
+								{session.userAgent || "Unknown device"}
+							</span>
+							<span className="text-xs text-muted-foreground">
+								{session.ip} · last active{" "}
+								{new Date(session.lastUsedAt).toLocaleString()}
+							</span>
+						</div>
+						{session.current && (
+							<Badge variant="secondary">This device</Badge>
+						)}
+					</div>
+					{!session.current && (
+						<Button
+							size="sm"
+							variant="ghost"
+							disabled={revoke.isPending}
+							onClick={() => revoke.mutate(session.id)}
+						>
+							<LogOutIcon className="size-4" />
+							Sign out
+						</Button>
+					)}
+				</div>
+			))}
+		</div>
+	);
+}
@@ -0,0 +1,108 @@
+import { Code, ConnectError } from "@connectrpc/connect";
+import { useQuery } from "@tanstack/react-query";
+import {
+	createContext,
+	type ReactNode,
+	useCallback,
+	useContext,
+	useState,
+} from "react";
+import { MFAForm } from "~/components/auth/mfa-form";
+import {
+	Dialog,
+	DialogContent,
+	DialogDescription,
+	DialogHeader,
+	DialogTitle,
+} from "~/components/ui/dialog";
+import { mfaClient } from "~/connect";
+
+// the server rejects destructive calls with this message until the session confirms a second factor
+const STEP_UP_REQUIRED = "two-factor confirmation required";
+
+type WithStepUp = <T>(action: () => Promise<T>) => Promise<T>;
+
+const StepUpContext = createContext<WithStepUp | null>(null);
+
+interface Pending {
+	resolve: () => void;
+	reject: (err: Error) => void;
+}
+
+// StepUpProvider asks for a second factor when a destructive call needs it, then retries the call
+export function StepUpProvider({ children }: { children: ReactNode }) {
+	const [pending, setPending] = useState<Pending | null>(null);
+	const { data: status } = useQuery({
+		queryKey: ["mfa-status"],
+		queryFn: () => mfaClient.getMFAStatus({}),
+		enabled: !!pending,
+	});
+	const withStepUp = useCallback<WithStepUp>(async (action) => {
+		try {
+			return await action();
+		} catch (err) {
+			if (
+				!(err instanceof ConnectError) ||
+				err.code !== Code.PermissionDenied ||
+				err.rawMessage !== STEP_UP_REQUIRED
+			) {
+				throw err;
+			}
+			await new Promise<void>((resolve, reject) => {
+				setPending({ resolve, reject });
+			});
+			return await action();
+		}
+	}, []);
+
+	return (
+		<StepUpContext.Provider value={withStepUp}>
+			{children}
+			<Dialog
+				open={!!pending}
+				onOpenChange={(open) => {
+					if (!open) {
+						pending?.reject(new Error("Two-factor confirmation was cancelled"));
+						setPending(null);
+					}
+				}}
+			>
+				<DialogContent>
+					<DialogHeader>
+						<DialogTitle>Confirm it's you</DialogTitle>
+						<DialogDescription>
+							This action needs two-factor confirmation.
+						</DialogDescription>
+					</DialogHeader>
+					{status && (
+						<MFAForm
+							totp={status.totpEnabled}
+							webauthn={
+								status.webauthnAvailable &&
+								status.webauthnCredentials.length > 0
+							}
+							beginWebAuthn={async () => {
+								const res = await mfaClient.beginStepUp({});
+								return res.webauthnOptionsJson;
+							}}
+							onVerify={async (factor) => {
+								await mfaClient.stepUp(factor);
+								pending?.resolve();
+								setPending(null);
+							}}
+						/>
+					)}
+				</DialogContent>
+			</Dialog>
+		</StepUpContext.Provider>
+	);
+}
+
+// useStepUp wraps a call so that it is retried after a successful two-factor confirmation
+export function useStepUp() {
+	const withStepUp = useContext(StepUpContext);
+	if (!withStepUp) {
+		throw new Error("useStepUp must be used within a StepUpProvider");
+	}
+	return withStepUp;
+}
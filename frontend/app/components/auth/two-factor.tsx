@@ -0,0 +1,234 @@
+import { useMutation, useQuery, useQueryClient } from "@tanstack/react-query";
+import {
+	KeyRoundIcon,
+	RefreshCwIcon,
+	ShieldCheckIcon,
+	SmartphoneIcon,
+	TrashIcon,
+} from "lucide-react";
+import { useState } from "react";
+import { toast } from "sonner";
+import { useStepUp } from "~/components/auth/step-up";
+import { Button } from "~/components/ui/button";
+import { Input } from "~/components/ui/input";
+import { mfaClient } from "~/connect";
+import { errorMessage } from "~/lib/errors";
+import { createCredential } from "~/lib/webauthn";
+
+export function TwoFactor() {
+	const queryClient = useQueryClient();
+	const withStepUp = useStepUp();
+	const [enrollment, setEnrollment] = useState<{
+		secret: string;
+		otpauthUrl: string;
+	} | null>(null);
+	const [code, setCode] = useState("");
+	const [keyName, setKeyName] = useState("");
+	// recovery codes are only shown once, right after they are generated
+	const [recoveryCodes, setRecoveryCodes] = useState<string[]>([]);
+	const { data: status } = useQuery({
+		queryKey: ["mfa-status"],
+		queryFn: () => mfaClient.getMFAStatus({}),
+	});
+	const onSuccess = (codes?: string[]) => {
+		if (codes?.length) {
+			setRecoveryCodes(codes);
+		}
+		queryClient.invalidateQueries({ queryKey: ["mfa-status"] });
+	};
+	const onError = (err: Error) => {
+		toast.error(errorMessage(err));
+	};
+
+	const beginTOTP = useMutation({
+		mutationFn: () => withStepUp(() => mfaClient.beginTOTPEnrollment({})),
+		onSuccess(res) {
+			setEnrollment(res);
+			setCode("");
+		},
+		onError,
+	});
+	const confirmTOTP = useMutation({
+		mutationFn: () => mfaClient.confirmTOTPEnrollment({ code }),
+		onSuccess(res) {
+			setEnrollment(null);
+			toast.success("Authenticator app enabled");
+			onSuccess(res.recoveryCodes);
+		},
+		onError,
+	});
+	const disableTOTP = useMutation({
+		mutationFn: () => withStepUp(() => mfaClient.disableTOTP({})),
+		onSuccess: () => onSuccess(),
+		onError,
+	});
+	const addKey = useMutation({
+		mutationFn: async () => {
+			const { optionsJson } = await withStepUp(() =>
+				mfaClient.beginWebAuthnRegistration({}),
+			);
+			return await mfaClient.finishWebAuthnRegistration({
+				name: keyName,
+				responseJson: await createCredential(optionsJson),
+			});
+		},
+		onSuccess(res) {
+			setKeyName("");
+			toast.success("Security key added");
+			onSuccess(res.recoveryCodes);
+		},
+		onError,
+	});
+	const removeKey = useMutation({
+		mutationFn: (id: string) =>
+			withStepUp(() => mfaClient.deleteWebAuthnCredential({ id })),
+		onSuccess: () => onSuccess(),
+		onError,
+	});
+	const regenerate = useMutation({
+		mutationFn: () => withStepUp(() => mfaClient.regenerateRecoveryCodes({})),
+		onSuccess: (res) => onSuccess(res.recoveryCodes),
+		onError,
+	});
+
+	if (!status) {
+		return null;
+	}
+	const enabled = status.totpEnabled || status.webauthnCredentials.length > 0;
+	return (
+		<div className="flex flex-col gap-3 p-4 border rounded-2xl">
+			<h2 className="text-sm font-bold">Two-factor authentication</h2>
+			<div className="flex items-center justify-between gap-2">
+				<div className="flex items-center gap-2 text-sm">
+					<SmartphoneIcon className="size-4" />
+					<span className="font-medium">Authenticator app</span>
+					{status.totpEnabled && (
+						<ShieldCheckIcon className="size-4 text-green-600" />
+					)}
+				</div>
+				{status.totpEnabled ? (
+					<Button
+						size="sm"
+						variant="ghost"
+						disabled={disableTOTP.isPending}
+						onClick={() => disableTOTP.mutate()}
+					>
+						Disable
+					</Button>
+				) : (
+					<Button
+						size="sm"
+						variant="outline"
+						disabled={beginTOTP.isPending || !!enrollment}
+						onClick={() => beginTOTP.mutate()}
+					>
+						Set up
+					</Button>
+				)}
+			</div>
+			{enrollment && (
+				<div className="flex flex-col gap-2 p-3 bg-muted rounded-xl text-sm">
+					<p>
+						Add this key to your authenticator app, or{" "}
+						<a href={enrollment.otpauthUrl} className="text-primary underline">
+							open it on this device
+						</a>
+						, then enter the 6-digit code.
+					</p>
+					<code className="font-mono break-all">{enrollment.secret}</code>
+					<div className="flex gap-2">
+						<Input
+							value={code}
+							onChange={(e) => setCode(e.target.value)}
+							autoComplete="one-time-code"
+							inputMode="numeric"
+							placeholder="123456"
+						/>
+						<Button
+							disabled={confirmTOTP.isPending || code.trim().length !== 6}
+							onClick={() => confirmTOTP.mutate()}
+						>
+							Verify
+						</Button>
+					</div>
+				</div>
+			)}
+			{status.webauthnAvailable && (
+				<div className="flex flex-col gap-2">
+					<div className="flex items-center gap-2 text-sm">
+						<KeyRoundIcon className="size-4" />
+						<span className="font-medium">Security keys</span>
+					</div>
+					{status.webauthnCredentials.map((credential) => (
+						<div
+							key={credential.id}
+							className="flex items-center justify-between gap-2 text-sm pl-6"
+						>
+							<span>{credential.name}</span>
+							<Button
+								size="sm"
+								variant="ghost"
+								disabled={removeKey.isPending}
+								onClick={() => removeKey.mutate(credential.id)}
+							>
+								<TrashIcon className="size-4" />
+								Remove
+							</Button>
+						</div>
+					))}
+					<div className="flex gap-2 pl-6">
+						<Input
+							value={keyName}
+							onChange={(e) => setKeyName(e.target.value)}
+							placeholder="Key name, e.g. YubiKey"
+							maxLength={64}
+						/>
+						<Button
+							variant="outline"
+							disabled={addKey.isPending || !keyName.trim()}
+							onClick={() => addKey.mutate()}
+						>
+							Add key
+						</Button>
+					</div>
+				</div>
+			)}
+			{enabled && (
+				<div className="flex items-center justify-between gap-2 text-sm">
+					<span className="text-muted-foreground">
+						{status.recoveryCodesRemaining} recovery codes left
+					</span>
+					<Button
+						size="sm"
+						variant="ghost"
+						disabled={regenerate.isPending}
+						onClick={() => regenerate.mutate()}
+					>
+						<RefreshCwIcon className="size-4" />
+						New recovery codes
+					</Button>
+				</div>
+			)}
+			{recoveryCodes.length > 0 && (
+				<div className="flex flex-col gap-2 p-3 bg-muted rounded-xl text-sm">
+					<p>
+						Save these recovery codes somewhere safe. Each one can be used once
+						if you lose access to your second factor.
+					</p>
+					<div className="grid grid-cols-2 gap-1 font-mono">
+						{recoveryCodes.map((code) => (
+							<span key={code}>{code}</span>
+						))}
+					</div>
+					<Button
+						size="sm"
+						variant="outline"
+						onClick={() => setRecoveryCodes([])}
+					>
+						I have saved them
+					</Button>
+				</div>
+			)}
+		</div>
+	);
+}
@@ -0,0 +1,112 @@
+import { zodResolver } from "@hookform/resolvers/zod";
+import { useMutation, useQueryClient } from "@tanstack/react-query";
+import type { Organization } from "gen/organization/v1/organization_pb";
+import { PlusIcon, UsersIcon } from "lucide-react";
+import { useRef } from "react";
+import { useForm } from "react-hook-form";
+import { toast } from "sonner";
+import { z } from "zod";
+import { Button } from "~/components/ui/button";
+import {
+	Dialog,
+	DialogClose,
+	DialogContent,
+	DialogFooter,
+	DialogHeader,
+	DialogTrigger,
+} from "~/components/ui/dialog";
+import {
+	Form,
+	FormControl,
+	FormField,
+	FormItem,
+	FormMessage,
+} from "~/components/ui/form";
+import { Input } from "~/components/ui/input";
+import { organizationClient } from "~/connect";
+import { errorMessage } from "~/lib/errors";
+
+const schema = z.object({
+	name: z
+		.string()
+		.trim()
+		.min(1, { message: "Name is required." })
+		.max(100, { message: "Name is too long." }),
+});
+
+export function CreateOrganizationDialog({
+	onCreated,
+}: {
+	onCreated?: (organization: Organization) => void;
+}) {
+	const queryClient = useQueryClient();
+	const closeRef = useRef<HTMLButtonElement>(null);
+	const form = useForm<z.infer<typeof schema>>({
+		resolver: zodResolver(schema),
+		defaultValues: {
+			name: "",
+		},
+	});
+	const onSubmit = (values: z.infer<typeof schema>) => {
+		mutation.mutate(values);
+	};
+	const mutation = useMutation({
+		mutationFn: async (values: z.infer<typeof schema>) => {
+			const response = await organizationClient.createOrganization({
+				name: values.name,
+			});
+			return response.organization;
+		},
+		onSuccess(organization) {
+			form.reset();
+			closeRef.current?.click();
+			queryClient.invalidateQueries({ queryKey: ["organizations"] });
+			if (organization) {
+				onCreated?.(organization);
+			}
+		},
+		onError(err) {
+			toast.error(errorMessage(err));
+		},
+	});
+	return (
+		<Dialog>
+			<DialogTrigger asChild>
+				<Button size="icon">
+					<PlusIcon />
+				</Button>
+			</DialogTrigger>
+			<DialogContent>
+				<Form {...form}>
+					<form onSubmit={form.handleSubmit(onSubmit)}>
+						<DialogHeader>
+							<UsersIcon />
+						</DialogHeader>
+						<FormField
+							control={form.control}
+							name="name"
+							render={({ field }) => (
+								<FormItem className="my-4">
+									<FormControl>
+										<Input {...field} placeholder="Organization name" />
+									</FormControl>
+									<FormMessage />
+								</FormItem>
+							)}
+						/>
+						<DialogFooter>
+							<DialogClose ref={closeRef} asChild>
+								<Button type="button" variant="outline">
+									Cancel
+								</Button>
+							</DialogClose>
+							<Button type="submit" disabled={mutation.isPending}>
+								Create
+							</Button>
+						</DialogFooter>
+					</form>
+				</Form>
+			</DialogContent>
+		</Dialog>
+	);
+}
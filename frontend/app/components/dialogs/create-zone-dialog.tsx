@@ -1,5 +1,6 @@
 import { zodResolver } from "@hookform/resolvers/zod";
-import { useMutation } from "@tanstack/react-query";
+import { useMutation, useQuery } from "@tanstack/react-query";
+import { Role } from "gen/organization/v1/organization_pb";
 import { GlobeIcon, PlusIcon } from "lucide-react";
 import { useRef } from "react";
 import { useForm } from "react-hook-form";
@@ -21,7 +22,15 @@ import {
 	FormMessage,
 } from "~/components/ui/form";
 import { Input } from "~/components/ui/input";
-import { zoneClient } from "~/connect";
+import {
+	Select,
+	SelectContent,
+	SelectItem,
+	SelectTrigger,
+	SelectValue,
+} from "~/components/ui/select";
+import { organizationClient, zoneClient } from "~/connect";
+import { hasRole } from "~/lib/roles";
 
 const schema = z.object({
 	zoneName: z
@@ -37,14 +46,29 @@ const schema = z.object({
 				message: "Invalid domain name format.",
 			},
 		),
+	organizationId: z.string(),
 });
 
 export function CreateZoneDialog() {
 	const closeRef = useRef<HTMLButtonElement>(null);
+	// zones can be added to organizations where the user is at least an admin
+	const { data: organizations } = useQuery({
+		queryKey: ["organizations"],
+		queryFn: async () => {
+			const res = await organizationClient.listOrganizations({});
+			return res.organizations;
+		},
+		select: (organizations) =>
+			organizations.filter((organization) =>
+				hasRole(organization.role, Role.ADMIN),
+			),
+	});
 	const form = useForm<z.infer<typeof schema>>({
 		resolver: zodResolver(schema),
 		defaultValues: {
 			zoneName: "",
+			// empty means the personal organization
+			organizationId: "",
 		},
 	});
 	const onSubmit = (values: z.infer<typeof schema>) => {
@@ -54,6 +78,7 @@ export function CreateZoneDialog() {
 		mutationFn: async (values: z.infer<typeof schema>) => {
 			const response = await zoneClient.createZone({
 				zoneName: values.zoneName,
+				organizationId: values.organizationId,
 			});
 			return response.zone;
 		},
@@ -87,6 +112,36 @@ export function CreateZoneDialog() {
 								</FormItem>
 							)}
 						/>
+						{organizations && organizations.length > 1 && (
+							<FormField
+								control={form.control}
+								name="organizationId"
+								render={({ field }) => (
+									<FormItem className="my-4">
+										<Select
+											value={field.value || organizations[0].id}
+											onValueChange={field.onChange}
+										>
+											<FormControl>
+												<SelectTrigger className="w-full">
+													<SelectValue />
+												</SelectTrigger>
+											</FormControl>
+											<SelectContent>
+												{organizations.map((organization) => (
+													<SelectItem
+														key={organization.id}
+														value={organization.id}
+													>
+														{organization.name}
+													</SelectItem>
+												))}
+											</SelectContent>
+										</Select>
+									</FormItem>
+								)}
+							/>
+						)}
 						<DialogFooter>
 							<DialogClose ref={closeRef} asChild>
 								<Button type="button" variant="outline">
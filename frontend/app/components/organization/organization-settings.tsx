@@ -0,0 +1,354 @@
+import { useMutation, useQuery, useQueryClient } from "@tanstack/react-query";
+import { type Organization, Role } from "gen/organization/v1/organization_pb";
+import { LogOutIcon, MailIcon, Trash2Icon, UserMinusIcon } from "lucide-react";
+import { useState } from "react";
+import { toast } from "sonner";
+import { useStepUp } from "~/components/auth/step-up";
+import {
+	AlertDialog,
+	AlertDialogAction,
+	AlertDialogCancel,
+	AlertDialogContent,
+	AlertDialogDescription,
+	AlertDialogFooter,
+	AlertDialogHeader,
+	AlertDialogTitle,
+	AlertDialogTrigger,
+} from "~/components/ui/alert-dialog";
+import { Avatar, AvatarFallback, AvatarImage } from "~/components/ui/avatar";
+import { Badge } from "~/components/ui/badge";
+import { Button } from "~/components/ui/button";
+import { Input } from "~/components/ui/input";
+import {
+	Select,
+	SelectContent,
+	SelectItem,
+	SelectTrigger,
+	SelectValue,
+} from "~/components/ui/select";
+import { authClient, organizationClient } from "~/connect";
+import { errorMessage } from "~/lib/errors";
+import { assignableRoles, hasRole, roleLabels } from "~/lib/roles";
+
+function RoleSelect({
+	value,
+	onChange,
+	maxRole,
+	disabled,
+}: {
+	value: Role;
+	onChange: (role: Role) => void;
+	maxRole: Role;
+	disabled?: boolean;
+}) {
+	return (
+		<Select
+			value={String(value)}
+			onValueChange={(role) => onChange(Number(role) as Role)}
+			disabled={disabled}
+		>
+			<SelectTrigger className="w-28">
+				<SelectValue />
+			</SelectTrigger>
+			<SelectContent>
+				{assignableRoles
+					.filter((role) => role <= maxRole)
+					.map((role) => (
+						<SelectItem key={role} value={String(role)}>
+							{roleLabels[role]}
+						</SelectItem>
+					))}
+			</SelectContent>
+		</Select>
+	);
+}
+
+export function OrganizationSettings({
+	organization,
+	onDeleted,
+}: {
+	organization: Organization;
+	onDeleted?: () => void;
+}) {
+	const queryClient = useQueryClient();
+	const withStepUp = useStepUp();
+	const organizationId = organization.id;
+	const canManageMembers = hasRole(organization.role, Role.ADMIN);
+	const isOwner = hasRole(organization.role, Role.OWNER);
+	// only owners can hand out or take away the owner role
+	const maxRole = isOwner ? Role.OWNER : Role.ADMIN;
+	const [name, setName] = useState(organization.name);
+	const [email, setEmail] = useState("");
+	const [inviteRole, setInviteRole] = useState(Role.EDITOR);
+
+	const { data: user } = useQuery({
+		queryKey: ["account"],
+		queryFn: async () => {
+			const res = await authClient.whoAmI({});
+			if (!res.user) {
+				throw new Error("Failed to get user");
+			}
+			return res.user;
+		},
+	});
+	const { data: members } = useQuery({
+		queryKey: ["organization-members", organizationId],
+		queryFn: async () => {
+			const res = await organizationClient.listMembers({ organizationId });
+			return res.members;
+		},
+	});
+	const { data: invitations } = useQuery({
+		queryKey: ["organization-invitations", organizationId],
+		queryFn: async () => {
+			const res = await organizationClient.listInvitations({ organizationId });
+			return res.invitations;
+		},
+		enabled: canManageMembers,
+	});
+
+	const onError = (err: Error) => {
+		toast.error(errorMessage(err));
+	};
+	const invalidate = (key: string) => {
+		queryClient.invalidateQueries({ queryKey: [key, organizationId] });
+	};
+
+	const rename = useMutation({
+		mutationFn: () =>
+			organizationClient.renameOrganization({ id: organizationId, name }),
+		onSuccess() {
+			toast.success("Organization renamed");
+			queryClient.invalidateQueries({ queryKey: ["organizations"] });
+		},
+		onError,
+	});
+	const updateRole = useMutation({
+		mutationFn: (variables: { userId: string; role: Role }) =>
+			withStepUp(() =>
+				organizationClient.updateMemberRole({ organizationId, ...variables }),
+			),
+		onSuccess: () => invalidate("organization-members"),
+		onError,
+	});
+	const removeMember = useMutation({
+		mutationFn: (userId: string) =>
+			withStepUp(() =>
+				organizationClient.removeMember({ organizationId, userId }),
+			),
+		onSuccess(_data, userId) {
+			if (userId === user?.id) {
+				queryClient.invalidateQueries({ queryKey: ["organizations"] });
+				queryClient.invalidateQueries({ queryKey: ["zones"] });
+				onDeleted?.();
+				return;
+			}
+			invalidate("organization-members");
+		},
+		onError,
+	});
+	const invite = useMutation({
+		mutationFn: () =>
+			withStepUp(() =>
+				organizationClient.inviteMember({
+					organizationId,
+					email,
+					role: inviteRole,
+				}),
+			),
+		onSuccess() {
+			toast.success(`Invitation sent to ${email}`);
+			setEmail("");
+			invalidate("organization-invitations");
+		},
+		onError,
+	});
+	const revokeInvitation = useMutation({
+		mutationFn: (id: string) =>
+			withStepUp(() =>
+				organizationClient.revokeInvitation({ organizationId, id }),
+			),
+		onSuccess: () => invalidate("organization-invitations"),
+		onError,
+	});
+	const deleteOrganization = useMutation({
+		mutationFn: () =>
+			withStepUp(() =>
+				organizationClient.deleteOrganization({ id: organizationId }),
+			),
+		onSuccess() {
+			queryClient.invalidateQueries({ queryKey: ["organizations"] });
+			onDeleted?.();
+		},
+		onError,
+	});
+
+	return (
+		<div className="flex flex-col gap-4">
+			{isOwner && (
+				<div className="flex flex-col gap-3 p-4 border rounded-2xl">
+					<h2 className="text-sm font-bold">Name</h2>
+					<div className="flex gap-2">
+						<Input
+							value={name}
+							onChange={(e) => setName(e.target.value)}
+							maxLength={100}
+						/>
+						<Button
+							variant="outline"
+							disabled={
+								rename.isPending ||
+								!name.trim() ||
+								name.trim() === organization.name
+							}
+							onClick={() => rename.mutate()}
+						>
+							Save
+						</Button>
+					</div>
+				</div>
+			)}
+			<div className="flex flex-col gap-3 p-4 border rounded-2xl">
+				<h2 className="text-sm font-bold">Members</h2>
+				{members?.map((member) => {
+					const isSelf = member.userId === user?.id;
+					// admins cannot change or remove owners
+					const editable =
+						canManageMembers &&
+						!isSelf &&
+						(isOwner || member.role !== Role.OWNER);
+					return (
+						<div key={member.userId} className="flex items-center gap-2">
+							<Avatar className="size-8">
+								<AvatarImage src={member.avatar} />
+								<AvatarFallback>
+									{member.email.charAt(0).toUpperCase()}
+								</AvatarFallback>
+							</Avatar>
+							<span className="text-sm flex-1 truncate">
+								{member.email}
+								{isSelf && (
+									<span className="text-muted-foreground"> (you)</span>
+								)}
+							</span>
+							{editable ? (
+								<>
+									<RoleSelect
+										value={member.role}
+										maxRole={maxRole}
+										disabled={updateRole.isPending}
+										onChange={(role) =>
+											updateRole.mutate({ userId: member.userId, role })
+										}
+									/>
+									<Button
+										size="sm"
+										variant="ghost"
+										disabled={removeMember.isPending}
+										onClick={() => removeMember.mutate(member.userId)}
+									>
+										<UserMinusIcon className="size-4" />
+									</Button>
+								</>
+							) : (
+								<Badge variant="secondary">{roleLabels[member.role]}</Badge>
+							)}
+						</div>
+					);
+				})}
+			</div>
+			{canManageMembers && (
+				<div className="flex flex-col gap-3 p-4 border rounded-2xl">
+					<h2 className="text-sm font-bold">Invite</h2>
+					<form
+						className="flex gap-2"
+						onSubmit={(e) => {
+							e.preventDefault();
+							invite.mutate();
+						}}
+					>
+						<Input
+							type="email"
+							value={email}
+							onChange={(e) => setEmail(e.target.value)}
+							placeholder="teammate@example.com"
+						/>
+						<RoleSelect
+							value={inviteRole}
+							maxRole={maxRole}
+							onChange={setInviteRole}
+						/>
+						<Button type="submit" disabled={invite.isPending || !email.trim()}>
+							<MailIcon className="size-4" />
+							Invite
+						</Button>
+					</form>
+					{invitations?.map((invitation) => (
+						<div
+							key={invitation.id}
+							className="flex items-center justify-between gap-2 text-sm"
+						>
+							<span className="truncate">
+								{invitation.email}
+								<span className="text-muted-foreground">
+									{" "}
+									· {roleLabels[invitation.role]} · expires{" "}
+									{new Date(invitation.expiresAt).toLocaleDateString()}
+								</span>
+							</span>
+							<Button
+								size="sm"
+								variant="ghost"
+								disabled={revokeInvitation.isPending}
+								onClick={() => revokeInvitation.mutate(invitation.id)}
+							>
+								Revoke
+							</Button>
+						</div>
+					))}
+				</div>
+			)}
+			{!organization.personal && (
+				<div className="flex flex-col sm:flex-row gap-2">
+					<Button
+						variant="outline"
+						className="flex-1"
+						disabled={removeMember.isPending || !user}
+						onClick={() => user && removeMember.mutate(user.id)}
+					>
+						<LogOutIcon className="size-4" />
+						Leave organization
+					</Button>
+					{isOwner && (
+						<AlertDialog>
+							<AlertDialogTrigger asChild>
+								<Button variant="destructive" className="flex-1">
+									<Trash2Icon className="size-4" />
+									Delete organization
+								</Button>
+							</AlertDialogTrigger>
+							<AlertDialogContent>
+								<AlertDialogHeader>
+									<AlertDialogTitle>Are you absolutely sure?</AlertDialogTitle>
+									<AlertDialogDescription>
+										This will permanently delete {organization.name} and remove
+										all of its members. Its zones must be deleted first.
+									</AlertDialogDescription>
+								</AlertDialogHeader>
+								<AlertDialogFooter>
+									<AlertDialogCancel>Cancel</AlertDialogCancel>
+									<AlertDialogAction
+										disabled={deleteOrganization.isPending}
+										onClick={() => deleteOrganization.mutate()}
+									>
+										Confirm
+									</AlertDialogAction>
+								</AlertDialogFooter>
+							</AlertDialogContent>
+						</AlertDialog>
+					)}
+				</div>
+			)}
+		</div>
+	);
+}
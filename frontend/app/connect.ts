@@ -7,29 +7,94 @@ import {
 import { createConnectTransport } from "@connectrpc/connect-web";
 import { AuthService } from "gen/auth/v1/auth_pb";
 import { DNSRecordService } from "gen/dns_record/v1/dns_record_pb";
+import { MFAService } from "gen/mfa/v1/mfa_pb";
+import { OrganizationService } from "gen/organization/v1/organization_pb";
 import { ZoneService } from "gen/zone/v1/zone_pb";
 import { useAuthStore } from "~/stores/auth";
 
+// refreshClient has no auth interceptor, so a failed refresh cannot recurse
+const refreshClient = createClient(
+	AuthService,
+	createConnectTransport({ baseUrl: import.meta.env.VITE_API_URL }),
+);
+
+let refreshing: Promise<string | null> | null = null;
+
+// refreshAccessToken rotates the refresh token and returns the new access token.
+// Concurrent callers share one request, because a refresh token can only be used once.
+function refreshAccessToken(staleToken: string | null) {
+	refreshing ??= (async () => {
+		// another tab may have refreshed already and saved new tokens
+		await useAuthStore.persist.rehydrate();
+		const { accessToken, refreshToken } = useAuthStore.getState();
+		if (accessToken && accessToken !== staleToken) {
+			return accessToken;
+		}
+		if (!refreshToken) {
+			return null;
+		}
+		try {
+			const res = await refreshClient.refreshToken({ refreshToken });
+			useAuthStore.getState().signIn(res.token, res.refreshToken);
+			return res.token;
+		} catch (err) {
+			if (err instanceof ConnectError && err.code === Code.Unauthenticated) {
+				return null;
+			}
+			throw err;
+		}
+	})().finally(() => {
+		refreshing = null;
+	});
+	return refreshing;
+}
+
 const authInterceptor: Interceptor = (next) => async (req) => {
 	const token = useAuthStore.getState().accessToken;
 	if (token) {
-		req.header.append("Authorization", `Bearer ${token}`);
+		req.header.set("Authorization", `Bearer ${token}`);
 	}
 	try {
 		return await next(req);
 	} catch (err) {
-		if (err instanceof ConnectError && err.code === Code.Unauthenticated) {
+		if (
+			!(err instanceof ConnectError) ||
+			err.code !== Code.Unauthenticated ||
+			!token
+		) {
+			throw err;
+		}
+		// access tokens are short-lived, try once with a refreshed one
+		const refreshed = await refreshAccessToken(token);
+		if (!refreshed) {
 			useAuthStore.getState().signOut();
+			throw err;
 		}
-		throw err;
+		req.header.set("Authorization", `Bearer ${refreshed}`);
+		return await next(req);
 	}
 };
 
 const transport = createConnectTransport({
 	baseUrl: import.meta.env.VITE_API_URL,
 	interceptors: [authInterceptor],
+	// getLoginURL / getLinkURL set the OAuth state cookie that binds a sign-in
+	// to this browser, so the API has to be allowed to set cookies
+	fetch: (input, init) => fetch(input, { ...init, credentials: "include" }),
 });
 
 export const authClient = createClient(AuthService, transport);
 export const zoneClient = createClient(ZoneService, transport);
 export const dnsRecordClient = createClient(DNSRecordService, transport);
+export const mfaClient = createClient(MFAService, transport);
+export const organizationClient = createClient(OrganizationService, transport);
+
+// signOut revokes the current session on the server before forgetting the tokens locally
+export async function signOut() {
+	try {
+		await authClient.logout({});
+	} catch {
+		// the session may already be revoked or expired
+	}
+	useAuthStore.getState().signOut();
+}
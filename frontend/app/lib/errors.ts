@@ -0,0 +1,8 @@
+import { ConnectError } from "@connectrpc/connect";
+
+export function errorMessage(err: unknown) {
+	if (err instanceof ConnectError) {
+		return err.rawMessage;
+	}
+	return err instanceof Error ? err.message : "Something went wrong";
+}
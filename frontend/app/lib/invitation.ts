@@ -0,0 +1,13 @@
+const pendingInvitationKey = "dnsarc:pending-invitation";
+
+// savePendingInvitation keeps an invitation token across sign-in, so it can
+// be accepted once the user is back in the dashboard
+export function savePendingInvitation(token: string) {
+	sessionStorage.setItem(pendingInvitationKey, token);
+}
+
+export function takePendingInvitation() {
+	const token = sessionStorage.getItem(pendingInvitationKey);
+	sessionStorage.removeItem(pendingInvitationKey);
+	return token;
+}
@@ -0,0 +1,22 @@
+import { Role } from "gen/organization/v1/organization_pb";
+
+export const roleLabels: Record<Role, string> = {
+	[Role.UNSPECIFIED]: "Unknown",
+	[Role.VIEWER]: "Viewer",
+	[Role.EDITOR]: "Editor",
+	[Role.ADMIN]: "Admin",
+	[Role.OWNER]: "Owner",
+};
+
+// roles a member can be given, lowest first
+export const assignableRoles = [
+	Role.VIEWER,
+	Role.EDITOR,
+	Role.ADMIN,
+	Role.OWNER,
+];
+
+// roles are numbered by privilege, so a higher value includes the lower ones
+export function hasRole(role: Role, min: Role) {
+	return role >= min;
+}
@@ -0,0 +1,91 @@
+// The server sends WebAuthn options as JSON with base64url-encoded binary fields
+// and expects the browser's response in the same encoding.
+
+function decode(value: string) {
+	const base64 = value.replace(/-/g, "+").replace(/_/g, "/");
+	const padded = base64.padEnd(Math.ceil(base64.length / 4) * 4, "=");
+	return Uint8Array.from(atob(padded), (c) => c.charCodeAt(0));
+}
+
+function encode(buffer: ArrayBuffer | null) {
+	if (!buffer) {
+		return undefined;
+	}
+	let binary = "";
+	for (const byte of new Uint8Array(buffer)) {
+		binary += String.fromCharCode(byte);
+	}
+	return btoa(binary)
+		.replace(/\+/g, "-")
+		.replace(/\//g, "_")
+		.replace(/=+$/, "");
+}
+
+interface CredentialDescriptorJSON {
+	type: PublicKeyCredentialType;
+	id: string;
+	transports?: AuthenticatorTransport[];
+}
+
+function descriptors(list: CredentialDescriptorJSON[] | undefined) {
+	return list?.map((credential) => ({
+		...credential,
+		id: decode(credential.id),
+	}));
+}
+
+// createCredential registers a new security key and returns the response for FinishWebAuthnRegistration
+export async function createCredential(optionsJson: string) {
+	const { publicKey } = JSON.parse(optionsJson);
+	const credential = (await navigator.credentials.create({
+		publicKey: {
+			...publicKey,
+			challenge: decode(publicKey.challenge),
+			user: { ...publicKey.user, id: decode(publicKey.user.id) },
+			excludeCredentials: descriptors(publicKey.excludeCredentials),
+		},
+	})) as PublicKeyCredential | null;
+	if (!credential) {
+		throw new Error("Security key registration was cancelled");
+	}
+	const response = credential.response as AuthenticatorAttestationResponse;
+	return JSON.stringify({
+		id: credential.id,
+		rawId: encode(credential.rawId),
+		type: credential.type,
+		response: {
+			clientDataJSON: encode(response.clientDataJSON),
+			attestationObject: encode(response.attestationObject),
+			transports: response.getTransports?.(),
+		},
+		clientExtensionResults: credential.getClientExtensionResults(),
+	});
+}
+
+// getAssertion signs the server's challenge with a registered security key
+export async function getAssertion(optionsJson: string) {
+	const { publicKey } = JSON.parse(optionsJson);
+	const credential = (await navigator.credentials.get({
+		publicKey: {
+			...publicKey,
+			challenge: decode(publicKey.challenge),
+			allowCredentials: descriptors(publicKey.allowCredentials),
+		},
+	})) as PublicKeyCredential | null;
+	if (!credential) {
+		throw new Error("Security key verification was cancelled");
+	}
+	const response = credential.response as AuthenticatorAssertionResponse;
+	return JSON.stringify({
+		id: credential.id,
+		rawId: encode(credential.rawId),
+		type: credential.type,
+		response: {
+			clientDataJSON: encode(response.clientDataJSON),
+			authenticatorData: encode(response.authenticatorData),
+			signature: encode(response.signature),
+			userHandle: encode(response.userHandle),
+		},
+		clientExtensionResults: credential.getClientExtensionResults(),
+	});
+}
@@ -8,10 +8,17 @@ import {
 export default [
 	index("routes/home.tsx"),
 	route("auth", "routes/auth.tsx"),
+	route("auth/callback", "routes/auth.callback.tsx"),
+	route("auth/mfa", "routes/auth.mfa.tsx"),
+	route("auth/verify-email", "routes/auth.verify-email.tsx"),
+	route("auth/forgot-password", "routes/auth.forgot-password.tsx"),
+	route("auth/reset-password", "routes/auth.reset-password.tsx"),
+	route("invite", "routes/invite.tsx"),
 	layout("routes/dash/layout.tsx", [
 		route("/dash", "routes/dash/index.tsx"),
 		route("/dash/zones", "routes/dash/zones.tsx"),
 		route("/dash/zone/:name", "routes/dash/zone.tsx"),
+		route("/dash/organizations", "routes/dash/organizations.tsx"),
 		route("/dash/account", "routes/dash/account.tsx"),
 	]),
 ] satisfies RouteConfig;
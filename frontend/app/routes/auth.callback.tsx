@@ -0,0 +1,58 @@
+import { useMutation } from "@tanstack/react-query";
+import { useEffect, useRef } from "react";
+import { Link, useNavigate, useSearchParams } from "react-router";
+import { toast } from "sonner";
+import { AuthLayout } from "~/components/auth/auth-layout";
+import { Button } from "~/components/ui/button";
+import { authClient } from "~/connect";
+import { errorMessage } from "~/lib/errors";
+import { useAuthStore } from "~/stores/auth";
+
+export default function AuthCallbackPage() {
+	const [searchParams] = useSearchParams();
+	const code = searchParams.get("code") ?? "";
+	const navigate = useNavigate();
+	const mutation = useMutation({
+		mutationFn: async (code: string) => {
+			return await authClient.exchangeAuthCode({ code });
+		},
+		onSuccess(res) {
+			if (res.mfa) {
+				navigate("/auth/mfa", {
+					replace: true,
+					state: { challenge: res.mfa },
+				});
+				return;
+			}
+			useAuthStore.getState().signIn(res.token, res.refreshToken);
+			toast.success("Login successful");
+			navigate("/dash", { replace: true });
+		},
+	});
+	const { mutate } = mutation;
+	// the code is single-use, so exchange it only once even if the effect runs twice
+	const submitted = useRef(false);
+	useEffect(() => {
+		if (submitted.current) {
+			return;
+		}
+		submitted.current = true;
+		mutate(code);
+	}, [code, mutate]);
+	return (
+		<AuthLayout
+			title="Signing in"
+			description={
+				mutation.isError
+					? errorMessage(mutation.error)
+					: "Completing sign in..."
+			}
+		>
+			{mutation.isError && (
+				<Button size="lg" className="w-full max-w-sm h-12" asChild>
+					<Link to="/auth">Back to sign in</Link>
+				</Button>
+			)}
+		</AuthLayout>
+	);
+}
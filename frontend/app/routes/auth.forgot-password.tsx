@@ -0,0 +1,80 @@
+import { zodResolver } from "@hookform/resolvers/zod";
+import { useMutation } from "@tanstack/react-query";
+import { useForm } from "react-hook-form";
+import { toast } from "sonner";
+import { z } from "zod";
+import { AuthLayout } from "~/components/auth/auth-layout";
+import { Button } from "~/components/ui/button";
+import {
+	Form,
+	FormControl,
+	FormField,
+	FormItem,
+	FormMessage,
+} from "~/components/ui/form";
+import { Input } from "~/components/ui/input";
+import { authClient } from "~/connect";
+import { errorMessage } from "~/lib/errors";
+
+const schema = z.object({
+	email: z.email({ message: "Invalid email address." }),
+});
+
+export default function ForgotPasswordPage() {
+	const form = useForm<z.infer<typeof schema>>({
+		resolver: zodResolver(schema),
+		defaultValues: {
+			email: "",
+		},
+	});
+	const mutation = useMutation({
+		mutationFn: async (values: z.infer<typeof schema>) => {
+			await authClient.forgotPassword(values);
+		},
+		onSuccess() {
+			toast.success("If the email is registered, a reset link is on its way");
+		},
+		onError(err) {
+			toast.error(errorMessage(err));
+		},
+	});
+	return (
+		<AuthLayout
+			title="Forgot your password?"
+			description="We will email you a link to set a new password"
+		>
+			<Form {...form}>
+				<form
+					onSubmit={form.handleSubmit((values) => mutation.mutate(values))}
+					className="w-full max-w-sm space-y-3"
+				>
+					<FormField
+						control={form.control}
+						name="email"
+						render={({ field }) => (
+							<FormItem>
+								<FormControl>
+									<Input
+										{...field}
+										type="email"
+										autoComplete="email"
+										placeholder="you@example.com"
+									/>
+								</FormControl>
+								<FormMessage />
+							</FormItem>
+						)}
+					/>
+					<Button
+						type="submit"
+						size="lg"
+						className="w-full h-12"
+						disabled={mutation.isPending}
+					>
+						Send reset link
+					</Button>
+				</form>
+			</Form>
+		</AuthLayout>
+	);
+}
@@ -0,0 +1,49 @@
+import type { MFAChallenge } from "gen/auth/v1/auth_pb";
+import { useEffect } from "react";
+import { useLocation, useNavigate } from "react-router";
+import { toast } from "sonner";
+import { AuthLayout } from "~/components/auth/auth-layout";
+import { MFAForm } from "~/components/auth/mfa-form";
+import { authClient } from "~/connect";
+import { useAuthStore } from "~/stores/auth";
+
+export default function MFAPage() {
+	const location = useLocation();
+	const navigate = useNavigate();
+	const challenge = location.state?.challenge as MFAChallenge | undefined;
+	// the challenge only lives in history state, so a reload starts over
+	useEffect(() => {
+		if (!challenge) {
+			navigate("/auth", { replace: true });
+		}
+	}, [challenge, navigate]);
+	if (!challenge) {
+		return null;
+	}
+	return (
+		<AuthLayout
+			title="Two-factor authentication"
+			description="Confirm it's you to finish signing in"
+		>
+			<MFAForm
+				totp={challenge.totp}
+				webauthn={challenge.webauthn}
+				beginWebAuthn={async () => {
+					const res = await authClient.beginMFAWebAuthn({
+						mfaToken: challenge.token,
+					});
+					return res.optionsJson;
+				}}
+				onVerify={async (factor) => {
+					const res = await authClient.verifyMFA({
+						mfaToken: challenge.token,
+						...factor,
+					});
+					useAuthStore.getState().signIn(res.token, res.refreshToken);
+					toast.success("Login successful");
+					navigate("/dash", { replace: true });
+				}}
+			/>
+		</AuthLayout>
+	);
+}
@@ -0,0 +1,111 @@
+import { zodResolver } from "@hookform/resolvers/zod";
+import { useMutation } from "@tanstack/react-query";
+import { useForm } from "react-hook-form";
+import { useNavigate, useSearchParams } from "react-router";
+import { toast } from "sonner";
+import { z } from "zod";
+import { AuthLayout } from "~/components/auth/auth-layout";
+import { Button } from "~/components/ui/button";
+import {
+	Form,
+	FormControl,
+	FormField,
+	FormItem,
+	FormMessage,
+} from "~/components/ui/form";
+import { Input } from "~/components/ui/input";
+import { authClient } from "~/connect";
+import { errorMessage } from "~/lib/errors";
+
+const schema = z
+	.object({
+		password: z
+			.string()
+			.min(8, { message: "Password must be at least 8 characters." })
+			.max(128),
+		confirm: z.string(),
+	})
+	.refine((values) => values.password === values.confirm, {
+		message: "Passwords do not match.",
+		path: ["confirm"],
+	});
+
+export default function ResetPasswordPage() {
+	const [searchParams] = useSearchParams();
+	const navigate = useNavigate();
+	const form = useForm<z.infer<typeof schema>>({
+		resolver: zodResolver(schema),
+		defaultValues: {
+			password: "",
+			confirm: "",
+		},
+	});
+	const mutation = useMutation({
+		mutationFn: async (values: z.infer<typeof schema>) => {
+			await authClient.resetPassword({
+				token: searchParams.get("token") ?? "",
+				password: values.password,
+			});
+		},
+		onSuccess() {
+			toast.success("Your password has been reset");
+			navigate("/auth");
+		},
+		onError(err) {
+			toast.error(errorMessage(err));
+		},
+	});
+	return (
+		<AuthLayout title="Set a new password" description="Choose a new password">
+			<Form {...form}>
+				<form
+					onSubmit={form.handleSubmit((values) => mutation.mutate(values))}
+					className="w-full max-w-sm space-y-3"
+				>
+					<FormField
+						control={form.control}
+						name="password"
+						render={({ field }) => (
+							<FormItem>
+								<FormControl>
+									<Input
+										{...field}
+										type="password"
+										autoComplete="new-password"
+										placeholder="New password"
+									/>
+								</FormControl>
+								<FormMessage />
+							</FormItem>
+						)}
+					/>
+					<FormField
+						control={form.control}
+						name="confirm"
+						render={({ field }) => (
+							<FormItem>
+								<FormControl>
+									<Input
+										{...field}
+										type="password"
+										autoComplete="new-password"
+										placeholder="Confirm password"
+									/>
+								</FormControl>
+								<FormMessage />
+							</FormItem>
+						)}
+					/>
+					<Button
+						type="submit"
+						size="lg"
+						className="w-full h-12"
+						disabled={mutation.isPending}
+					>
+						Reset password
+					</Button>
+				</form>
+			</Form>
+		</AuthLayout>
+	);
+}
@@ -1,43 +1,44 @@
+import { useMutation } from "@tanstack/react-query";
 import { ArrowLeftIcon } from "lucide-react";
 import { useEffect } from "react";
-import { FcGoogle } from "react-icons/fc";
-import { Link, redirect, useNavigate, useSearchParams } from "react-router";
+import { Link, redirect, useSearchParams } from "react-router";
 import { toast } from "sonner";
+import { PasswordLoginForm } from "~/components/auth/password-login-form";
+import { ProviderIcon } from "~/components/auth/provider-icon";
 import { Button } from "~/components/ui/button";
 import { authClient } from "~/connect";
+import { errorMessage } from "~/lib/errors";
 import { useAuthStore } from "~/stores/auth";
 import type { Route } from "./+types/auth";
 
-export async function loader() {
-	//const response = await authClient.googleLoginURL({});
-	const url = "https://google.com";
-	return {
-		url,
-	};
-}
-
-export async function clientLoader({ serverLoader }: Route.ClientLoaderArgs) {
-	const { url } = await serverLoader();
+export async function clientLoader() {
 	const accessToken = useAuthStore.getState().accessToken;
 	if (accessToken) {
 		return redirect("/dash");
 	}
+	const { providers } = await authClient.listLoginProviders({});
 	return {
-		url,
+		providers,
 	};
 }
 
 export default function AuthPage({ loaderData }: Route.ComponentProps) {
 	const [searchParams] = useSearchParams();
-	const token = searchParams.get("token");
-	const navigate = useNavigate();
+	const error = searchParams.get("error");
 	useEffect(() => {
-		if (token) {
-			useAuthStore.getState().signIn(token);
-			toast.success("Login successful");
-			navigate("/dash");
+		if (error) {
+			toast.error(error);
 		}
-	}, [token, navigate]);
+	}, [error]);
+	const login = useMutation({
+		mutationFn: async (provider: string) => {
+			const res = await authClient.getLoginURL({ provider });
+			window.location.href = res.url;
+		},
+		onError(err) {
+			toast.error(errorMessage(err));
+		},
+	});
 	return (
 		<div className="h-dvh flex flex-col p-4">
 			<div>
@@ -54,12 +55,27 @@ export default function AuthPage({ loaderData }: Route.ComponentProps) {
 					<span className="mx-1 text-blue-500">DNSARC</span>
 				</h1>
 				<p className="text-muted-foreground mb-8">Sign in below to continue</p>
-				<Button size="lg" className="w-full mx-auto max-w-sm h-12" asChild>
-					<Link to={loaderData.url}>
-						<FcGoogle className="mr-2 size-4" />
-						Continue with Google
-					</Link>
-				</Button>
+				<PasswordLoginForm />
+				<div className="flex items-center w-full max-w-sm my-6 text-xs text-muted-foreground">
+					<div className="flex-1 border-t" />
+					<span className="mx-3">OR</span>
+					<div className="flex-1 border-t" />
+				</div>
+				<div className="flex flex-col gap-3 w-full max-w-sm">
+					{loaderData.providers.map((provider) => (
+						<Button
+							key={provider.name}
+							size="lg"
+							variant="outline"
+							className="w-full h-12"
+							disabled={login.isPending}
+							onClick={() => login.mutate(provider.name)}
+						>
+							<ProviderIcon name={provider.name} />
+							Continue with {provider.displayName}
+						</Button>
+					))}
+				</div>
 				<span className="text-sm text-muted-foreground mt-6">
 					By continuing, you agree to our
 					<Link to="/terms" className="text-primary hover:underline mx-1">
@@ -0,0 +1,45 @@
+import { useMutation } from "@tanstack/react-query";
+import { useEffect, useRef } from "react";
+import { Link, useSearchParams } from "react-router";
+import { AuthLayout } from "~/components/auth/auth-layout";
+import { Button } from "~/components/ui/button";
+import { authClient } from "~/connect";
+import { errorMessage } from "~/lib/errors";
+
+export default function VerifyEmailPage() {
+	const [searchParams] = useSearchParams();
+	const token = searchParams.get("token") ?? "";
+	const mutation = useMutation({
+		mutationFn: async (token: string) => {
+			await authClient.verifyEmail({ token });
+		},
+	});
+	const { mutate } = mutation;
+	// the token is single-use, so submit it only once even if the effect runs twice
+	const submitted = useRef(false);
+	useEffect(() => {
+		if (submitted.current) {
+			return;
+		}
+		submitted.current = true;
+		mutate(token);
+	}, [token, mutate]);
+	return (
+		<AuthLayout
+			title="Verify your email"
+			description={
+				mutation.isSuccess
+					? "Your email address has been verified."
+					: mutation.isError
+						? errorMessage(mutation.error)
+						: "Verifying your email address..."
+			}
+		>
+			{mutation.isSuccess && (
+				<Button size="lg" className="w-full max-w-sm h-12" asChild>
+					<Link to="/auth">Continue to sign in</Link>
+				</Button>
+			)}
+		</AuthLayout>
+	);
+}
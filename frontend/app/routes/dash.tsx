@@ -33,13 +33,12 @@ import {
 	FormMessage,
 } from "~/components/ui/form";
 import { Input } from "~/components/ui/input";
-import { authClient, zoneClient } from "~/connect";
+import { authClient, signOut, zoneClient } from "~/connect";
 
 const schema = z.object({
 	zoneName: z.string().min(1).max(255),
 });
 
-import { useAuthStore } from "~/stores/auth";
 
 export default function Dash() {
 	const navigate = useNavigate();
@@ -60,8 +59,8 @@ export default function Dash() {
 			return response.zones;
 		},
 	});
-	const logout = () => {
-		useAuthStore.getState().signOut();
+	const logout = async () => {
+		await signOut();
 		navigate("/auth");
 	};
 	const form = useForm<z.infer<typeof schema>>({
@@ -6,11 +6,13 @@ import {
 	TriangleAlertIcon,
 } from "lucide-react";
 import { useNavigate } from "react-router";
+import { LinkedAccounts } from "~/components/auth/linked-accounts";
+import { Sessions } from "~/components/auth/sessions";
+import { TwoFactor } from "~/components/auth/two-factor";
 import { Avatar, AvatarFallback, AvatarImage } from "~/components/ui/avatar";
 import { Badge } from "~/components/ui/badge";
 import { Button } from "~/components/ui/button";
-import { authClient } from "~/connect";
-import { useAuthStore } from "~/stores/auth";
+import { authClient, signOut } from "~/connect";
 
 export default function Account() {
 	const {
@@ -30,8 +32,8 @@ export default function Account() {
 	});
 	const navigate = useNavigate();
 
-	function logout() {
-		useAuthStore.getState().signOut();
+	async function logout() {
+		await signOut();
 		navigate("/auth");
 	}
 
@@ -82,6 +84,9 @@ export default function Account() {
 							<p className="text-sm font-medium">{user?.email}</p>
 						</div>
 					</div>
+					<LinkedAccounts />
+					<TwoFactor />
+					<Sessions />
 					<div>
 						<Button
 							size="lg"
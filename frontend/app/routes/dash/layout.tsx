@@ -1,6 +1,8 @@
-import { GlobeIcon, HouseIcon, UserIcon } from "lucide-react";
+import { GlobeIcon, HouseIcon, UserIcon, UsersIcon } from "lucide-react";
 import { Link, Outlet, redirect, useLocation } from "react-router";
+import { StepUpProvider } from "~/components/auth/step-up";
 import { Button } from "~/components/ui/button";
+import { takePendingInvitation } from "~/lib/invitation";
 import { useAuthStore } from "~/stores/auth";
 
 export async function clientLoader() {
@@ -8,6 +10,11 @@ export async function clientLoader() {
 	if (!token) {
 		return redirect("/auth");
 	}
+	// an invitation opened before signing in is picked up after sign-in
+	const invitation = takePendingInvitation();
+	if (invitation) {
+		return redirect(`/invite?token=${encodeURIComponent(invitation)}`);
+	}
 	return null;
 }
 
@@ -28,6 +35,11 @@ const navItems: NavItem[] = [
 		href: "/dash/zones",
 		icon: GlobeIcon,
 	},
+	{
+		name: "Organizations",
+		href: "/dash/organizations",
+		icon: UsersIcon,
+	},
 	{
 		name: "Account",
 		href: "/dash/account",
@@ -78,7 +90,9 @@ export default function Layout() {
 					})}
 				</nav>
 			</div>
-			<Outlet />
+			<StepUpProvider>
+				<Outlet />
+			</StepUpProvider>
 			<div className="shrink-0 p-4 bg-transparent fixed bottom-0 left-0 right-0 md:hidden">
 				<div className="flex justify-between w-fit mx-auto gap-4 h-fit p-2 border rounded-full backdrop-blur-xl shadow-lg">
 					{navItems.map((item) => {
@@ -0,0 +1,80 @@
+import { useQuery } from "@tanstack/react-query";
+import { LoaderIcon, TriangleAlertIcon } from "lucide-react";
+import { useState } from "react";
+import { CreateOrganizationDialog } from "~/components/dialogs/create-organization-dialog";
+import { OrganizationSettings } from "~/components/organization/organization-settings";
+import { Badge } from "~/components/ui/badge";
+import { Button } from "~/components/ui/button";
+import { ScrollArea } from "~/components/ui/scroll-area";
+import { organizationClient } from "~/connect";
+import { roleLabels } from "~/lib/roles";
+
+export default function Organizations() {
+	const {
+		data: organizations,
+		isLoading,
+		isLoadingError,
+	} = useQuery({
+		queryKey: ["organizations"],
+		queryFn: async () => {
+			const res = await organizationClient.listOrganizations({});
+			return res.organizations;
+		},
+	});
+	const [selectedId, setSelectedId] = useState<string>();
+	const selected =
+		organizations?.find((organization) => organization.id === selectedId) ??
+		organizations?.[0];
+
+	return (
+		<div className="flex flex-1 flex-col h-full">
+			<div className="flex border-b border-muted h-12 p-3 items-center">
+				<h1 className="text-md font-bold">Organizations</h1>
+				<div className="flex-1" />
+				<CreateOrganizationDialog
+					onCreated={(organization) => setSelectedId(organization.id)}
+				/>
+			</div>
+			{isLoading && (
+				<div className="h-full flex flex-col gap-2 items-center justify-center">
+					<LoaderIcon className="animate-spin" />
+				</div>
+			)}
+			{isLoadingError && (
+				<div className="h-full flex flex-col gap-2 items-center justify-center">
+					<TriangleAlertIcon />
+					<span className="text-sm font-medium">Something went wrong</span>
+				</div>
+			)}
+			<ScrollArea className="flex-1 flex flex-col overflow-y-auto">
+				<div className="p-4 flex flex-col gap-4 max-w-4xl w-full mx-auto">
+					<div className="flex flex-wrap gap-2">
+						{organizations?.map((organization) => (
+							<Button
+								key={organization.id}
+								variant={
+									organization.id === selected?.id ? "default" : "outline"
+								}
+								className="rounded-full"
+								onClick={() => setSelectedId(organization.id)}
+							>
+								{organization.name}
+								<Badge variant="secondary">
+									{roleLabels[organization.role]}
+								</Badge>
+							</Button>
+						))}
+					</div>
+					{selected && (
+						<OrganizationSettings
+							key={selected.id}
+							organization={selected}
+							onDeleted={() => setSelectedId(undefined)}
+						/>
+					)}
+				</div>
+				<div className="h-16" />
+			</ScrollArea>
+		</div>
+	);
+}
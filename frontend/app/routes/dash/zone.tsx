@@ -11,7 +11,7 @@ import {
 } from "lucide-react";
 import { useRef, useState } from "react";
 import { useForm } from "react-hook-form";
-import { Link } from "react-router";
+import { Link, useSearchParams } from "react-router";
 import { z } from "zod";
 import {
 	AlertDialog,
@@ -95,6 +95,9 @@ export default function Zone({ params }: Route.ComponentProps) {
 	const [editingRecord, setEditingRecord] = useState<any>(null);
 
 	const zoneName = params.name;
+	// several organizations can have a zone with the same name
+	const [searchParams] = useSearchParams();
+	const organizationId = searchParams.get("org") ?? "";
 
 	const form = useForm<z.infer<typeof schema>>({
 		resolver: zodResolver(schema),
@@ -130,6 +133,7 @@ export default function Zone({ params }: Route.ComponentProps) {
 			console.log("create mutation", values);
 			const resp = await dnsRecordClient.createDNSRecord({
 				zoneName: zoneName,
+				organizationId: organizationId,
 				name: values.name,
 				type: values.type,
 				content: values.content,
@@ -176,10 +180,11 @@ export default function Zone({ params }: Route.ComponentProps) {
 		isLoading,
 		isLoadingError,
 	} = useQuery({
-		queryKey: ["dns_records", zoneName],
+		queryKey: ["dns_records", zoneName, organizationId],
 		queryFn: async () => {
 			const response = await dnsRecordClient.listDNSRecordsByZoneName({
 				zoneName: zoneName,
+				organizationId: organizationId,
 			});
 			return response.records;
 		},
@@ -262,9 +267,13 @@ export default function Zone({ params }: Route.ComponentProps) {
 												<SelectContent>
 													<SelectItem value="A">A Record</SelectItem>
 													<SelectItem value="CNAME">CNAME Record</SelectItem>
+													<SelectItem value="ALIAS">ALIAS Record</SelectItem>
 													<SelectItem value="MX">MX Record</SelectItem>
 													<SelectItem value="TXT">TXT Record</SelectItem>
 													<SelectItem value="AAAA">AAAA Record</SelectItem>
+													<SelectItem value="CAA">CAA Record</SelectItem>
+													<SelectItem value="HTTPS">HTTPS Record</SelectItem>
+													<SelectItem value="SVCB">SVCB Record</SelectItem>
 												</SelectContent>
 											</Select>
 											<FormMessage />
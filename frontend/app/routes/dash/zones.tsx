@@ -8,6 +8,8 @@ import {
 	TriangleAlertIcon,
 } from "lucide-react";
 import { Link } from "react-router";
+import { toast } from "sonner";
+import { useStepUp } from "~/components/auth/step-up";
 import { CreateZoneDialog } from "~/components/dialogs/create-zone-dialog";
 import {
 	AlertDialog,
@@ -23,7 +25,8 @@ import {
 import { Badge } from "~/components/ui/badge";
 import { Button } from "~/components/ui/button";
 import { ScrollArea } from "~/components/ui/scroll-area";
-import { zoneClient } from "~/connect";
+import { organizationClient, zoneClient } from "~/connect";
+import { errorMessage } from "~/lib/errors";
 
 export default function Zones() {
 	const queryClient = useQueryClient();
@@ -41,17 +44,39 @@ export default function Zones() {
 		},
 	});
 
+	const { data: organizationNames } = useQuery({
+		queryKey: ["organizations"],
+		queryFn: async () => {
+			const res = await organizationClient.listOrganizations({});
+			return res.organizations;
+		},
+		select: (organizations) =>
+			new Map(
+				organizations.map((organization) => [
+					organization.id,
+					organization.name,
+				]),
+			),
+	});
+
+	const withStepUp = useStepUp();
 	const _deleteMutation = useMutation({
 		mutationFn: async (id: string) => {
-			await zoneClient.deleteZone({
-				id: id,
-			});
+			// asks for a second factor first when two-factor auth is on
+			await withStepUp(() =>
+				zoneClient.deleteZone({
+					id: id,
+				}),
+			);
 		},
 		onSuccess: () => {
 			queryClient.invalidateQueries({
 				queryKey: ["zones"],
 			});
 		},
+		onError(err) {
+			toast.error(errorMessage(err));
+		},
 	});
 
 	return (
@@ -82,10 +107,17 @@ export default function Zones() {
 								className="h-fit p-6 w-full border shadow-sm"
 								asChild
 							>
-								<Link to={`/dash/zone/${zone.zoneName}`}>
+								<Link
+									to={`/dash/zone/${zone.zoneName}?org=${zone.organizationId}`}
+								>
 									<GlobeIcon className="size-6" />
 									<div className="flex flex-col gap-1">
 										<p className="text-sm font-medium">{zone.zoneName}</p>
+										{organizationNames && organizationNames.size > 1 && (
+											<p className="text-xs text-muted-foreground">
+												{organizationNames.get(zone.organizationId)}
+											</p>
+										)}
 										{zone.isActive ? (
 											<Badge
 												variant="secondary"
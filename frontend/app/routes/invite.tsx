@@ -0,0 +1,62 @@
+import { useMutation, useQueryClient } from "@tanstack/react-query";
+import { useEffect } from "react";
+import { Link, useNavigate, useSearchParams } from "react-router";
+import { toast } from "sonner";
+import { AuthLayout } from "~/components/auth/auth-layout";
+import { Button } from "~/components/ui/button";
+import { organizationClient } from "~/connect";
+import { errorMessage } from "~/lib/errors";
+import { savePendingInvitation } from "~/lib/invitation";
+import { useAuthStore } from "~/stores/auth";
+
+export default function InvitePage() {
+	const [searchParams] = useSearchParams();
+	const token = searchParams.get("token") ?? "";
+	const signedIn = useAuthStore((state) => !!state.accessToken);
+	const navigate = useNavigate();
+	const queryClient = useQueryClient();
+	useEffect(() => {
+		if (!signedIn && token) {
+			savePendingInvitation(token);
+		}
+	}, [signedIn, token]);
+	const mutation = useMutation({
+		mutationFn: async () => {
+			const res = await organizationClient.acceptInvitation({ token });
+			return res.organization;
+		},
+		onSuccess(organization) {
+			toast.success(`You joined ${organization?.name}`);
+			queryClient.invalidateQueries({ queryKey: ["organizations"] });
+			queryClient.invalidateQueries({ queryKey: ["zones"] });
+			navigate("/dash/organizations", { replace: true });
+		},
+	});
+	return (
+		<AuthLayout
+			title="Join an organization"
+			description={
+				mutation.isError
+					? errorMessage(mutation.error)
+					: signedIn
+						? "You have been invited to join an organization on DNSARC."
+						: "Sign in with the email address the invitation was sent to."
+			}
+		>
+			{signedIn ? (
+				<Button
+					size="lg"
+					className="w-full max-w-sm h-12"
+					disabled={!token || mutation.isPending}
+					onClick={() => mutation.mutate()}
+				>
+					Accept invitation
+				</Button>
+			) : (
+				<Button size="lg" className="w-full max-w-sm h-12" asChild>
+					<Link to="/auth">Sign in to accept</Link>
+				</Button>
+			)}
+		</AuthLayout>
+	);
+}
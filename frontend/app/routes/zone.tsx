@@ -244,9 +244,17 @@ export default function Records({ loaderData }: Route.ComponentProps) {
 															<SelectItem value="CNAME">
 																CNAME Record
 															</SelectItem>
+															<SelectItem value="ALIAS">
+																ALIAS Record
+															</SelectItem>
 															<SelectItem value="MX">MX Record</SelectItem>
 															<SelectItem value="TXT">TXT Record</SelectItem>
 															<SelectItem value="AAAA">AAAA Record</SelectItem>
+															<SelectItem value="CAA">CAA Record</SelectItem>
+															<SelectItem value="HTTPS">
+																HTTPS Record
+															</SelectItem>
+															<SelectItem value="SVCB">SVCB Record</SelectItem>
 														</SelectContent>
 													</Select>
 													<FormMessage />
@@ -374,11 +382,23 @@ export default function Records({ loaderData }: Route.ComponentProps) {
 																<SelectItem value="CNAME">
 																	CNAME Record
 																</SelectItem>
+																<SelectItem value="ALIAS">
+																	ALIAS Record
+																</SelectItem>
 																<SelectItem value="MX">MX Record</SelectItem>
 																<SelectItem value="TXT">TXT Record</SelectItem>
 																<SelectItem value="AAAA">
 																	AAAA Record
 																</SelectItem>
+																<SelectItem value="CAA">
+																	CAA Record
+																</SelectItem>
+																<SelectItem value="HTTPS">
+																	HTTPS Record
+																</SelectItem>
+																<SelectItem value="SVCB">
+																	SVCB Record
+																</SelectItem>
 															</SelectContent>
 														</Select>
 														<FormMessage />
@@ -472,6 +492,7 @@ function RecordCard({ record }: { record: DNSRecord }) {
 			case "A":
 				return "🌐";
 			case "CNAME":
+			case "ALIAS":
 				return "🔗";
 			case "MX":
 				return "📧";
@@ -485,6 +506,8 @@ function RecordCard({ record }: { record: DNSRecord }) {
 				return "🔄";
 			case "SRV":
 				return "🎯";
+			case "CAA":
+				return "🔒";
 			default:
 				return "📋";
 		}
@@ -495,6 +518,7 @@ function RecordCard({ record }: { record: DNSRecord }) {
 			case "A":
 				return "bg-blue-100 text-blue-800 border-blue-200";
 			case "CNAME":
+			case "ALIAS":
 				return "bg-green-100 text-green-800 border-green-200";
 			case "MX":
 				return "bg-purple-100 text-purple-800 border-purple-200";
@@ -508,6 +532,8 @@ function RecordCard({ record }: { record: DNSRecord }) {
 				return "bg-yellow-100 text-yellow-800 border-yellow-200";
 			case "SRV":
 				return "bg-pink-100 text-pink-800 border-pink-200";
+			case "CAA":
+				return "bg-teal-100 text-teal-800 border-teal-200";
 			default:
 				return "bg-gray-100 text-gray-800 border-gray-200";
 		}
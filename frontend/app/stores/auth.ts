@@ -3,7 +3,8 @@ import { persist } from "zustand/middleware";
 
 interface AuthState {
 	accessToken: string | null;
-	signIn: (accessToken: string) => void;
+	refreshToken: string | null;
+	signIn: (accessToken: string, refreshToken: string) => void;
 	signOut: () => void;
 }
 
@@ -11,11 +12,12 @@ export const useAuthStore = create<AuthState>()(
 	persist(
 		(set) => ({
 			accessToken: null,
-			signIn: (accessToken: string) => {
-				set({ accessToken });
+			refreshToken: null,
+			signIn: (accessToken: string, refreshToken: string) => {
+				set({ accessToken, refreshToken });
 			},
 			signOut: () => {
-				set({ accessToken: null });
+				set({ accessToken: null, refreshToken: null });
 			},
 		}),
 		{
@@ -0,0 +1,294 @@
+// @generated by protoc-gen-es v2.2.5 with parameter "target=ts"
+// @generated from file analytics/v1/analytics.proto (package analytics.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file analytics/v1/analytics.proto.
+ */
+export const file_analytics_v1_analytics: GenFile = /*@__PURE__*/
+  fileDesc("ChxhbmFseXRpY3MvdjEvYW5hbHl0aWNzLnByb3RvEgxhbmFseXRpY3MudjEiSgoLUXVlcnlGaWx0ZXISDAoEbmFtZRgBIAEoCRINCgVxdHlwZRgCIAEoCRINCgVyY29kZRgDIAEoCRIPCgdjb3VudHJ5GAQgASgJIikKBVBvaW50EhEKCXRpbWVzdGFtcBgBIAEoCRINCgVjb3VudBgCIAEoAyJJCgZTZXJpZXMSCwoDa2V5GAEgASgJEiMKBnBvaW50cxgCIAMoCzITLmFuYWx5dGljcy52MS5Qb2ludBINCgV0b3RhbBgDIAEoAyK0AQoZR2V0UXVlcnlUaW1lU2VyaWVzUmVxdWVzdBIPCgd6b25lX2lkGAEgASgJEg0KBXN0YXJ0GAIgASgJEgsKA2VuZBgDIAEoCRIUCgxzdGVwX3NlY29uZHMYBCABKAMSKQoIZ3JvdXBfYnkYBSABKA4yFy5hbmFseXRpY3MudjEuRGltZW5zaW9uEikKBmZpbHRlchgGIAEoCzIZLmFuYWx5dGljcy52MS5RdWVyeUZpbHRlciJYChpHZXRRdWVyeVRpbWVTZXJpZXNSZXNwb25zZRIkCgZzZXJpZXMYASADKAsyFC5hbmFseXRpY3MudjEuU2VyaWVzEhQKDHN0ZXBfc2Vjb25kcxgCIAEoAyIoCglOYW1lQ291bnQSDAoEbmFtZRgBIAEoCRINCgVjb3VudBgCIAEoAyJ7ChJHZXRUb3BOYW1lc1JlcXVlc3QSDwoHem9uZV9pZBgBIAEoCRINCgVzdGFydBgCIAEoCRILCgNlbmQYAyABKAkSDQoFbGltaXQYBCABKAUSKQoGZmlsdGVyGAUgASgLMhkuYW5hbHl0aWNzLnYxLlF1ZXJ5RmlsdGVyIj0KE0dldFRvcE5hbWVzUmVzcG9uc2USJgoFbmFtZXMYASADKAsyFy5hbmFseXRpY3MudjEuTmFtZUNvdW50KnsKCURpbWVuc2lvbhIZChVESU1FTlNJT05fVU5TUEVDSUZJRUQQABITCg9ESU1FTlNJT05fUVRZUEUQARITCg9ESU1FTlNJT05fUkNPREUQAhIVChFESU1FTlNJT05fQ09VTlRSWRADEhIKDkRJTUVOU0lPTl9OQU1FEAQy0wEKEEFuYWx5dGljc1NlcnZpY2USaQoSR2V0UXVlcnlUaW1lU2VyaWVzEicuYW5hbHl0aWNzLnYxLkdldFF1ZXJ5VGltZVNlcmllc1JlcXVlc3QaKC5hbmFseXRpY3MudjEuR2V0UXVlcnlUaW1lU2VyaWVzUmVzcG9uc2UiABJUCgtHZXRUb3BOYW1lcxIgLmFuYWx5dGljcy52MS5HZXRUb3BOYW1lc1JlcXVlc3QaIS5hbmFseXRpY3MudjEuR2V0VG9wTmFtZXNSZXNwb25zZSIAQiVaI2Ruc2FyYy9nZW4vYW5hbHl0aWNzL3YxO2FuYWx5dGljc3YxYgZwcm90bzM");
+
+/**
+ * @generated from message analytics.v1.QueryFilter
+ */
+export type QueryFilter = Message<"analytics.v1.QueryFilter"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string qtype = 2;
+   */
+  qtype: string;
+
+  /**
+   * @generated from field: string rcode = 3;
+   */
+  rcode: string;
+
+  /**
+   * @generated from field: string country = 4;
+   */
+  country: string;
+};
+
+/**
+ * Describes the message analytics.v1.QueryFilter.
+ * Use `create(QueryFilterSchema)` to create a new message.
+ */
+export const QueryFilterSchema: GenMessage<QueryFilter> = /*@__PURE__*/
+  messageDesc(file_analytics_v1_analytics, 0);
+
+/**
+ * @generated from message analytics.v1.Point
+ */
+export type Point = Message<"analytics.v1.Point"> & {
+  /**
+   * @generated from field: string timestamp = 1;
+   */
+  timestamp: string;
+
+  /**
+   * @generated from field: int64 count = 2;
+   */
+  count: bigint;
+};
+
+/**
+ * Describes the message analytics.v1.Point.
+ * Use `create(PointSchema)` to create a new message.
+ */
+export const PointSchema: GenMessage<Point> = /*@__PURE__*/
+  messageDesc(file_analytics_v1_analytics, 1);
+
+/**
+ * @generated from message analytics.v1.Series
+ */
+export type Series = Message<"analytics.v1.Series"> & {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key: string;
+
+  /**
+   * @generated from field: repeated analytics.v1.Point points = 2;
+   */
+  points: Point[];
+
+  /**
+   * @generated from field: int64 total = 3;
+   */
+  total: bigint;
+};
+
+/**
+ * Describes the message analytics.v1.Series.
+ * Use `create(SeriesSchema)` to create a new message.
+ */
+export const SeriesSchema: GenMessage<Series> = /*@__PURE__*/
+  messageDesc(file_analytics_v1_analytics, 2);
+
+/**
+ * @generated from message analytics.v1.GetQueryTimeSeriesRequest
+ */
+export type GetQueryTimeSeriesRequest = Message<"analytics.v1.GetQueryTimeSeriesRequest"> & {
+  /**
+   * @generated from field: string zone_id = 1;
+   */
+  zoneId: string;
+
+  /**
+   * @generated from field: string start = 2;
+   */
+  start: string;
+
+  /**
+   * @generated from field: string end = 3;
+   */
+  end: string;
+
+  /**
+   * @generated from field: int64 step_seconds = 4;
+   */
+  stepSeconds: bigint;
+
+  /**
+   * @generated from field: analytics.v1.Dimension group_by = 5;
+   */
+  groupBy: Dimension;
+
+  /**
+   * @generated from field: analytics.v1.QueryFilter filter = 6;
+   */
+  filter?: QueryFilter;
+};
+
+/**
+ * Describes the message analytics.v1.GetQueryTimeSeriesRequest.
+ * Use `create(GetQueryTimeSeriesRequestSchema)` to create a new message.
+ */
+export const GetQueryTimeSeriesRequestSchema: GenMessage<GetQueryTimeSeriesRequest> = /*@__PURE__*/
+  messageDesc(file_analytics_v1_analytics, 3);
+
+/**
+ * @generated from message analytics.v1.GetQueryTimeSeriesResponse
+ */
+export type GetQueryTimeSeriesResponse = Message<"analytics.v1.GetQueryTimeSeriesResponse"> & {
+  /**
+   * @generated from field: repeated analytics.v1.Series series = 1;
+   */
+  series: Series[];
+
+  /**
+   * @generated from field: int64 step_seconds = 2;
+   */
+  stepSeconds: bigint;
+};
+
+/**
+ * Describes the message analytics.v1.GetQueryTimeSeriesResponse.
+ * Use `create(GetQueryTimeSeriesResponseSchema)` to create a new message.
+ */
+export const GetQueryTimeSeriesResponseSchema: GenMessage<GetQueryTimeSeriesResponse> = /*@__PURE__*/
+  messageDesc(file_analytics_v1_analytics, 4);
+
+/**
+ * @generated from message analytics.v1.NameCount
+ */
+export type NameCount = Message<"analytics.v1.NameCount"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: int64 count = 2;
+   */
+  count: bigint;
+};
+
+/**
+ * Describes the message analytics.v1.NameCount.
+ * Use `create(NameCountSchema)` to create a new message.
+ */
+export const NameCountSchema: GenMessage<NameCount> = /*@__PURE__*/
+  messageDesc(file_analytics_v1_analytics, 5);
+
+/**
+ * @generated from message analytics.v1.GetTopNamesRequest
+ */
+export type GetTopNamesRequest = Message<"analytics.v1.GetTopNamesRequest"> & {
+  /**
+   * @generated from field: string zone_id = 1;
+   */
+  zoneId: string;
+
+  /**
+   * @generated from field: string start = 2;
+   */
+  start: string;
+
+  /**
+   * @generated from field: string end = 3;
+   */
+  end: string;
+
+  /**
+   * @generated from field: int32 limit = 4;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: analytics.v1.QueryFilter filter = 5;
+   */
+  filter?: QueryFilter;
+};
+
+/**
+ * Describes the message analytics.v1.GetTopNamesRequest.
+ * Use `create(GetTopNamesRequestSchema)` to create a new message.
+ */
+export const GetTopNamesRequestSchema: GenMessage<GetTopNamesRequest> = /*@__PURE__*/
+  messageDesc(file_analytics_v1_analytics, 6);
+
+/**
+ * @generated from message analytics.v1.GetTopNamesResponse
+ */
+export type GetTopNamesResponse = Message<"analytics.v1.GetTopNamesResponse"> & {
+  /**
+   * @generated from field: repeated analytics.v1.NameCount names = 1;
+   */
+  names: NameCount[];
+};
+
+/**
+ * Describes the message analytics.v1.GetTopNamesResponse.
+ * Use `create(GetTopNamesResponseSchema)` to create a new message.
+ */
+export const GetTopNamesResponseSchema: GenMessage<GetTopNamesResponse> = /*@__PURE__*/
+  messageDesc(file_analytics_v1_analytics, 7);
+
+/**
+ * @generated from enum analytics.v1.Dimension
+ */
+export enum Dimension {
+  /**
+   * @generated from enum value: DIMENSION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DIMENSION_QTYPE = 1;
+   */
+  QTYPE = 1,
+
+  /**
+   * @generated from enum value: DIMENSION_RCODE = 2;
+   */
+  RCODE = 2,
+
+  /**
+   * @generated from enum value: DIMENSION_COUNTRY = 3;
+   */
+  COUNTRY = 3,
+
+  /**
+   * @generated from enum value: DIMENSION_NAME = 4;
+   */
+  NAME = 4,
+}
+
+/**
+ * Describes the enum analytics.v1.Dimension.
+ */
+export const DimensionSchema: GenEnum<Dimension> = /*@__PURE__*/
+  enumDesc(file_analytics_v1_analytics, 0);
+
+/**
+ * @generated from service analytics.v1.AnalyticsService
+ */
+export const AnalyticsService: GenService<{
+  /**
+   * @generated from rpc analytics.v1.AnalyticsService.GetQueryTimeSeries
+   */
+  getQueryTimeSeries: {
+    methodKind: "unary";
+    input: typeof GetQueryTimeSeriesRequestSchema;
+    output: typeof GetQueryTimeSeriesResponseSchema;
+  },
+  /**
+   * @generated from rpc analytics.v1.AnalyticsService.GetTopNames
+   */
+  getTopNames: {
+    methodKind: "unary";
+    input: typeof GetTopNamesRequestSchema;
+    output: typeof GetTopNamesResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_analytics_v1_analytics, 0);
+
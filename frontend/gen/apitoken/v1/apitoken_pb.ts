@@ -0,0 +1,237 @@
+// @generated by protoc-gen-es v2.2.5 with parameter "target=ts"
+// @generated from file apitoken/v1/apitoken.proto (package apitoken.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file apitoken/v1/apitoken.proto.
+ */
+export const file_apitoken_v1_apitoken: GenFile = /*@__PURE__*/
+  fileDesc("ChphcGl0b2tlbi92MS9hcGl0b2tlbi5wcm90bxILYXBpdG9rZW4udjEipwEKCEFwaVRva2VuEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSDgoGcHJlZml4GAMgASgJEiEKBXNjb3BlGAQgASgOMhIuYXBpdG9rZW4udjEuU2NvcGUSEAoIem9uZV9pZHMYBSADKAkSEgoKZXhwaXJlc19hdBgGIAEoCRIUCgxsYXN0X3VzZWRfYXQYByABKAkSEgoKY3JlYXRlZF9hdBgIIAEoCSJuChVDcmVhdGVBcGlUb2tlblJlcXVlc3QSDAoEbmFtZRgBIAEoCRIhCgVzY29wZRgCIAEoDjISLmFwaXRva2VuLnYxLlNjb3BlEhAKCHpvbmVfaWRzGAMgAygJEhIKCmV4cGlyZXNfYXQYBCABKAkiUQoWQ3JlYXRlQXBpVG9rZW5SZXNwb25zZRIoCglhcGlfdG9rZW4YASABKAsyFS5hcGl0b2tlbi52MS5BcGlUb2tlbhINCgV0b2tlbhgCIAEoCSIWChRMaXN0QXBpVG9rZW5zUmVxdWVzdCJCChVMaXN0QXBpVG9rZW5zUmVzcG9uc2USKQoKYXBpX3Rva2VucxgBIAMoCzIVLmFwaXRva2VuLnYxLkFwaVRva2VuIiMKFVJldm9rZUFwaVRva2VuUmVxdWVzdBIKCgJpZBgBIAEoCSIYChZSZXZva2VBcGlUb2tlblJlc3BvbnNlKj8KBVNjb3BlEhUKEVNDT1BFX1VOU1BFQ0lGSUVEEAASDgoKU0NPUEVfUkVBRBABEg8KC1NDT1BFX1dSSVRFEAIypQIKD0FwaVRva2VuU2VydmljZRJbCg5DcmVhdGVBcGlUb2tlbhIiLmFwaXRva2VuLnYxLkNyZWF0ZUFwaVRva2VuUmVxdWVzdBojLmFwaXRva2VuLnYxLkNyZWF0ZUFwaVRva2VuUmVzcG9uc2UiABJYCg1MaXN0QXBpVG9rZW5zEiEuYXBpdG9rZW4udjEuTGlzdEFwaVRva2Vuc1JlcXVlc3QaIi5hcGl0b2tlbi52MS5MaXN0QXBpVG9rZW5zUmVzcG9uc2UiABJbCg5SZXZva2VBcGlUb2tlbhIiLmFwaXRva2VuLnYxLlJldm9rZUFwaVRva2VuUmVxdWVzdBojLmFwaXRva2VuLnYxLlJldm9rZUFwaVRva2VuUmVzcG9uc2UiAEIjWiFkbnNhcmMvZ2VuL2FwaXRva2VuL3YxO2FwaXRva2VudjFiBnByb3RvMw");
+
+/**
+ * @generated from message apitoken.v1.ApiToken
+ */
+export type ApiToken = Message<"apitoken.v1.ApiToken"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string prefix = 3;
+   */
+  prefix: string;
+
+  /**
+   * @generated from field: apitoken.v1.Scope scope = 4;
+   */
+  scope: Scope;
+
+  /**
+   * @generated from field: repeated string zone_ids = 5;
+   */
+  zoneIds: string[];
+
+  /**
+   * @generated from field: string expires_at = 6;
+   */
+  expiresAt: string;
+
+  /**
+   * @generated from field: string last_used_at = 7;
+   */
+  lastUsedAt: string;
+
+  /**
+   * @generated from field: string created_at = 8;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message apitoken.v1.ApiToken.
+ * Use `create(ApiTokenSchema)` to create a new message.
+ */
+export const ApiTokenSchema: GenMessage<ApiToken> = /*@__PURE__*/
+  messageDesc(file_apitoken_v1_apitoken, 0);
+
+/**
+ * @generated from message apitoken.v1.CreateApiTokenRequest
+ */
+export type CreateApiTokenRequest = Message<"apitoken.v1.CreateApiTokenRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: apitoken.v1.Scope scope = 2;
+   */
+  scope: Scope;
+
+  /**
+   * @generated from field: repeated string zone_ids = 3;
+   */
+  zoneIds: string[];
+
+  /**
+   * @generated from field: string expires_at = 4;
+   */
+  expiresAt: string;
+};
+
+/**
+ * Describes the message apitoken.v1.CreateApiTokenRequest.
+ * Use `create(CreateApiTokenRequestSchema)` to create a new message.
+ */
+export const CreateApiTokenRequestSchema: GenMessage<CreateApiTokenRequest> = /*@__PURE__*/
+  messageDesc(file_apitoken_v1_apitoken, 1);
+
+/**
+ * @generated from message apitoken.v1.CreateApiTokenResponse
+ */
+export type CreateApiTokenResponse = Message<"apitoken.v1.CreateApiTokenResponse"> & {
+  /**
+   * @generated from field: apitoken.v1.ApiToken api_token = 1;
+   */
+  apiToken?: ApiToken;
+
+  /**
+   * @generated from field: string token = 2;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message apitoken.v1.CreateApiTokenResponse.
+ * Use `create(CreateApiTokenResponseSchema)` to create a new message.
+ */
+export const CreateApiTokenResponseSchema: GenMessage<CreateApiTokenResponse> = /*@__PURE__*/
+  messageDesc(file_apitoken_v1_apitoken, 2);
+
+/**
+ * @generated from message apitoken.v1.ListApiTokensRequest
+ */
+export type ListApiTokensRequest = Message<"apitoken.v1.ListApiTokensRequest"> & {
+};
+
+/**
+ * Describes the message apitoken.v1.ListApiTokensRequest.
+ * Use `create(ListApiTokensRequestSchema)` to create a new message.
+ */
+export const ListApiTokensRequestSchema: GenMessage<ListApiTokensRequest> = /*@__PURE__*/
+  messageDesc(file_apitoken_v1_apitoken, 3);
+
+/**
+ * @generated from message apitoken.v1.ListApiTokensResponse
+ */
+export type ListApiTokensResponse = Message<"apitoken.v1.ListApiTokensResponse"> & {
+  /**
+   * @generated from field: repeated apitoken.v1.ApiToken api_tokens = 1;
+   */
+  apiTokens: ApiToken[];
+};
+
+/**
+ * Describes the message apitoken.v1.ListApiTokensResponse.
+ * Use `create(ListApiTokensResponseSchema)` to create a new message.
+ */
+export const ListApiTokensResponseSchema: GenMessage<ListApiTokensResponse> = /*@__PURE__*/
+  messageDesc(file_apitoken_v1_apitoken, 4);
+
+/**
+ * @generated from message apitoken.v1.RevokeApiTokenRequest
+ */
+export type RevokeApiTokenRequest = Message<"apitoken.v1.RevokeApiTokenRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message apitoken.v1.RevokeApiTokenRequest.
+ * Use `create(RevokeApiTokenRequestSchema)` to create a new message.
+ */
+export const RevokeApiTokenRequestSchema: GenMessage<RevokeApiTokenRequest> = /*@__PURE__*/
+  messageDesc(file_apitoken_v1_apitoken, 5);
+
+/**
+ * @generated from message apitoken.v1.RevokeApiTokenResponse
+ */
+export type RevokeApiTokenResponse = Message<"apitoken.v1.RevokeApiTokenResponse"> & {
+};
+
+/**
+ * Describes the message apitoken.v1.RevokeApiTokenResponse.
+ * Use `create(RevokeApiTokenResponseSchema)` to create a new message.
+ */
+export const RevokeApiTokenResponseSchema: GenMessage<RevokeApiTokenResponse> = /*@__PURE__*/
+  messageDesc(file_apitoken_v1_apitoken, 6);
+
+/**
+ * @generated from enum apitoken.v1.Scope
+ */
+export enum Scope {
+  /**
+   * @generated from enum value: SCOPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SCOPE_READ = 1;
+   */
+  READ = 1,
+
+  /**
+   * @generated from enum value: SCOPE_WRITE = 2;
+   */
+  WRITE = 2,
+}
+
+/**
+ * Describes the enum apitoken.v1.Scope.
+ */
+export const ScopeSchema: GenEnum<Scope> = /*@__PURE__*/
+  enumDesc(file_apitoken_v1_apitoken, 0);
+
+/**
+ * @generated from service apitoken.v1.ApiTokenService
+ */
+export const ApiTokenService: GenService<{
+  /**
+   * @generated from rpc apitoken.v1.ApiTokenService.CreateApiToken
+   */
+  createApiToken: {
+    methodKind: "unary";
+    input: typeof CreateApiTokenRequestSchema;
+    output: typeof CreateApiTokenResponseSchema;
+  },
+  /**
+   * @generated from rpc apitoken.v1.ApiTokenService.ListApiTokens
+   */
+  listApiTokens: {
+    methodKind: "unary";
+    input: typeof ListApiTokensRequestSchema;
+    output: typeof ListApiTokensResponseSchema;
+  },
+  /**
+   * @generated from rpc apitoken.v1.ApiTokenService.RevokeApiToken
+   */
+  revokeApiToken: {
+    methodKind: "unary";
+    input: typeof RevokeApiTokenRequestSchema;
+    output: typeof RevokeApiTokenResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_apitoken_v1_apitoken, 0);
+
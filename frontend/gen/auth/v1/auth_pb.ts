@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file auth/v1/auth.proto.
  */
 export const file_auth_v1_auth: GenFile = /*@__PURE__*/
-  fileDesc("ChJhdXRoL3YxL2F1dGgucHJvdG8SB2F1dGgudjEiWQoEVXNlchIKCgJpZBgBIAEoCRINCgVlbWFpbBgCIAEoCRIOCgZhdmF0YXIYAyABKAkSEgoKY3JlYXRlZF9hdBgEIAEoCRISCgp1cGRhdGVkX2F0GAUgASgJIhcKFUdvb2dsZUxvZ2luVVJMUmVxdWVzdCIlChZHb29nbGVMb2dpblVSTFJlc3BvbnNlEgsKA3VybBgBIAEoCSIPCg1XaG9BbUlSZXF1ZXN0Ii0KDldob0FtSVJlc3BvbnNlEhsKBHVzZXIYASABKAsyDS5hdXRoLnYxLlVzZXIynwEKC0F1dGhTZXJ2aWNlElMKDkdvb2dsZUxvZ2luVVJMEh4uYXV0aC52MS5Hb29nbGVMb2dpblVSTFJlcXVlc3QaHy5hdXRoLnYxLkdvb2dsZUxvZ2luVVJMUmVzcG9uc2UiABI7CgZXaG9BbUkSFi5hdXRoLnYxLldob0FtSVJlcXVlc3QaFy5hdXRoLnYxLldob0FtSVJlc3BvbnNlIgBCG1oZZG5zYXJjL2dlbi9hdXRoL3YxO2F1dGh2MWIGcHJvdG8z");
+  fileDesc("ChJhdXRoL3YxL2F1dGgucHJvdG8SB2F1dGgudjEihgEKBFVzZXISCgoCaWQYASABKAkSDQoFZW1haWwYAiABKAkSDgoGYXZhdGFyGAMgASgJEhIKCmNyZWF0ZWRfYXQYBCABKAkSEgoKdXBkYXRlZF9hdBgFIAEoCRIWCg5lbWFpbF92ZXJpZmllZBgGIAEoCBITCgttZmFfZW5hYmxlZBgHIAEoCCIzCg1Mb2dpblByb3ZpZGVyEgwKBG5hbWUYASABKAkSFAoMZGlzcGxheV9uYW1lGAIgASgJIksKCElkZW50aXR5EgoKAmlkGAEgASgJEhAKCHByb3ZpZGVyGAIgASgJEg0KBWVtYWlsGAMgASgJEhIKCmNyZWF0ZWRfYXQYBCABKAkiPQoMTUZBQ2hhbGxlbmdlEg0KBXRva2VuGAEgASgJEgwKBHRvdHAYAiABKAgSEAoId2ViYXV0aG4YAyABKAgihAEKB1Nlc3Npb24SCgoCaWQYASABKAkSEgoKdXNlcl9hZ2VudBgCIAEoCRIKCgJpcBgDIAEoCRIPCgdjdXJyZW50GAQgASgIEhIKCmNyZWF0ZWRfYXQYBSABKAkSFAoMbGFzdF91c2VkX2F0GAYgASgJEhIKCmV4cGlyZXNfYXQYByABKAkiFwoVR29vZ2xlTG9naW5VUkxSZXF1ZXN0IiUKFkdvb2dsZUxvZ2luVVJMUmVzcG9uc2USCwoDdXJsGAEgASgJIg8KDVdob0FtSVJlcXVlc3QiLQoOV2hvQW1JUmVzcG9uc2USGwoEdXNlchgBIAEoCzINLmF1dGgudjEuVXNlciIbChlMaXN0TG9naW5Qcm92aWRlcnNSZXF1ZXN0IkcKGkxpc3RMb2dpblByb3ZpZGVyc1Jlc3BvbnNlEikKCXByb3ZpZGVycxgBIAMoCzIWLmF1dGgudjEuTG9naW5Qcm92aWRlciImChJHZXRMb2dpblVSTFJlcXVlc3QSEAoIcHJvdmlkZXIYASABKAkiIgoTR2V0TG9naW5VUkxSZXNwb25zZRILCgN1cmwYASABKAkiJQoRR2V0TGlua1VSTFJlcXVlc3QSEAoIcHJvdmlkZXIYASABKAkiIQoSR2V0TGlua1VSTFJlc3BvbnNlEgsKA3VybBgBIAEoCSIXChVMaXN0SWRlbnRpdGllc1JlcXVlc3QiPwoWTGlzdElkZW50aXRpZXNSZXNwb25zZRIlCgppZGVudGl0aWVzGAEgAygLMhEuYXV0aC52MS5JZGVudGl0eSIjChVVbmxpbmtJZGVudGl0eVJlcXVlc3QSCgoCaWQYASABKAkiGAoWVW5saW5rSWRlbnRpdHlSZXNwb25zZSInChdFeGNoYW5nZUF1dGhDb2RlUmVxdWVzdBIMCgRjb2RlGAEgASgJImQKGEV4Y2hhbmdlQXV0aENvZGVSZXNwb25zZRINCgV0b2tlbhgBIAEoCRIVCg1yZWZyZXNoX3Rva2VuGAIgASgJEiIKA21mYRgDIAEoCzIVLmF1dGgudjEuTUZBQ2hhbGxlbmdlIiMKE0NvbXBsZXRlTGlua1JlcXVlc3QSDAoEY29kZRgBIAEoCSI7ChRDb21wbGV0ZUxpbmtSZXNwb25zZRIjCghpZGVudGl0eRgBIAEoCzIRLmF1dGgudjEuSWRlbnRpdHkiMgoPUmVnaXN0ZXJSZXF1ZXN0Eg0KBWVtYWlsGAEgASgJEhAKCHBhc3N3b3JkGAIgASgJIhIKEFJlZ2lzdGVyUmVzcG9uc2UiLwoMTG9naW5SZXF1ZXN0Eg0KBWVtYWlsGAEgASgJEhAKCHBhc3N3b3JkGAIgASgJIlkKDUxvZ2luUmVzcG9uc2USDQoFdG9rZW4YASABKAkSFQoNcmVmcmVzaF90b2tlbhgCIAEoCRIiCgNtZmEYAyABKAsyFS5hdXRoLnYxLk1GQUNoYWxsZW5nZSIjChJWZXJpZnlFbWFpbFJlcXVlc3QSDQoFdG9rZW4YASABKAkiFQoTVmVyaWZ5RW1haWxSZXNwb25zZSImChVGb3Jnb3RQYXNzd29yZFJlcXVlc3QSDQoFZW1haWwYASABKAkiGAoWRm9yZ290UGFzc3dvcmRSZXNwb25zZSI3ChRSZXNldFBhc3N3b3JkUmVxdWVzdBINCgV0b2tlbhgBIAEoCRIQCghwYXNzd29yZBgCIAEoCSIXChVSZXNldFBhc3N3b3JkUmVzcG9uc2UiLAoTUmVmcmVzaFRva2VuUmVxdWVzdBIVCg1yZWZyZXNoX3Rva2VuGAEgASgJIjwKFFJlZnJlc2hUb2tlblJlc3BvbnNlEg0KBXRva2VuGAEgASgJEhUKDXJlZnJlc2hfdG9rZW4YAiABKAkiLAoXQmVnaW5NRkFXZWJBdXRoblJlcXVlc3QSEQoJbWZhX3Rva2VuGAEgASgJIjAKGEJlZ2luTUZBV2ViQXV0aG5SZXNwb25zZRIUCgxvcHRpb25zX2pzb24YASABKAkibwoQVmVyaWZ5TUZBUmVxdWVzdBIRCgltZmFfdG9rZW4YASABKAkSEQoJdG90cF9jb2RlGAIgASgJEhUKDXJlY292ZXJ5X2NvZGUYAyABKAkSHgoWd2ViYXV0aG5fcmVzcG9uc2VfanNvbhgEIAEoCSI5ChFWZXJpZnlNRkFSZXNwb25zZRINCgV0b2tlbhgBIAEoCRIVCg1yZWZyZXNoX3Rva2VuGAIgASgJIg8KDUxvZ291dFJlcXVlc3QiEAoOTG9nb3V0UmVzcG9uc2UiFQoTTGlzdFNlc3Npb25zUmVxdWVzdCI6ChRMaXN0U2Vzc2lvbnNSZXNwb25zZRIiCghzZXNzaW9ucxgBIAMoCzIQLmF1dGgudjEuU2Vzc2lvbiIiChRSZXZva2VTZXNzaW9uUmVxdWVzdBIKCgJpZBgBIAEoCSIXChVSZXZva2VTZXNzaW9uUmVzcG9uc2UiHAoaUmV2b2tlT3RoZXJTZXNzaW9uc1JlcXVlc3QiHQobUmV2b2tlT3RoZXJTZXNzaW9uc1Jlc3BvbnNlMo4NCgtBdXRoU2VydmljZRJWCg5Hb29nbGVMb2dpblVSTBIeLmF1dGgudjEuR29vZ2xlTG9naW5VUkxSZXF1ZXN0Gh8uYXV0aC52MS5Hb29nbGVMb2dpblVSTFJlc3BvbnNlIgOIAgESXwoSTGlzdExvZ2luUHJvdmlkZXJzEiIuYXV0aC52MS5MaXN0TG9naW5Qcm92aWRlcnNSZXF1ZXN0GiMuYXV0aC52MS5MaXN0TG9naW5Qcm92aWRlcnNSZXNwb25zZSIAEkoKC0dldExvZ2luVVJMEhsuYXV0aC52MS5HZXRMb2dpblVSTFJlcXVlc3QaHC5hdXRoLnYxLkdldExvZ2luVVJMUmVzcG9uc2UiABJHCgpHZXRMaW5rVVJMEhouYXV0aC52MS5HZXRMaW5rVVJMUmVxdWVzdBobLmF1dGgudjEuR2V0TGlua1VSTFJlc3BvbnNlIgASUwoOTGlzdElkZW50aXRpZXMSHi5hdXRoLnYxLkxpc3RJZGVudGl0aWVzUmVxdWVzdBofLmF1dGgudjEuTGlzdElkZW50aXRpZXNSZXNwb25zZSIAElMKDlVubGlua0lkZW50aXR5Eh4uYXV0aC52MS5VbmxpbmtJZGVudGl0eVJlcXVlc3QaHy5hdXRoLnYxLlVubGlua0lkZW50aXR5UmVzcG9uc2UiABJZChBFeGNoYW5nZUF1dGhDb2RlEiAuYXV0aC52MS5FeGNoYW5nZUF1dGhDb2RlUmVxdWVzdBohLmF1dGgudjEuRXhjaGFuZ2VBdXRoQ29kZVJlc3BvbnNlIgASTQoMQ29tcGxldGVMaW5rEhwuYXV0aC52MS5Db21wbGV0ZUxpbmtSZXF1ZXN0Gh0uYXV0aC52MS5Db21wbGV0ZUxpbmtSZXNwb25zZSIAEjsKBldob0FtSRIWLmF1dGgudjEuV2hvQW1JUmVxdWVzdBoXLmF1dGgudjEuV2hvQW1JUmVzcG9uc2UiABJBCghSZWdpc3RlchIYLmF1dGgudjEuUmVnaXN0ZXJSZXF1ZXN0GhkuYXV0aC52MS5SZWdpc3RlclJlc3BvbnNlIgASOAoFTG9naW4SFS5hdXRoLnYxLkxvZ2luUmVxdWVzdBoWLmF1dGgudjEuTG9naW5SZXNwb25zZSIAEkoKC1ZlcmlmeUVtYWlsEhsuYXV0aC52MS5WZXJpZnlFbWFpbFJlcXVlc3QaHC5hdXRoLnYxLlZlcmlmeUVtYWlsUmVzcG9uc2UiABJTCg5Gb3Jnb3RQYXNzd29yZBIeLmF1dGgudjEuRm9yZ290UGFzc3dvcmRSZXF1ZXN0Gh8uYXV0aC52MS5Gb3Jnb3RQYXNzd29yZFJlc3BvbnNlIgASUAoNUmVzZXRQYXNzd29yZBIdLmF1dGgudjEuUmVzZXRQYXNzd29yZFJlcXVlc3QaHi5hdXRoLnYxLlJlc2V0UGFzc3dvcmRSZXNwb25zZSIAElkKEEJlZ2luTUZBV2ViQXV0aG4SIC5hdXRoLnYxLkJlZ2luTUZBV2ViQXV0aG5SZXF1ZXN0GiEuYXV0aC52MS5CZWdpbk1GQVdlYkF1dGhuUmVzcG9uc2UiABJECglWZXJpZnlNRkESGS5hdXRoLnYxLlZlcmlmeU1GQVJlcXVlc3QaGi5hdXRoLnYxLlZlcmlmeU1GQVJlc3BvbnNlIgASTQoMUmVmcmVzaFRva2VuEhwuYXV0aC52MS5SZWZyZXNoVG9rZW5SZXF1ZXN0Gh0uYXV0aC52MS5SZWZyZXNoVG9rZW5SZXNwb25zZSIAEjsKBkxvZ291dBIWLmF1dGgudjEuTG9nb3V0UmVxdWVzdBoXLmF1dGgudjEuTG9nb3V0UmVzcG9uc2UiABJNCgxMaXN0U2Vzc2lvbnMSHC5hdXRoLnYxLkxpc3RTZXNzaW9uc1JlcXVlc3QaHS5hdXRoLnYxLkxpc3RTZXNzaW9uc1Jlc3BvbnNlIgASUAoNUmV2b2tlU2Vzc2lvbhIdLmF1dGgudjEuUmV2b2tlU2Vzc2lvblJlcXVlc3QaHi5hdXRoLnYxLlJldm9rZVNlc3Npb25SZXNwb25zZSIAEmIKE1Jldm9rZU90aGVyU2Vzc2lvbnMSIy5hdXRoLnYxLlJldm9rZU90aGVyU2Vzc2lvbnNSZXF1ZXN0GiQuYXV0aC52MS5SZXZva2VPdGhlclNlc3Npb25zUmVzcG9uc2UiAEIbWhlkbnNhcmMvZ2VuL2F1dGgvdjE7YXV0aHYxYgZwcm90bzM");
 
 /**
  * @generated from message auth.v1.User
@@ -40,6 +40,16 @@ export type User = Message<"auth.v1.User"> & {
    * @generated from field: string updated_at = 5;
    */
   updatedAt: string;
+
+  /**
+   * @generated from field: bool email_verified = 6;
+   */
+  emailVerified: boolean;
+
+  /**
+   * @generated from field: bool mfa_enabled = 7;
+   */
+  mfaEnabled: boolean;
 };
 
 /**
@@ -49,6 +59,134 @@ export type User = Message<"auth.v1.User"> & {
 export const UserSchema: GenMessage<User> = /*@__PURE__*/
   messageDesc(file_auth_v1_auth, 0);
 
+/**
+ * @generated from message auth.v1.LoginProvider
+ */
+export type LoginProvider = Message<"auth.v1.LoginProvider"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string display_name = 2;
+   */
+  displayName: string;
+};
+
+/**
+ * Describes the message auth.v1.LoginProvider.
+ * Use `create(LoginProviderSchema)` to create a new message.
+ */
+export const LoginProviderSchema: GenMessage<LoginProvider> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 1);
+
+/**
+ * @generated from message auth.v1.Identity
+ */
+export type Identity = Message<"auth.v1.Identity"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string provider = 2;
+   */
+  provider: string;
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string created_at = 4;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message auth.v1.Identity.
+ * Use `create(IdentitySchema)` to create a new message.
+ */
+export const IdentitySchema: GenMessage<Identity> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 2);
+
+/**
+ * @generated from message auth.v1.MFAChallenge
+ */
+export type MFAChallenge = Message<"auth.v1.MFAChallenge"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * @generated from field: bool totp = 2;
+   */
+  totp: boolean;
+
+  /**
+   * @generated from field: bool webauthn = 3;
+   */
+  webauthn: boolean;
+};
+
+/**
+ * Describes the message auth.v1.MFAChallenge.
+ * Use `create(MFAChallengeSchema)` to create a new message.
+ */
+export const MFAChallengeSchema: GenMessage<MFAChallenge> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 3);
+
+/**
+ * @generated from message auth.v1.Session
+ */
+export type Session = Message<"auth.v1.Session"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_agent = 2;
+   */
+  userAgent: string;
+
+  /**
+   * @generated from field: string ip = 3;
+   */
+  ip: string;
+
+  /**
+   * @generated from field: bool current = 4;
+   */
+  current: boolean;
+
+  /**
+   * @generated from field: string created_at = 5;
+   */
+  createdAt: string;
+
+  /**
+   * @generated from field: string last_used_at = 6;
+   */
+  lastUsedAt: string;
+
+  /**
+   * @generated from field: string expires_at = 7;
+   */
+  expiresAt: string;
+};
+
+/**
+ * Describes the message auth.v1.Session.
+ * Use `create(SessionSchema)` to create a new message.
+ */
+export const SessionSchema: GenMessage<Session> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 4);
+
 /**
  * @generated from message auth.v1.GoogleLoginURLRequest
  */
@@ -60,7 +198,7 @@ export type GoogleLoginURLRequest = Message<"auth.v1.GoogleLoginURLRequest"> & {
  * Use `create(GoogleLoginURLRequestSchema)` to create a new message.
  */
 export const GoogleLoginURLRequestSchema: GenMessage<GoogleLoginURLRequest> = /*@__PURE__*/
-  messageDesc(file_auth_v1_auth, 1);
+  messageDesc(file_auth_v1_auth, 5);
 
 /**
  * @generated from message auth.v1.GoogleLoginURLResponse
@@ -77,7 +215,7 @@ export type GoogleLoginURLResponse = Message<"auth.v1.GoogleLoginURLResponse"> &
  * Use `create(GoogleLoginURLResponseSchema)` to create a new message.
  */
 export const GoogleLoginURLResponseSchema: GenMessage<GoogleLoginURLResponse> = /*@__PURE__*/
-  messageDesc(file_auth_v1_auth, 2);
+  messageDesc(file_auth_v1_auth, 6);
 
 /**
  * @generated from message auth.v1.WhoAmIRequest
@@ -90,7 +228,7 @@ export type WhoAmIRequest = Message<"auth.v1.WhoAmIRequest"> & {
  * Use `create(WhoAmIRequestSchema)` to create a new message.
  */
 export const WhoAmIRequestSchema: GenMessage<WhoAmIRequest> = /*@__PURE__*/
-  messageDesc(file_auth_v1_auth, 3);
+  messageDesc(file_auth_v1_auth, 7);
 
 /**
  * @generated from message auth.v1.WhoAmIResponse
@@ -107,27 +245,833 @@ export type WhoAmIResponse = Message<"auth.v1.WhoAmIResponse"> & {
  * Use `create(WhoAmIResponseSchema)` to create a new message.
  */
 export const WhoAmIResponseSchema: GenMessage<WhoAmIResponse> = /*@__PURE__*/
-  messageDesc(file_auth_v1_auth, 4);
+  messageDesc(file_auth_v1_auth, 8);
 
 /**
- * @generated from service auth.v1.AuthService
+ * @generated from message auth.v1.ListLoginProvidersRequest
  */
-export const AuthService: GenService<{
+export type ListLoginProvidersRequest = Message<"auth.v1.ListLoginProvidersRequest"> & {
+};
+
+/**
+ * Describes the message auth.v1.ListLoginProvidersRequest.
+ * Use `create(ListLoginProvidersRequestSchema)` to create a new message.
+ */
+export const ListLoginProvidersRequestSchema: GenMessage<ListLoginProvidersRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 9);
+
+/**
+ * @generated from message auth.v1.ListLoginProvidersResponse
+ */
+export type ListLoginProvidersResponse = Message<"auth.v1.ListLoginProvidersResponse"> & {
   /**
-   * @generated from rpc auth.v1.AuthService.GoogleLoginURL
+   * @generated from field: repeated auth.v1.LoginProvider providers = 1;
    */
-  googleLoginURL: {
-    methodKind: "unary";
-    input: typeof GoogleLoginURLRequestSchema;
-    output: typeof GoogleLoginURLResponseSchema;
-  },
+  providers: LoginProvider[];
+};
+
+/**
+ * Describes the message auth.v1.ListLoginProvidersResponse.
+ * Use `create(ListLoginProvidersResponseSchema)` to create a new message.
+ */
+export const ListLoginProvidersResponseSchema: GenMessage<ListLoginProvidersResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 10);
+
+/**
+ * @generated from message auth.v1.GetLoginURLRequest
+ */
+export type GetLoginURLRequest = Message<"auth.v1.GetLoginURLRequest"> & {
   /**
-   * @generated from rpc auth.v1.AuthService.WhoAmI
+   * @generated from field: string provider = 1;
    */
-  whoAmI: {
-    methodKind: "unary";
-    input: typeof WhoAmIRequestSchema;
-    output: typeof WhoAmIResponseSchema;
+  provider: string;
+};
+
+/**
+ * Describes the message auth.v1.GetLoginURLRequest.
+ * Use `create(GetLoginURLRequestSchema)` to create a new message.
+ */
+export const GetLoginURLRequestSchema: GenMessage<GetLoginURLRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 11);
+
+/**
+ * @generated from message auth.v1.GetLoginURLResponse
+ */
+export type GetLoginURLResponse = Message<"auth.v1.GetLoginURLResponse"> & {
+  /**
+   * @generated from field: string url = 1;
+   */
+  url: string;
+};
+
+/**
+ * Describes the message auth.v1.GetLoginURLResponse.
+ * Use `create(GetLoginURLResponseSchema)` to create a new message.
+ */
+export const GetLoginURLResponseSchema: GenMessage<GetLoginURLResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 12);
+
+/**
+ * @generated from message auth.v1.GetLinkURLRequest
+ */
+export type GetLinkURLRequest = Message<"auth.v1.GetLinkURLRequest"> & {
+  /**
+   * @generated from field: string provider = 1;
+   */
+  provider: string;
+};
+
+/**
+ * Describes the message auth.v1.GetLinkURLRequest.
+ * Use `create(GetLinkURLRequestSchema)` to create a new message.
+ */
+export const GetLinkURLRequestSchema: GenMessage<GetLinkURLRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 13);
+
+/**
+ * @generated from message auth.v1.GetLinkURLResponse
+ */
+export type GetLinkURLResponse = Message<"auth.v1.GetLinkURLResponse"> & {
+  /**
+   * @generated from field: string url = 1;
+   */
+  url: string;
+};
+
+/**
+ * Describes the message auth.v1.GetLinkURLResponse.
+ * Use `create(GetLinkURLResponseSchema)` to create a new message.
+ */
+export const GetLinkURLResponseSchema: GenMessage<GetLinkURLResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 14);
+
+/**
+ * @generated from message auth.v1.ListIdentitiesRequest
+ */
+export type ListIdentitiesRequest = Message<"auth.v1.ListIdentitiesRequest"> & {
+};
+
+/**
+ * Describes the message auth.v1.ListIdentitiesRequest.
+ * Use `create(ListIdentitiesRequestSchema)` to create a new message.
+ */
+export const ListIdentitiesRequestSchema: GenMessage<ListIdentitiesRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 15);
+
+/**
+ * @generated from message auth.v1.ListIdentitiesResponse
+ */
+export type ListIdentitiesResponse = Message<"auth.v1.ListIdentitiesResponse"> & {
+  /**
+   * @generated from field: repeated auth.v1.Identity identities = 1;
+   */
+  identities: Identity[];
+};
+
+/**
+ * Describes the message auth.v1.ListIdentitiesResponse.
+ * Use `create(ListIdentitiesResponseSchema)` to create a new message.
+ */
+export const ListIdentitiesResponseSchema: GenMessage<ListIdentitiesResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 16);
+
+/**
+ * @generated from message auth.v1.UnlinkIdentityRequest
+ */
+export type UnlinkIdentityRequest = Message<"auth.v1.UnlinkIdentityRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message auth.v1.UnlinkIdentityRequest.
+ * Use `create(UnlinkIdentityRequestSchema)` to create a new message.
+ */
+export const UnlinkIdentityRequestSchema: GenMessage<UnlinkIdentityRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 17);
+
+/**
+ * @generated from message auth.v1.UnlinkIdentityResponse
+ */
+export type UnlinkIdentityResponse = Message<"auth.v1.UnlinkIdentityResponse"> & {
+};
+
+/**
+ * Describes the message auth.v1.UnlinkIdentityResponse.
+ * Use `create(UnlinkIdentityResponseSchema)` to create a new message.
+ */
+export const UnlinkIdentityResponseSchema: GenMessage<UnlinkIdentityResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 18);
+
+/**
+ * @generated from message auth.v1.ExchangeAuthCodeRequest
+ */
+export type ExchangeAuthCodeRequest = Message<"auth.v1.ExchangeAuthCodeRequest"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message auth.v1.ExchangeAuthCodeRequest.
+ * Use `create(ExchangeAuthCodeRequestSchema)` to create a new message.
+ */
+export const ExchangeAuthCodeRequestSchema: GenMessage<ExchangeAuthCodeRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 19);
+
+/**
+ * @generated from message auth.v1.ExchangeAuthCodeResponse
+ */
+export type ExchangeAuthCodeResponse = Message<"auth.v1.ExchangeAuthCodeResponse"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * @generated from field: string refresh_token = 2;
+   */
+  refreshToken: string;
+
+  /**
+   * @generated from field: auth.v1.MFAChallenge mfa = 3;
+   */
+  mfa?: MFAChallenge;
+};
+
+/**
+ * Describes the message auth.v1.ExchangeAuthCodeResponse.
+ * Use `create(ExchangeAuthCodeResponseSchema)` to create a new message.
+ */
+export const ExchangeAuthCodeResponseSchema: GenMessage<ExchangeAuthCodeResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 20);
+
+/**
+ * @generated from message auth.v1.CompleteLinkRequest
+ */
+export type CompleteLinkRequest = Message<"auth.v1.CompleteLinkRequest"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message auth.v1.CompleteLinkRequest.
+ * Use `create(CompleteLinkRequestSchema)` to create a new message.
+ */
+export const CompleteLinkRequestSchema: GenMessage<CompleteLinkRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 21);
+
+/**
+ * @generated from message auth.v1.CompleteLinkResponse
+ */
+export type CompleteLinkResponse = Message<"auth.v1.CompleteLinkResponse"> & {
+  /**
+   * @generated from field: auth.v1.Identity identity = 1;
+   */
+  identity?: Identity;
+};
+
+/**
+ * Describes the message auth.v1.CompleteLinkResponse.
+ * Use `create(CompleteLinkResponseSchema)` to create a new message.
+ */
+export const CompleteLinkResponseSchema: GenMessage<CompleteLinkResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 22);
+
+/**
+ * @generated from message auth.v1.RegisterRequest
+ */
+export type RegisterRequest = Message<"auth.v1.RegisterRequest"> & {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string password = 2;
+   */
+  password: string;
+};
+
+/**
+ * Describes the message auth.v1.RegisterRequest.
+ * Use `create(RegisterRequestSchema)` to create a new message.
+ */
+export const RegisterRequestSchema: GenMessage<RegisterRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 23);
+
+/**
+ * @generated from message auth.v1.RegisterResponse
+ */
+export type RegisterResponse = Message<"auth.v1.RegisterResponse"> & {
+};
+
+/**
+ * Describes the message auth.v1.RegisterResponse.
+ * Use `create(RegisterResponseSchema)` to create a new message.
+ */
+export const RegisterResponseSchema: GenMessage<RegisterResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 24);
+
+/**
+ * @generated from message auth.v1.LoginRequest
+ */
+export type LoginRequest = Message<"auth.v1.LoginRequest"> & {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string password = 2;
+   */
+  password: string;
+};
+
+/**
+ * Describes the message auth.v1.LoginRequest.
+ * Use `create(LoginRequestSchema)` to create a new message.
+ */
+export const LoginRequestSchema: GenMessage<LoginRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 25);
+
+/**
+ * @generated from message auth.v1.LoginResponse
+ */
+export type LoginResponse = Message<"auth.v1.LoginResponse"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * @generated from field: string refresh_token = 2;
+   */
+  refreshToken: string;
+
+  /**
+   * @generated from field: auth.v1.MFAChallenge mfa = 3;
+   */
+  mfa?: MFAChallenge;
+};
+
+/**
+ * Describes the message auth.v1.LoginResponse.
+ * Use `create(LoginResponseSchema)` to create a new message.
+ */
+export const LoginResponseSchema: GenMessage<LoginResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 26);
+
+/**
+ * @generated from message auth.v1.VerifyEmailRequest
+ */
+export type VerifyEmailRequest = Message<"auth.v1.VerifyEmailRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message auth.v1.VerifyEmailRequest.
+ * Use `create(VerifyEmailRequestSchema)` to create a new message.
+ */
+export const VerifyEmailRequestSchema: GenMessage<VerifyEmailRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 27);
+
+/**
+ * @generated from message auth.v1.VerifyEmailResponse
+ */
+export type VerifyEmailResponse = Message<"auth.v1.VerifyEmailResponse"> & {
+};
+
+/**
+ * Describes the message auth.v1.VerifyEmailResponse.
+ * Use `create(VerifyEmailResponseSchema)` to create a new message.
+ */
+export const VerifyEmailResponseSchema: GenMessage<VerifyEmailResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 28);
+
+/**
+ * @generated from message auth.v1.ForgotPasswordRequest
+ */
+export type ForgotPasswordRequest = Message<"auth.v1.ForgotPasswordRequest"> & {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email: string;
+};
+
+/**
+ * Describes the message auth.v1.ForgotPasswordRequest.
+ * Use `create(ForgotPasswordRequestSchema)` to create a new message.
+ */
+export const ForgotPasswordRequestSchema: GenMessage<ForgotPasswordRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 29);
+
+/**
+ * @generated from message auth.v1.ForgotPasswordResponse
+ */
+export type ForgotPasswordResponse = Message<"auth.v1.ForgotPasswordResponse"> & {
+};
+
+/**
+ * Describes the message auth.v1.ForgotPasswordResponse.
+ * Use `create(ForgotPasswordResponseSchema)` to create a new message.
+ */
+export const ForgotPasswordResponseSchema: GenMessage<ForgotPasswordResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 30);
+
+/**
+ * @generated from message auth.v1.ResetPasswordRequest
+ */
+export type ResetPasswordRequest = Message<"auth.v1.ResetPasswordRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * @generated from field: string password = 2;
+   */
+  password: string;
+};
+
+/**
+ * Describes the message auth.v1.ResetPasswordRequest.
+ * Use `create(ResetPasswordRequestSchema)` to create a new message.
+ */
+export const ResetPasswordRequestSchema: GenMessage<ResetPasswordRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 31);
+
+/**
+ * @generated from message auth.v1.ResetPasswordResponse
+ */
+export type ResetPasswordResponse = Message<"auth.v1.ResetPasswordResponse"> & {
+};
+
+/**
+ * Describes the message auth.v1.ResetPasswordResponse.
+ * Use `create(ResetPasswordResponseSchema)` to create a new message.
+ */
+export const ResetPasswordResponseSchema: GenMessage<ResetPasswordResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 32);
+
+/**
+ * @generated from message auth.v1.RefreshTokenRequest
+ */
+export type RefreshTokenRequest = Message<"auth.v1.RefreshTokenRequest"> & {
+  /**
+   * @generated from field: string refresh_token = 1;
+   */
+  refreshToken: string;
+};
+
+/**
+ * Describes the message auth.v1.RefreshTokenRequest.
+ * Use `create(RefreshTokenRequestSchema)` to create a new message.
+ */
+export const RefreshTokenRequestSchema: GenMessage<RefreshTokenRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 33);
+
+/**
+ * @generated from message auth.v1.RefreshTokenResponse
+ */
+export type RefreshTokenResponse = Message<"auth.v1.RefreshTokenResponse"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * @generated from field: string refresh_token = 2;
+   */
+  refreshToken: string;
+};
+
+/**
+ * Describes the message auth.v1.RefreshTokenResponse.
+ * Use `create(RefreshTokenResponseSchema)` to create a new message.
+ */
+export const RefreshTokenResponseSchema: GenMessage<RefreshTokenResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 34);
+
+/**
+ * @generated from message auth.v1.BeginMFAWebAuthnRequest
+ */
+export type BeginMFAWebAuthnRequest = Message<"auth.v1.BeginMFAWebAuthnRequest"> & {
+  /**
+   * @generated from field: string mfa_token = 1;
+   */
+  mfaToken: string;
+};
+
+/**
+ * Describes the message auth.v1.BeginMFAWebAuthnRequest.
+ * Use `create(BeginMFAWebAuthnRequestSchema)` to create a new message.
+ */
+export const BeginMFAWebAuthnRequestSchema: GenMessage<BeginMFAWebAuthnRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 35);
+
+/**
+ * @generated from message auth.v1.BeginMFAWebAuthnResponse
+ */
+export type BeginMFAWebAuthnResponse = Message<"auth.v1.BeginMFAWebAuthnResponse"> & {
+  /**
+   * @generated from field: string options_json = 1;
+   */
+  optionsJson: string;
+};
+
+/**
+ * Describes the message auth.v1.BeginMFAWebAuthnResponse.
+ * Use `create(BeginMFAWebAuthnResponseSchema)` to create a new message.
+ */
+export const BeginMFAWebAuthnResponseSchema: GenMessage<BeginMFAWebAuthnResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 36);
+
+/**
+ * @generated from message auth.v1.VerifyMFARequest
+ */
+export type VerifyMFARequest = Message<"auth.v1.VerifyMFARequest"> & {
+  /**
+   * @generated from field: string mfa_token = 1;
+   */
+  mfaToken: string;
+
+  /**
+   * @generated from field: string totp_code = 2;
+   */
+  totpCode: string;
+
+  /**
+   * @generated from field: string recovery_code = 3;
+   */
+  recoveryCode: string;
+
+  /**
+   * @generated from field: string webauthn_response_json = 4;
+   */
+  webauthnResponseJson: string;
+};
+
+/**
+ * Describes the message auth.v1.VerifyMFARequest.
+ * Use `create(VerifyMFARequestSchema)` to create a new message.
+ */
+export const VerifyMFARequestSchema: GenMessage<VerifyMFARequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 37);
+
+/**
+ * @generated from message auth.v1.VerifyMFAResponse
+ */
+export type VerifyMFAResponse = Message<"auth.v1.VerifyMFAResponse"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * @generated from field: string refresh_token = 2;
+   */
+  refreshToken: string;
+};
+
+/**
+ * Describes the message auth.v1.VerifyMFAResponse.
+ * Use `create(VerifyMFAResponseSchema)` to create a new message.
+ */
+export const VerifyMFAResponseSchema: GenMessage<VerifyMFAResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 38);
+
+/**
+ * @generated from message auth.v1.LogoutRequest
+ */
+export type LogoutRequest = Message<"auth.v1.LogoutRequest"> & {
+};
+
+/**
+ * Describes the message auth.v1.LogoutRequest.
+ * Use `create(LogoutRequestSchema)` to create a new message.
+ */
+export const LogoutRequestSchema: GenMessage<LogoutRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 39);
+
+/**
+ * @generated from message auth.v1.LogoutResponse
+ */
+export type LogoutResponse = Message<"auth.v1.LogoutResponse"> & {
+};
+
+/**
+ * Describes the message auth.v1.LogoutResponse.
+ * Use `create(LogoutResponseSchema)` to create a new message.
+ */
+export const LogoutResponseSchema: GenMessage<LogoutResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 40);
+
+/**
+ * @generated from message auth.v1.ListSessionsRequest
+ */
+export type ListSessionsRequest = Message<"auth.v1.ListSessionsRequest"> & {
+};
+
+/**
+ * Describes the message auth.v1.ListSessionsRequest.
+ * Use `create(ListSessionsRequestSchema)` to create a new message.
+ */
+export const ListSessionsRequestSchema: GenMessage<ListSessionsRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 41);
+
+/**
+ * @generated from message auth.v1.ListSessionsResponse
+ */
+export type ListSessionsResponse = Message<"auth.v1.ListSessionsResponse"> & {
+  /**
+   * @generated from field: repeated auth.v1.Session sessions = 1;
+   */
+  sessions: Session[];
+};
+
+/**
+ * Describes the message auth.v1.ListSessionsResponse.
+ * Use `create(ListSessionsResponseSchema)` to create a new message.
+ */
+export const ListSessionsResponseSchema: GenMessage<ListSessionsResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 42);
+
+/**
+ * @generated from message auth.v1.RevokeSessionRequest
+ */
+export type RevokeSessionRequest = Message<"auth.v1.RevokeSessionRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message auth.v1.RevokeSessionRequest.
+ * Use `create(RevokeSessionRequestSchema)` to create a new message.
+ */
+export const RevokeSessionRequestSchema: GenMessage<RevokeSessionRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 43);
+
+/**
+ * @generated from message auth.v1.RevokeSessionResponse
+ */
+export type RevokeSessionResponse = Message<"auth.v1.RevokeSessionResponse"> & {
+};
+
+/**
+ * Describes the message auth.v1.RevokeSessionResponse.
+ * Use `create(RevokeSessionResponseSchema)` to create a new message.
+ */
+export const RevokeSessionResponseSchema: GenMessage<RevokeSessionResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 44);
+
+/**
+ * @generated from message auth.v1.RevokeOtherSessionsRequest
+ */
+export type RevokeOtherSessionsRequest = Message<"auth.v1.RevokeOtherSessionsRequest"> & {
+};
+
+/**
+ * Describes the message auth.v1.RevokeOtherSessionsRequest.
+ * Use `create(RevokeOtherSessionsRequestSchema)` to create a new message.
+ */
+export const RevokeOtherSessionsRequestSchema: GenMessage<RevokeOtherSessionsRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 45);
+
+/**
+ * @generated from message auth.v1.RevokeOtherSessionsResponse
+ */
+export type RevokeOtherSessionsResponse = Message<"auth.v1.RevokeOtherSessionsResponse"> & {
+};
+
+/**
+ * Describes the message auth.v1.RevokeOtherSessionsResponse.
+ * Use `create(RevokeOtherSessionsResponseSchema)` to create a new message.
+ */
+export const RevokeOtherSessionsResponseSchema: GenMessage<RevokeOtherSessionsResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 46);
+
+/**
+ * @generated from service auth.v1.AuthService
+ */
+export const AuthService: GenService<{
+  /**
+   * @generated from rpc auth.v1.AuthService.GoogleLoginURL
+   */
+  googleLoginURL: {
+    methodKind: "unary";
+    input: typeof GoogleLoginURLRequestSchema;
+    output: typeof GoogleLoginURLResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.ListLoginProviders
+   */
+  listLoginProviders: {
+    methodKind: "unary";
+    input: typeof ListLoginProvidersRequestSchema;
+    output: typeof ListLoginProvidersResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.GetLoginURL
+   */
+  getLoginURL: {
+    methodKind: "unary";
+    input: typeof GetLoginURLRequestSchema;
+    output: typeof GetLoginURLResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.GetLinkURL
+   */
+  getLinkURL: {
+    methodKind: "unary";
+    input: typeof GetLinkURLRequestSchema;
+    output: typeof GetLinkURLResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.ListIdentities
+   */
+  listIdentities: {
+    methodKind: "unary";
+    input: typeof ListIdentitiesRequestSchema;
+    output: typeof ListIdentitiesResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.UnlinkIdentity
+   */
+  unlinkIdentity: {
+    methodKind: "unary";
+    input: typeof UnlinkIdentityRequestSchema;
+    output: typeof UnlinkIdentityResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.ExchangeAuthCode
+   */
+  exchangeAuthCode: {
+    methodKind: "unary";
+    input: typeof ExchangeAuthCodeRequestSchema;
+    output: typeof ExchangeAuthCodeResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.CompleteLink
+   */
+  completeLink: {
+    methodKind: "unary";
+    input: typeof CompleteLinkRequestSchema;
+    output: typeof CompleteLinkResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.WhoAmI
+   */
+  whoAmI: {
+    methodKind: "unary";
+    input: typeof WhoAmIRequestSchema;
+    output: typeof WhoAmIResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.Register
+   */
+  register: {
+    methodKind: "unary";
+    input: typeof RegisterRequestSchema;
+    output: typeof RegisterResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.Login
+   */
+  login: {
+    methodKind: "unary";
+    input: typeof LoginRequestSchema;
+    output: typeof LoginResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.VerifyEmail
+   */
+  verifyEmail: {
+    methodKind: "unary";
+    input: typeof VerifyEmailRequestSchema;
+    output: typeof VerifyEmailResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.ForgotPassword
+   */
+  forgotPassword: {
+    methodKind: "unary";
+    input: typeof ForgotPasswordRequestSchema;
+    output: typeof ForgotPasswordResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.ResetPassword
+   */
+  resetPassword: {
+    methodKind: "unary";
+    input: typeof ResetPasswordRequestSchema;
+    output: typeof ResetPasswordResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.BeginMFAWebAuthn
+   */
+  beginMFAWebAuthn: {
+    methodKind: "unary";
+    input: typeof BeginMFAWebAuthnRequestSchema;
+    output: typeof BeginMFAWebAuthnResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.VerifyMFA
+   */
+  verifyMFA: {
+    methodKind: "unary";
+    input: typeof VerifyMFARequestSchema;
+    output: typeof VerifyMFAResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.RefreshToken
+   */
+  refreshToken: {
+    methodKind: "unary";
+    input: typeof RefreshTokenRequestSchema;
+    output: typeof RefreshTokenResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.Logout
+   */
+  logout: {
+    methodKind: "unary";
+    input: typeof LogoutRequestSchema;
+    output: typeof LogoutResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.ListSessions
+   */
+  listSessions: {
+    methodKind: "unary";
+    input: typeof ListSessionsRequestSchema;
+    output: typeof ListSessionsResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.RevokeSession
+   */
+  revokeSession: {
+    methodKind: "unary";
+    input: typeof RevokeSessionRequestSchema;
+    output: typeof RevokeSessionResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.RevokeOtherSessions
+   */
+  revokeOtherSessions: {
+    methodKind: "unary";
+    input: typeof RevokeOtherSessionsRequestSchema;
+    output: typeof RevokeOtherSessionsResponseSchema;
   },
 }> = /*@__PURE__*/
   serviceDesc(file_auth_v1_auth, 0);
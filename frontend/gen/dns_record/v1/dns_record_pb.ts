@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file dns_record/v1/dns_record.proto.
  */
 export const file_dns_record_v1_dns_record: GenFile = /*@__PURE__*/
-  fileDesc("Ch5kbnNfcmVjb3JkL3YxL2Ruc19yZWNvcmQucHJvdG8SDWRuc19yZWNvcmQudjEidQoWQ3JlYXRlRE5TUmVjb3JkUmVxdWVzdBIRCgl6b25lX25hbWUYASABKAkSDAoEbmFtZRgCIAEoCRIMCgR0eXBlGAMgASgJEg8KB2NvbnRlbnQYBCABKAkSCwoDdHRsGAUgASgFEg4KBndlaWdodBgGIAEoBSKtAQoJRE5TUmVjb3JkEgoKAmlkGAEgASgJEg8KB3pvbmVfaWQYAiABKAkSEQoJem9uZV9uYW1lGAMgASgJEgwKBG5hbWUYBCABKAkSDAoEdHlwZRgFIAEoCRIPCgdjb250ZW50GAYgASgJEg4KBndlaWdodBgHIAEoBRILCgN0dGwYCCABKAUSEgoKY3JlYXRlZF9hdBgJIAEoCRISCgp1cGRhdGVkX2F0GAogASgJIkMKF0NyZWF0ZUROU1JlY29yZFJlc3BvbnNlEigKBnJlY29yZBgBIAEoCzIYLmRuc19yZWNvcmQudjEuRE5TUmVjb3JkIigKFUxpc3RETlNSZWNvcmRzUmVxdWVzdBIPCgd6b25lX2lkGAEgASgJIkMKFkxpc3RETlNSZWNvcmRzUmVzcG9uc2USKQoHcmVjb3JkcxgBIAMoCzIYLmRuc19yZWNvcmQudjEuRE5TUmVjb3JkIjQKH0xpc3RETlNSZWNvcmRzQnlab25lTmFtZVJlcXVlc3QSEQoJem9uZV9uYW1lGAEgASgJIk0KIExpc3RETlNSZWNvcmRzQnlab25lTmFtZVJlc3BvbnNlEikKB3JlY29yZHMYASADKAsyGC5kbnNfcmVjb3JkLnYxLkROU1JlY29yZCIhChNHZXRETlNSZWNvcmRSZXF1ZXN0EgoKAmlkGAEgASgJIkAKFEdldEROU1JlY29yZFJlc3BvbnNlEigKBnJlY29yZBgBIAEoCzIYLmRuc19yZWNvcmQudjEuRE5TUmVjb3JkIm4KFlVwZGF0ZUROU1JlY29yZFJlcXVlc3QSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIMCgR0eXBlGAMgASgJEg8KB2NvbnRlbnQYBCABKAkSCwoDdHRsGAUgASgFEg4KBndlaWdodBgGIAEoBSJDChdVcGRhdGVETlNSZWNvcmRSZXNwb25zZRIoCgZyZWNvcmQYASABKAsyGC5kbnNfcmVjb3JkLnYxLkROU1JlY29yZCIkChZEZWxldGVETlNSZWNvcmRSZXF1ZXN0EgoKAmlkGAEgASgJIhkKF0RlbGV0ZUROU1JlY29yZFJlc3BvbnNlMvkEChBETlNSZWNvcmRTZXJ2aWNlEmIKD0NyZWF0ZUROU1JlY29yZBIlLmRuc19yZWNvcmQudjEuQ3JlYXRlRE5TUmVjb3JkUmVxdWVzdBomLmRuc19yZWNvcmQudjEuQ3JlYXRlRE5TUmVjb3JkUmVzcG9uc2UiABJfCg5MaXN0RE5TUmVjb3JkcxIkLmRuc19yZWNvcmQudjEuTGlzdEROU1JlY29yZHNSZXF1ZXN0GiUuZG5zX3JlY29yZC52MS5MaXN0RE5TUmVjb3Jkc1Jlc3BvbnNlIgASfQoYTGlzdEROU1JlY29yZHNCeVpvbmVOYW1lEi4uZG5zX3JlY29yZC52MS5MaXN0RE5TUmVjb3Jkc0J5Wm9uZU5hbWVSZXF1ZXN0Gi8uZG5zX3JlY29yZC52MS5MaXN0RE5TUmVjb3Jkc0J5Wm9uZU5hbWVSZXNwb25zZSIAElkKDEdldEROU1JlY29yZBIiLmRuc19yZWNvcmQudjEuR2V0RE5TUmVjb3JkUmVxdWVzdBojLmRuc19yZWNvcmQudjEuR2V0RE5TUmVjb3JkUmVzcG9uc2UiABJiCg9VcGRhdGVETlNSZWNvcmQSJS5kbnNfcmVjb3JkLnYxLlVwZGF0ZUROU1JlY29yZFJlcXVlc3QaJi5kbnNfcmVjb3JkLnYxLlVwZGF0ZUROU1JlY29yZFJlc3BvbnNlIgASYgoPRGVsZXRlRE5TUmVjb3JkEiUuZG5zX3JlY29yZC52MS5EZWxldGVETlNSZWNvcmRSZXF1ZXN0GiYuZG5zX3JlY29yZC52MS5EZWxldGVETlNSZWNvcmRSZXNwb25zZSIAQidaJWRuc2FyYy9nZW4vZG5zX3JlY29yZC92MTtkbnNfcmVjb3JkdjFiBnByb3RvMw");
+  fileDesc("Ch5kbnNfcmVjb3JkL3YxL2Ruc19yZWNvcmQucHJvdG8SDWRuc19yZWNvcmQudjEitQEKFkNyZWF0ZUROU1JlY29yZFJlcXVlc3QSEQoJem9uZV9uYW1lGAEgASgJEgwKBG5hbWUYAiABKAkSDAoEdHlwZRgDIAEoCRIPCgdjb250ZW50GAQgASgJEgsKA3R0bBgFIAEoBRIOCgZ3ZWlnaHQYBiABKAUSJQoEc3ZjYhgHIAEoCzIXLmRuc19yZWNvcmQudjEuU1ZDQkRhdGESFwoPb3JnYW5pemF0aW9uX2lkGAggASgJInEKCVN2Y1BhcmFtcxIMCgRhbHBuGAEgAygJEhcKD25vX2RlZmF1bHRfYWxwbhgCIAEoCBIMCgRwb3J0GAMgASgNEhAKCGlwdjRoaW50GAQgAygJEhAKCGlwdjZoaW50GAUgAygJEgsKA2VjaBgGIAEoCSJWCghTVkNCRGF0YRIQCghwcmlvcml0eRgBIAEoDRIOCgZ0YXJnZXQYAiABKAkSKAoGcGFyYW1zGAMgASgLMhguZG5zX3JlY29yZC52MS5TdmNQYXJhbXMi1AEKCUROU1JlY29yZBIKCgJpZBgBIAEoCRIPCgd6b25lX2lkGAIgASgJEhEKCXpvbmVfbmFtZRgDIAEoCRIMCgRuYW1lGAQgASgJEgwKBHR5cGUYBSABKAkSDwoHY29udGVudBgGIAEoCRIOCgZ3ZWlnaHQYByABKAUSCwoDdHRsGAggASgFEhIKCmNyZWF0ZWRfYXQYCSABKAkSEgoKdXBkYXRlZF9hdBgKIAEoCRIlCgRzdmNiGAsgASgLMhcuZG5zX3JlY29yZC52MS5TVkNCRGF0YSJDChdDcmVhdGVETlNSZWNvcmRSZXNwb25zZRIoCgZyZWNvcmQYASABKAsyGC5kbnNfcmVjb3JkLnYxLkROU1JlY29yZCIoChVMaXN0RE5TUmVjb3Jkc1JlcXVlc3QSDwoHem9uZV9pZBgBIAEoCSJDChZMaXN0RE5TUmVjb3Jkc1Jlc3BvbnNlEikKB3JlY29yZHMYASADKAsyGC5kbnNfcmVjb3JkLnYxLkROU1JlY29yZCJNCh9MaXN0RE5TUmVjb3Jkc0J5Wm9uZU5hbWVSZXF1ZXN0EhEKCXpvbmVfbmFtZRgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkiTQogTGlzdEROU1JlY29yZHNCeVpvbmVOYW1lUmVzcG9uc2USKQoHcmVjb3JkcxgBIAMoCzIYLmRuc19yZWNvcmQudjEuRE5TUmVjb3JkIiEKE0dldEROU1JlY29yZFJlcXVlc3QSCgoCaWQYASABKAkiQAoUR2V0RE5TUmVjb3JkUmVzcG9uc2USKAoGcmVjb3JkGAEgASgLMhguZG5zX3JlY29yZC52MS5ETlNSZWNvcmQilQEKFlVwZGF0ZUROU1JlY29yZFJlcXVlc3QSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIMCgR0eXBlGAMgASgJEg8KB2NvbnRlbnQYBCABKAkSCwoDdHRsGAUgASgFEg4KBndlaWdodBgGIAEoBRIlCgRzdmNiGAcgASgLMhcuZG5zX3JlY29yZC52MS5TVkNCRGF0YSJDChdVcGRhdGVETlNSZWNvcmRSZXNwb25zZRIoCgZyZWNvcmQYASABKAsyGC5kbnNfcmVjb3JkLnYxLkROU1JlY29yZCIkChZEZWxldGVETlNSZWNvcmRSZXF1ZXN0EgoKAmlkGAEgASgJIhkKF0RlbGV0ZUROU1JlY29yZFJlc3BvbnNlMvkEChBETlNSZWNvcmRTZXJ2aWNlEmIKD0NyZWF0ZUROU1JlY29yZBIlLmRuc19yZWNvcmQudjEuQ3JlYXRlRE5TUmVjb3JkUmVxdWVzdBomLmRuc19yZWNvcmQudjEuQ3JlYXRlRE5TUmVjb3JkUmVzcG9uc2UiABJfCg5MaXN0RE5TUmVjb3JkcxIkLmRuc19yZWNvcmQudjEuTGlzdEROU1JlY29yZHNSZXF1ZXN0GiUuZG5zX3JlY29yZC52MS5MaXN0RE5TUmVjb3Jkc1Jlc3BvbnNlIgASfQoYTGlzdEROU1JlY29yZHNCeVpvbmVOYW1lEi4uZG5zX3JlY29yZC52MS5MaXN0RE5TUmVjb3Jkc0J5Wm9uZU5hbWVSZXF1ZXN0Gi8uZG5zX3JlY29yZC52MS5MaXN0RE5TUmVjb3Jkc0J5Wm9uZU5hbWVSZXNwb25zZSIAElkKDEdldEROU1JlY29yZBIiLmRuc19yZWNvcmQudjEuR2V0RE5TUmVjb3JkUmVxdWVzdBojLmRuc19yZWNvcmQudjEuR2V0RE5TUmVjb3JkUmVzcG9uc2UiABJiCg9VcGRhdGVETlNSZWNvcmQSJS5kbnNfcmVjb3JkLnYxLlVwZGF0ZUROU1JlY29yZFJlcXVlc3QaJi5kbnNfcmVjb3JkLnYxLlVwZGF0ZUROU1JlY29yZFJlc3BvbnNlIgASYgoPRGVsZXRlRE5TUmVjb3JkEiUuZG5zX3JlY29yZC52MS5EZWxldGVETlNSZWNvcmRSZXF1ZXN0GiYuZG5zX3JlY29yZC52MS5EZWxldGVETlNSZWNvcmRSZXNwb25zZSIAQidaJWRuc2FyYy9nZW4vZG5zX3JlY29yZC92MTtkbnNfcmVjb3JkdjFiBnByb3RvMw");
 
 /**
  * @generated from message dns_record.v1.CreateDNSRecordRequest
@@ -45,6 +45,16 @@ export type CreateDNSRecordRequest = Message<"dns_record.v1.CreateDNSRecordReque
    * @generated from field: int32 weight = 6;
    */
   weight: number;
+
+  /**
+   * @generated from field: dns_record.v1.SVCBData svcb = 7;
+   */
+  svcb?: SVCBData;
+
+  /**
+   * @generated from field: string organization_id = 8;
+   */
+  organizationId: string;
 };
 
 /**
@@ -54,6 +64,75 @@ export type CreateDNSRecordRequest = Message<"dns_record.v1.CreateDNSRecordReque
 export const CreateDNSRecordRequestSchema: GenMessage<CreateDNSRecordRequest> = /*@__PURE__*/
   messageDesc(file_dns_record_v1_dns_record, 0);
 
+/**
+ * @generated from message dns_record.v1.SvcParams
+ */
+export type SvcParams = Message<"dns_record.v1.SvcParams"> & {
+  /**
+   * @generated from field: repeated string alpn = 1;
+   */
+  alpn: string[];
+
+  /**
+   * @generated from field: bool no_default_alpn = 2;
+   */
+  noDefaultAlpn: boolean;
+
+  /**
+   * @generated from field: uint32 port = 3;
+   */
+  port: number;
+
+  /**
+   * @generated from field: repeated string ipv4hint = 4;
+   */
+  ipv4hint: string[];
+
+  /**
+   * @generated from field: repeated string ipv6hint = 5;
+   */
+  ipv6hint: string[];
+
+  /**
+   * @generated from field: string ech = 6;
+   */
+  ech: string;
+};
+
+/**
+ * Describes the message dns_record.v1.SvcParams.
+ * Use `create(SvcParamsSchema)` to create a new message.
+ */
+export const SvcParamsSchema: GenMessage<SvcParams> = /*@__PURE__*/
+  messageDesc(file_dns_record_v1_dns_record, 1);
+
+/**
+ * @generated from message dns_record.v1.SVCBData
+ */
+export type SVCBData = Message<"dns_record.v1.SVCBData"> & {
+  /**
+   * @generated from field: uint32 priority = 1;
+   */
+  priority: number;
+
+  /**
+   * @generated from field: string target = 2;
+   */
+  target: string;
+
+  /**
+   * @generated from field: dns_record.v1.SvcParams params = 3;
+   */
+  params?: SvcParams;
+};
+
+/**
+ * Describes the message dns_record.v1.SVCBData.
+ * Use `create(SVCBDataSchema)` to create a new message.
+ */
+export const SVCBDataSchema: GenMessage<SVCBData> = /*@__PURE__*/
+  messageDesc(file_dns_record_v1_dns_record, 2);
+
 /**
  * @generated from message dns_record.v1.DNSRecord
  */
@@ -107,6 +186,11 @@ export type DNSRecord = Message<"dns_record.v1.DNSRecord"> & {
    * @generated from field: string updated_at = 10;
    */
   updatedAt: string;
+
+  /**
+   * @generated from field: dns_record.v1.SVCBData svcb = 11;
+   */
+  svcb?: SVCBData;
 };
 
 /**
@@ -114,7 +198,7 @@ export type DNSRecord = Message<"dns_record.v1.DNSRecord"> & {
  * Use `create(DNSRecordSchema)` to create a new message.
  */
 export const DNSRecordSchema: GenMessage<DNSRecord> = /*@__PURE__*/
-  messageDesc(file_dns_record_v1_dns_record, 1);
+  messageDesc(file_dns_record_v1_dns_record, 3);
 
 /**
  * @generated from message dns_record.v1.CreateDNSRecordResponse
@@ -131,7 +215,7 @@ export type CreateDNSRecordResponse = Message<"dns_record.v1.CreateDNSRecordResp
  * Use `create(CreateDNSRecordResponseSchema)` to create a new message.
  */
 export const CreateDNSRecordResponseSchema: GenMessage<CreateDNSRecordResponse> = /*@__PURE__*/
-  messageDesc(file_dns_record_v1_dns_record, 2);
+  messageDesc(file_dns_record_v1_dns_record, 4);
 
 /**
  * @generated from message dns_record.v1.ListDNSRecordsRequest
@@ -148,7 +232,7 @@ export type ListDNSRecordsRequest = Message<"dns_record.v1.ListDNSRecordsRequest
  * Use `create(ListDNSRecordsRequestSchema)` to create a new message.
  */
 export const ListDNSRecordsRequestSchema: GenMessage<ListDNSRecordsRequest> = /*@__PURE__*/
-  messageDesc(file_dns_record_v1_dns_record, 3);
+  messageDesc(file_dns_record_v1_dns_record, 5);
 
 /**
  * @generated from message dns_record.v1.ListDNSRecordsResponse
@@ -165,7 +249,7 @@ export type ListDNSRecordsResponse = Message<"dns_record.v1.ListDNSRecordsRespon
  * Use `create(ListDNSRecordsResponseSchema)` to create a new message.
  */
 export const ListDNSRecordsResponseSchema: GenMessage<ListDNSRecordsResponse> = /*@__PURE__*/
-  messageDesc(file_dns_record_v1_dns_record, 4);
+  messageDesc(file_dns_record_v1_dns_record, 6);
 
 /**
  * @generated from message dns_record.v1.ListDNSRecordsByZoneNameRequest
@@ -175,6 +259,11 @@ export type ListDNSRecordsByZoneNameRequest = Message<"dns_record.v1.ListDNSReco
    * @generated from field: string zone_name = 1;
    */
   zoneName: string;
+
+  /**
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId: string;
 };
 
 /**
@@ -182,7 +271,7 @@ export type ListDNSRecordsByZoneNameRequest = Message<"dns_record.v1.ListDNSReco
  * Use `create(ListDNSRecordsByZoneNameRequestSchema)` to create a new message.
  */
 export const ListDNSRecordsByZoneNameRequestSchema: GenMessage<ListDNSRecordsByZoneNameRequest> = /*@__PURE__*/
-  messageDesc(file_dns_record_v1_dns_record, 5);
+  messageDesc(file_dns_record_v1_dns_record, 7);
 
 /**
  * @generated from message dns_record.v1.ListDNSRecordsByZoneNameResponse
@@ -199,7 +288,7 @@ export type ListDNSRecordsByZoneNameResponse = Message<"dns_record.v1.ListDNSRec
  * Use `create(ListDNSRecordsByZoneNameResponseSchema)` to create a new message.
  */
 export const ListDNSRecordsByZoneNameResponseSchema: GenMessage<ListDNSRecordsByZoneNameResponse> = /*@__PURE__*/
-  messageDesc(file_dns_record_v1_dns_record, 6);
+  messageDesc(file_dns_record_v1_dns_record, 8);
 
 /**
  * @generated from message dns_record.v1.GetDNSRecordRequest
@@ -216,7 +305,7 @@ export type GetDNSRecordRequest = Message<"dns_record.v1.GetDNSRecordRequest"> &
  * Use `create(GetDNSRecordRequestSchema)` to create a new message.
  */
 export const GetDNSRecordRequestSchema: GenMessage<GetDNSRecordRequest> = /*@__PURE__*/
-  messageDesc(file_dns_record_v1_dns_record, 7);
+  messageDesc(file_dns_record_v1_dns_record, 9);
 
 /**
  * @generated from message dns_record.v1.GetDNSRecordResponse
@@ -233,7 +322,7 @@ export type GetDNSRecordResponse = Message<"dns_record.v1.GetDNSRecordResponse">
  * Use `create(GetDNSRecordResponseSchema)` to create a new message.
  */
 export const GetDNSRecordResponseSchema: GenMessage<GetDNSRecordResponse> = /*@__PURE__*/
-  messageDesc(file_dns_record_v1_dns_record, 8);
+  messageDesc(file_dns_record_v1_dns_record, 10);
 
 /**
  * @generated from message dns_record.v1.UpdateDNSRecordRequest
@@ -268,6 +357,11 @@ export type UpdateDNSRecordRequest = Message<"dns_record.v1.UpdateDNSRecordReque
    * @generated from field: int32 weight = 6;
    */
   weight: number;
+
+  /**
+   * @generated from field: dns_record.v1.SVCBData svcb = 7;
+   */
+  svcb?: SVCBData;
 };
 
 /**
@@ -275,7 +369,7 @@ export type UpdateDNSRecordRequest = Message<"dns_record.v1.UpdateDNSRecordReque
  * Use `create(UpdateDNSRecordRequestSchema)` to create a new message.
  */
 export const UpdateDNSRecordRequestSchema: GenMessage<UpdateDNSRecordRequest> = /*@__PURE__*/
-  messageDesc(file_dns_record_v1_dns_record, 9);
+  messageDesc(file_dns_record_v1_dns_record, 11);
 
 /**
  * @generated from message dns_record.v1.UpdateDNSRecordResponse
@@ -292,7 +386,7 @@ export type UpdateDNSRecordResponse = Message<"dns_record.v1.UpdateDNSRecordResp
  * Use `create(UpdateDNSRecordResponseSchema)` to create a new message.
  */
 export const UpdateDNSRecordResponseSchema: GenMessage<UpdateDNSRecordResponse> = /*@__PURE__*/
-  messageDesc(file_dns_record_v1_dns_record, 10);
+  messageDesc(file_dns_record_v1_dns_record, 12);
 
 /**
  * @generated from message dns_record.v1.DeleteDNSRecordRequest
@@ -309,7 +403,7 @@ export type DeleteDNSRecordRequest = Message<"dns_record.v1.DeleteDNSRecordReque
  * Use `create(DeleteDNSRecordRequestSchema)` to create a new message.
  */
 export const DeleteDNSRecordRequestSchema: GenMessage<DeleteDNSRecordRequest> = /*@__PURE__*/
-  messageDesc(file_dns_record_v1_dns_record, 11);
+  messageDesc(file_dns_record_v1_dns_record, 13);
 
 /**
  * @generated from message dns_record.v1.DeleteDNSRecordResponse
@@ -322,7 +416,7 @@ export type DeleteDNSRecordResponse = Message<"dns_record.v1.DeleteDNSRecordResp
  * Use `create(DeleteDNSRecordResponseSchema)` to create a new message.
  */
 export const DeleteDNSRecordResponseSchema: GenMessage<DeleteDNSRecordResponse> = /*@__PURE__*/
-  messageDesc(file_dns_record_v1_dns_record, 12);
+  messageDesc(file_dns_record_v1_dns_record, 14);
 
 /**
  * @generated from service dns_record.v1.DNSRecordService
@@ -0,0 +1,241 @@
+// @generated by protoc-gen-es v2.2.5 with parameter "target=ts"
+// @generated from file grant/v1/grant.proto (package grant.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file grant/v1/grant.proto.
+ */
+export const file_grant_v1_grant: GenFile = /*@__PURE__*/
+  fileDesc("ChRncmFudC92MS9ncmFudC5wcm90bxIIZ3JhbnQudjEivgEKC1JlY29yZEdyYW50EgoKAmlkGAEgASgJEg8KB3pvbmVfaWQYAiABKAkSLwoOcHJpbmNpcGFsX3R5cGUYAyABKA4yFy5ncmFudC52MS5QcmluY2lwYWxUeXBlEhQKDHByaW5jaXBhbF9pZBgEIAEoCRIUCgxuYW1lX3BhdHRlcm4YBSABKAkSDQoFdHlwZXMYBiADKAkSEgoKY3JlYXRlZF9ieRgHIAEoCRISCgpjcmVhdGVkX2F0GAggASgJIpcBChhDcmVhdGVSZWNvcmRHcmFudFJlcXVlc3QSDwoHem9uZV9pZBgBIAEoCRIvCg5wcmluY2lwYWxfdHlwZRgCIAEoDjIXLmdyYW50LnYxLlByaW5jaXBhbFR5cGUSFAoMcHJpbmNpcGFsX2lkGAMgASgJEhQKDG5hbWVfcGF0dGVybhgEIAEoCRINCgV0eXBlcxgFIAMoCSJBChlDcmVhdGVSZWNvcmRHcmFudFJlc3BvbnNlEiQKBWdyYW50GAEgASgLMhUuZ3JhbnQudjEuUmVjb3JkR3JhbnQiKgoXTGlzdFJlY29yZEdyYW50c1JlcXVlc3QSDwoHem9uZV9pZBgBIAEoCSJBChhMaXN0UmVjb3JkR3JhbnRzUmVzcG9uc2USJQoGZ3JhbnRzGAEgAygLMhUuZ3JhbnQudjEuUmVjb3JkR3JhbnQiJgoYRGVsZXRlUmVjb3JkR3JhbnRSZXF1ZXN0EgoKAmlkGAEgASgJIhsKGURlbGV0ZVJlY29yZEdyYW50UmVzcG9uc2UqZgoNUHJpbmNpcGFsVHlwZRIeChpQUklOQ0lQQUxfVFlQRV9VTlNQRUNJRklFRBAAEhcKE1BSSU5DSVBBTF9UWVBFX1VTRVIQARIcChhQUklOQ0lQQUxfVFlQRV9BUElfVE9LRU4QAjKxAgoSUmVjb3JkR3JhbnRTZXJ2aWNlEl4KEUNyZWF0ZVJlY29yZEdyYW50EiIuZ3JhbnQudjEuQ3JlYXRlUmVjb3JkR3JhbnRSZXF1ZXN0GiMuZ3JhbnQudjEuQ3JlYXRlUmVjb3JkR3JhbnRSZXNwb25zZSIAElsKEExpc3RSZWNvcmRHcmFudHMSIS5ncmFudC52MS5MaXN0UmVjb3JkR3JhbnRzUmVxdWVzdBoiLmdyYW50LnYxLkxpc3RSZWNvcmRHcmFudHNSZXNwb25zZSIAEl4KEURlbGV0ZVJlY29yZEdyYW50EiIuZ3JhbnQudjEuRGVsZXRlUmVjb3JkR3JhbnRSZXF1ZXN0GiMuZ3JhbnQudjEuRGVsZXRlUmVjb3JkR3JhbnRSZXNwb25zZSIAQh1aG2Ruc2FyYy9nZW4vZ3JhbnQvdjE7Z3JhbnR2MWIGcHJvdG8z");
+
+/**
+ * @generated from message grant.v1.RecordGrant
+ */
+export type RecordGrant = Message<"grant.v1.RecordGrant"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string zone_id = 2;
+   */
+  zoneId: string;
+
+  /**
+   * @generated from field: grant.v1.PrincipalType principal_type = 3;
+   */
+  principalType: PrincipalType;
+
+  /**
+   * @generated from field: string principal_id = 4;
+   */
+  principalId: string;
+
+  /**
+   * @generated from field: string name_pattern = 5;
+   */
+  namePattern: string;
+
+  /**
+   * @generated from field: repeated string types = 6;
+   */
+  types: string[];
+
+  /**
+   * @generated from field: string created_by = 7;
+   */
+  createdBy: string;
+
+  /**
+   * @generated from field: string created_at = 8;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message grant.v1.RecordGrant.
+ * Use `create(RecordGrantSchema)` to create a new message.
+ */
+export const RecordGrantSchema: GenMessage<RecordGrant> = /*@__PURE__*/
+  messageDesc(file_grant_v1_grant, 0);
+
+/**
+ * @generated from message grant.v1.CreateRecordGrantRequest
+ */
+export type CreateRecordGrantRequest = Message<"grant.v1.CreateRecordGrantRequest"> & {
+  /**
+   * @generated from field: string zone_id = 1;
+   */
+  zoneId: string;
+
+  /**
+   * @generated from field: grant.v1.PrincipalType principal_type = 2;
+   */
+  principalType: PrincipalType;
+
+  /**
+   * @generated from field: string principal_id = 3;
+   */
+  principalId: string;
+
+  /**
+   * @generated from field: string name_pattern = 4;
+   */
+  namePattern: string;
+
+  /**
+   * @generated from field: repeated string types = 5;
+   */
+  types: string[];
+};
+
+/**
+ * Describes the message grant.v1.CreateRecordGrantRequest.
+ * Use `create(CreateRecordGrantRequestSchema)` to create a new message.
+ */
+export const CreateRecordGrantRequestSchema: GenMessage<CreateRecordGrantRequest> = /*@__PURE__*/
+  messageDesc(file_grant_v1_grant, 1);
+
+/**
+ * @generated from message grant.v1.CreateRecordGrantResponse
+ */
+export type CreateRecordGrantResponse = Message<"grant.v1.CreateRecordGrantResponse"> & {
+  /**
+   * @generated from field: grant.v1.RecordGrant grant = 1;
+   */
+  grant?: RecordGrant;
+};
+
+/**
+ * Describes the message grant.v1.CreateRecordGrantResponse.
+ * Use `create(CreateRecordGrantResponseSchema)` to create a new message.
+ */
+export const CreateRecordGrantResponseSchema: GenMessage<CreateRecordGrantResponse> = /*@__PURE__*/
+  messageDesc(file_grant_v1_grant, 2);
+
+/**
+ * @generated from message grant.v1.ListRecordGrantsRequest
+ */
+export type ListRecordGrantsRequest = Message<"grant.v1.ListRecordGrantsRequest"> & {
+  /**
+   * @generated from field: string zone_id = 1;
+   */
+  zoneId: string;
+};
+
+/**
+ * Describes the message grant.v1.ListRecordGrantsRequest.
+ * Use `create(ListRecordGrantsRequestSchema)` to create a new message.
+ */
+export const ListRecordGrantsRequestSchema: GenMessage<ListRecordGrantsRequest> = /*@__PURE__*/
+  messageDesc(file_grant_v1_grant, 3);
+
+/**
+ * @generated from message grant.v1.ListRecordGrantsResponse
+ */
+export type ListRecordGrantsResponse = Message<"grant.v1.ListRecordGrantsResponse"> & {
+  /**
+   * @generated from field: repeated grant.v1.RecordGrant grants = 1;
+   */
+  grants: RecordGrant[];
+};
+
+/**
+ * Describes the message grant.v1.ListRecordGrantsResponse.
+ * Use `create(ListRecordGrantsResponseSchema)` to create a new message.
+ */
+export const ListRecordGrantsResponseSchema: GenMessage<ListRecordGrantsResponse> = /*@__PURE__*/
+  messageDesc(file_grant_v1_grant, 4);
+
+/**
+ * @generated from message grant.v1.DeleteRecordGrantRequest
+ */
+export type DeleteRecordGrantRequest = Message<"grant.v1.DeleteRecordGrantRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message grant.v1.DeleteRecordGrantRequest.
+ * Use `create(DeleteRecordGrantRequestSchema)` to create a new message.
+ */
+export const DeleteRecordGrantRequestSchema: GenMessage<DeleteRecordGrantRequest> = /*@__PURE__*/
+  messageDesc(file_grant_v1_grant, 5);
+
+/**
+ * @generated from message grant.v1.DeleteRecordGrantResponse
+ */
+export type DeleteRecordGrantResponse = Message<"grant.v1.DeleteRecordGrantResponse"> & {
+};
+
+/**
+ * Describes the message grant.v1.DeleteRecordGrantResponse.
+ * Use `create(DeleteRecordGrantResponseSchema)` to create a new message.
+ */
+export const DeleteRecordGrantResponseSchema: GenMessage<DeleteRecordGrantResponse> = /*@__PURE__*/
+  messageDesc(file_grant_v1_grant, 6);
+
+/**
+ * @generated from enum grant.v1.PrincipalType
+ */
+export enum PrincipalType {
+  /**
+   * @generated from enum value: PRINCIPAL_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PRINCIPAL_TYPE_USER = 1;
+   */
+  USER = 1,
+
+  /**
+   * @generated from enum value: PRINCIPAL_TYPE_API_TOKEN = 2;
+   */
+  API_TOKEN = 2,
+}
+
+/**
+ * Describes the enum grant.v1.PrincipalType.
+ */
+export const PrincipalTypeSchema: GenEnum<PrincipalType> = /*@__PURE__*/
+  enumDesc(file_grant_v1_grant, 0);
+
+/**
+ * @generated from service grant.v1.RecordGrantService
+ */
+export const RecordGrantService: GenService<{
+  /**
+   * @generated from rpc grant.v1.RecordGrantService.CreateRecordGrant
+   */
+  createRecordGrant: {
+    methodKind: "unary";
+    input: typeof CreateRecordGrantRequestSchema;
+    output: typeof CreateRecordGrantResponseSchema;
+  },
+  /**
+   * @generated from rpc grant.v1.RecordGrantService.ListRecordGrants
+   */
+  listRecordGrants: {
+    methodKind: "unary";
+    input: typeof ListRecordGrantsRequestSchema;
+    output: typeof ListRecordGrantsResponseSchema;
+  },
+  /**
+   * @generated from rpc grant.v1.RecordGrantService.DeleteRecordGrant
+   */
+  deleteRecordGrant: {
+    methodKind: "unary";
+    input: typeof DeleteRecordGrantRequestSchema;
+    output: typeof DeleteRecordGrantResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_grant_v1_grant, 0);
+
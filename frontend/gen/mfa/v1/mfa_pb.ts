@@ -0,0 +1,477 @@
+// @generated by protoc-gen-es v2.2.5 with parameter "target=ts"
+// @generated from file mfa/v1/mfa.proto (package mfa.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file mfa/v1/mfa.proto.
+ */
+export const file_mfa_v1_mfa: GenFile = /*@__PURE__*/
+  fileDesc("ChBtZmEvdjEvbWZhLnByb3RvEgZtZmEudjEiWAoSV2ViQXV0aG5DcmVkZW50aWFsEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEgoKY3JlYXRlZF9hdBgDIAEoCRIUCgxsYXN0X3VzZWRfYXQYBCABKAkiFQoTR2V0TUZBU3RhdHVzUmVxdWVzdCKkAQoUR2V0TUZBU3RhdHVzUmVzcG9uc2USFAoMdG90cF9lbmFibGVkGAEgASgIEjgKFHdlYmF1dGhuX2NyZWRlbnRpYWxzGAIgAygLMhoubWZhLnYxLldlYkF1dGhuQ3JlZGVudGlhbBIgChhyZWNvdmVyeV9jb2Rlc19yZW1haW5pbmcYAyABKAUSGgoSd2ViYXV0aG5fYXZhaWxhYmxlGAQgASgIIhwKGkJlZ2luVE9UUEVucm9sbG1lbnRSZXF1ZXN0IkIKG0JlZ2luVE9UUEVucm9sbG1lbnRSZXNwb25zZRIOCgZzZWNyZXQYASABKAkSEwoLb3RwYXV0aF91cmwYAiABKAkiLAocQ29uZmlybVRPVFBFbnJvbGxtZW50UmVxdWVzdBIMCgRjb2RlGAEgASgJIjcKHUNvbmZpcm1UT1RQRW5yb2xsbWVudFJlc3BvbnNlEhYKDnJlY292ZXJ5X2NvZGVzGAEgAygJIhQKEkRpc2FibGVUT1RQUmVxdWVzdCIVChNEaXNhYmxlVE9UUFJlc3BvbnNlIiIKIEJlZ2luV2ViQXV0aG5SZWdpc3RyYXRpb25SZXF1ZXN0IjkKIUJlZ2luV2ViQXV0aG5SZWdpc3RyYXRpb25SZXNwb25zZRIUCgxvcHRpb25zX2pzb24YASABKAkiSAohRmluaXNoV2ViQXV0aG5SZWdpc3RyYXRpb25SZXF1ZXN0EgwKBG5hbWUYASABKAkSFQoNcmVzcG9uc2VfanNvbhgCIAEoCSJsCiJGaW5pc2hXZWJBdXRoblJlZ2lzdHJhdGlvblJlc3BvbnNlEi4KCmNyZWRlbnRpYWwYASABKAsyGi5tZmEudjEuV2ViQXV0aG5DcmVkZW50aWFsEhYKDnJlY292ZXJ5X2NvZGVzGAIgAygJIi0KH0RlbGV0ZVdlYkF1dGhuQ3JlZGVudGlhbFJlcXVlc3QSCgoCaWQYASABKAkiIgogRGVsZXRlV2ViQXV0aG5DcmVkZW50aWFsUmVzcG9uc2UiIAoeUmVnZW5lcmF0ZVJlY292ZXJ5Q29kZXNSZXF1ZXN0IjkKH1JlZ2VuZXJhdGVSZWNvdmVyeUNvZGVzUmVzcG9uc2USFgoOcmVjb3ZlcnlfY29kZXMYASADKAkiFAoSQmVnaW5TdGVwVXBSZXF1ZXN0IjQKE0JlZ2luU3RlcFVwUmVzcG9uc2USHQoVd2ViYXV0aG5fb3B0aW9uc19qc29uGAEgASgJIlkKDVN0ZXBVcFJlcXVlc3QSEQoJdG90cF9jb2RlGAEgASgJEhUKDXJlY292ZXJ5X2NvZGUYAiABKAkSHgoWd2ViYXV0aG5fcmVzcG9uc2VfanNvbhgDIAEoCSIQCg5TdGVwVXBSZXNwb25zZTK8BwoKTUZBU2VydmljZRJLCgxHZXRNRkFTdGF0dXMSGy5tZmEudjEuR2V0TUZBU3RhdHVzUmVxdWVzdBocLm1mYS52MS5HZXRNRkFTdGF0dXNSZXNwb25zZSIAEmAKE0JlZ2luVE9UUEVucm9sbG1lbnQSIi5tZmEudjEuQmVnaW5UT1RQRW5yb2xsbWVudFJlcXVlc3QaIy5tZmEudjEuQmVnaW5UT1RQRW5yb2xsbWVudFJlc3BvbnNlIgASZgoVQ29uZmlybVRPVFBFbnJvbGxtZW50EiQubWZhLnYxLkNvbmZpcm1UT1RQRW5yb2xsbWVudFJlcXVlc3QaJS5tZmEudjEuQ29uZmlybVRPVFBFbnJvbGxtZW50UmVzcG9uc2UiABJICgtEaXNhYmxlVE9UUBIaLm1mYS52MS5EaXNhYmxlVE9UUFJlcXVlc3QaGy5tZmEudjEuRGlzYWJsZVRPVFBSZXNwb25zZSIAEnIKGUJlZ2luV2ViQXV0aG5SZWdpc3RyYXRpb24SKC5tZmEudjEuQmVnaW5XZWJBdXRoblJlZ2lzdHJhdGlvblJlcXVlc3QaKS5tZmEudjEuQmVnaW5XZWJBdXRoblJlZ2lzdHJhdGlvblJlc3BvbnNlIgASdQoaRmluaXNoV2ViQXV0aG5SZWdpc3RyYXRpb24SKS5tZmEudjEuRmluaXNoV2ViQXV0aG5SZWdpc3RyYXRpb25SZXF1ZXN0GioubWZhLnYxLkZpbmlzaFdlYkF1dGhuUmVnaXN0cmF0aW9uUmVzcG9uc2UiABJvChhEZWxldGVXZWJBdXRobkNyZWRlbnRpYWwSJy5tZmEudjEuRGVsZXRlV2ViQXV0aG5DcmVkZW50aWFsUmVxdWVzdBooLm1mYS52MS5EZWxldGVXZWJBdXRobkNyZWRlbnRpYWxSZXNwb25zZSIAEmwKF1JlZ2VuZXJhdGVSZWNvdmVyeUNvZGVzEiYubWZhLnYxLlJlZ2VuZXJhdGVSZWNvdmVyeUNvZGVzUmVxdWVzdBonLm1mYS52MS5SZWdlbmVyYXRlUmVjb3ZlcnlDb2Rlc1Jlc3BvbnNlIgASSAoLQmVnaW5TdGVwVXASGi5tZmEudjEuQmVnaW5TdGVwVXBSZXF1ZXN0GhsubWZhLnYxLkJlZ2luU3RlcFVwUmVzcG9uc2UiABI5CgZTdGVwVXASFS5tZmEudjEuU3RlcFVwUmVxdWVzdBoWLm1mYS52MS5TdGVwVXBSZXNwb25zZSIAQhlaF2Ruc2FyYy9nZW4vbWZhL3YxO21mYXYxYgZwcm90bzM");
+
+/**
+ * @generated from message mfa.v1.WebAuthnCredential
+ */
+export type WebAuthnCredential = Message<"mfa.v1.WebAuthnCredential"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string created_at = 3;
+   */
+  createdAt: string;
+
+  /**
+   * @generated from field: string last_used_at = 4;
+   */
+  lastUsedAt: string;
+};
+
+/**
+ * Describes the message mfa.v1.WebAuthnCredential.
+ * Use `create(WebAuthnCredentialSchema)` to create a new message.
+ */
+export const WebAuthnCredentialSchema: GenMessage<WebAuthnCredential> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 0);
+
+/**
+ * @generated from message mfa.v1.GetMFAStatusRequest
+ */
+export type GetMFAStatusRequest = Message<"mfa.v1.GetMFAStatusRequest"> & {
+};
+
+/**
+ * Describes the message mfa.v1.GetMFAStatusRequest.
+ * Use `create(GetMFAStatusRequestSchema)` to create a new message.
+ */
+export const GetMFAStatusRequestSchema: GenMessage<GetMFAStatusRequest> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 1);
+
+/**
+ * @generated from message mfa.v1.GetMFAStatusResponse
+ */
+export type GetMFAStatusResponse = Message<"mfa.v1.GetMFAStatusResponse"> & {
+  /**
+   * @generated from field: bool totp_enabled = 1;
+   */
+  totpEnabled: boolean;
+
+  /**
+   * @generated from field: repeated mfa.v1.WebAuthnCredential webauthn_credentials = 2;
+   */
+  webauthnCredentials: WebAuthnCredential[];
+
+  /**
+   * @generated from field: int32 recovery_codes_remaining = 3;
+   */
+  recoveryCodesRemaining: number;
+
+  /**
+   * @generated from field: bool webauthn_available = 4;
+   */
+  webauthnAvailable: boolean;
+};
+
+/**
+ * Describes the message mfa.v1.GetMFAStatusResponse.
+ * Use `create(GetMFAStatusResponseSchema)` to create a new message.
+ */
+export const GetMFAStatusResponseSchema: GenMessage<GetMFAStatusResponse> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 2);
+
+/**
+ * @generated from message mfa.v1.BeginTOTPEnrollmentRequest
+ */
+export type BeginTOTPEnrollmentRequest = Message<"mfa.v1.BeginTOTPEnrollmentRequest"> & {
+};
+
+/**
+ * Describes the message mfa.v1.BeginTOTPEnrollmentRequest.
+ * Use `create(BeginTOTPEnrollmentRequestSchema)` to create a new message.
+ */
+export const BeginTOTPEnrollmentRequestSchema: GenMessage<BeginTOTPEnrollmentRequest> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 3);
+
+/**
+ * @generated from message mfa.v1.BeginTOTPEnrollmentResponse
+ */
+export type BeginTOTPEnrollmentResponse = Message<"mfa.v1.BeginTOTPEnrollmentResponse"> & {
+  /**
+   * @generated from field: string secret = 1;
+   */
+  secret: string;
+
+  /**
+   * @generated from field: string otpauth_url = 2;
+   */
+  otpauthUrl: string;
+};
+
+/**
+ * Describes the message mfa.v1.BeginTOTPEnrollmentResponse.
+ * Use `create(BeginTOTPEnrollmentResponseSchema)` to create a new message.
+ */
+export const BeginTOTPEnrollmentResponseSchema: GenMessage<BeginTOTPEnrollmentResponse> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 4);
+
+/**
+ * @generated from message mfa.v1.ConfirmTOTPEnrollmentRequest
+ */
+export type ConfirmTOTPEnrollmentRequest = Message<"mfa.v1.ConfirmTOTPEnrollmentRequest"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message mfa.v1.ConfirmTOTPEnrollmentRequest.
+ * Use `create(ConfirmTOTPEnrollmentRequestSchema)` to create a new message.
+ */
+export const ConfirmTOTPEnrollmentRequestSchema: GenMessage<ConfirmTOTPEnrollmentRequest> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 5);
+
+/**
+ * @generated from message mfa.v1.ConfirmTOTPEnrollmentResponse
+ */
+export type ConfirmTOTPEnrollmentResponse = Message<"mfa.v1.ConfirmTOTPEnrollmentResponse"> & {
+  /**
+   * @generated from field: repeated string recovery_codes = 1;
+   */
+  recoveryCodes: string[];
+};
+
+/**
+ * Describes the message mfa.v1.ConfirmTOTPEnrollmentResponse.
+ * Use `create(ConfirmTOTPEnrollmentResponseSchema)` to create a new message.
+ */
+export const ConfirmTOTPEnrollmentResponseSchema: GenMessage<ConfirmTOTPEnrollmentResponse> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 6);
+
+/**
+ * @generated from message mfa.v1.DisableTOTPRequest
+ */
+export type DisableTOTPRequest = Message<"mfa.v1.DisableTOTPRequest"> & {
+};
+
+/**
+ * Describes the message mfa.v1.DisableTOTPRequest.
+ * Use `create(DisableTOTPRequestSchema)` to create a new message.
+ */
+export const DisableTOTPRequestSchema: GenMessage<DisableTOTPRequest> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 7);
+
+/**
+ * @generated from message mfa.v1.DisableTOTPResponse
+ */
+export type DisableTOTPResponse = Message<"mfa.v1.DisableTOTPResponse"> & {
+};
+
+/**
+ * Describes the message mfa.v1.DisableTOTPResponse.
+ * Use `create(DisableTOTPResponseSchema)` to create a new message.
+ */
+export const DisableTOTPResponseSchema: GenMessage<DisableTOTPResponse> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 8);
+
+/**
+ * @generated from message mfa.v1.BeginWebAuthnRegistrationRequest
+ */
+export type BeginWebAuthnRegistrationRequest = Message<"mfa.v1.BeginWebAuthnRegistrationRequest"> & {
+};
+
+/**
+ * Describes the message mfa.v1.BeginWebAuthnRegistrationRequest.
+ * Use `create(BeginWebAuthnRegistrationRequestSchema)` to create a new message.
+ */
+export const BeginWebAuthnRegistrationRequestSchema: GenMessage<BeginWebAuthnRegistrationRequest> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 9);
+
+/**
+ * @generated from message mfa.v1.BeginWebAuthnRegistrationResponse
+ */
+export type BeginWebAuthnRegistrationResponse = Message<"mfa.v1.BeginWebAuthnRegistrationResponse"> & {
+  /**
+   * @generated from field: string options_json = 1;
+   */
+  optionsJson: string;
+};
+
+/**
+ * Describes the message mfa.v1.BeginWebAuthnRegistrationResponse.
+ * Use `create(BeginWebAuthnRegistrationResponseSchema)` to create a new message.
+ */
+export const BeginWebAuthnRegistrationResponseSchema: GenMessage<BeginWebAuthnRegistrationResponse> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 10);
+
+/**
+ * @generated from message mfa.v1.FinishWebAuthnRegistrationRequest
+ */
+export type FinishWebAuthnRegistrationRequest = Message<"mfa.v1.FinishWebAuthnRegistrationRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string response_json = 2;
+   */
+  responseJson: string;
+};
+
+/**
+ * Describes the message mfa.v1.FinishWebAuthnRegistrationRequest.
+ * Use `create(FinishWebAuthnRegistrationRequestSchema)` to create a new message.
+ */
+export const FinishWebAuthnRegistrationRequestSchema: GenMessage<FinishWebAuthnRegistrationRequest> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 11);
+
+/**
+ * @generated from message mfa.v1.FinishWebAuthnRegistrationResponse
+ */
+export type FinishWebAuthnRegistrationResponse = Message<"mfa.v1.FinishWebAuthnRegistrationResponse"> & {
+  /**
+   * @generated from field: mfa.v1.WebAuthnCredential credential = 1;
+   */
+  credential?: WebAuthnCredential;
+
+  /**
+   * @generated from field: repeated string recovery_codes = 2;
+   */
+  recoveryCodes: string[];
+};
+
+/**
+ * Describes the message mfa.v1.FinishWebAuthnRegistrationResponse.
+ * Use `create(FinishWebAuthnRegistrationResponseSchema)` to create a new message.
+ */
+export const FinishWebAuthnRegistrationResponseSchema: GenMessage<FinishWebAuthnRegistrationResponse> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 12);
+
+/**
+ * @generated from message mfa.v1.DeleteWebAuthnCredentialRequest
+ */
+export type DeleteWebAuthnCredentialRequest = Message<"mfa.v1.DeleteWebAuthnCredentialRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message mfa.v1.DeleteWebAuthnCredentialRequest.
+ * Use `create(DeleteWebAuthnCredentialRequestSchema)` to create a new message.
+ */
+export const DeleteWebAuthnCredentialRequestSchema: GenMessage<DeleteWebAuthnCredentialRequest> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 13);
+
+/**
+ * @generated from message mfa.v1.DeleteWebAuthnCredentialResponse
+ */
+export type DeleteWebAuthnCredentialResponse = Message<"mfa.v1.DeleteWebAuthnCredentialResponse"> & {
+};
+
+/**
+ * Describes the message mfa.v1.DeleteWebAuthnCredentialResponse.
+ * Use `create(DeleteWebAuthnCredentialResponseSchema)` to create a new message.
+ */
+export const DeleteWebAuthnCredentialResponseSchema: GenMessage<DeleteWebAuthnCredentialResponse> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 14);
+
+/**
+ * @generated from message mfa.v1.RegenerateRecoveryCodesRequest
+ */
+export type RegenerateRecoveryCodesRequest = Message<"mfa.v1.RegenerateRecoveryCodesRequest"> & {
+};
+
+/**
+ * Describes the message mfa.v1.RegenerateRecoveryCodesRequest.
+ * Use `create(RegenerateRecoveryCodesRequestSchema)` to create a new message.
+ */
+export const RegenerateRecoveryCodesRequestSchema: GenMessage<RegenerateRecoveryCodesRequest> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 15);
+
+/**
+ * @generated from message mfa.v1.RegenerateRecoveryCodesResponse
+ */
+export type RegenerateRecoveryCodesResponse = Message<"mfa.v1.RegenerateRecoveryCodesResponse"> & {
+  /**
+   * @generated from field: repeated string recovery_codes = 1;
+   */
+  recoveryCodes: string[];
+};
+
+/**
+ * Describes the message mfa.v1.RegenerateRecoveryCodesResponse.
+ * Use `create(RegenerateRecoveryCodesResponseSchema)` to create a new message.
+ */
+export const RegenerateRecoveryCodesResponseSchema: GenMessage<RegenerateRecoveryCodesResponse> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 16);
+
+/**
+ * @generated from message mfa.v1.BeginStepUpRequest
+ */
+export type BeginStepUpRequest = Message<"mfa.v1.BeginStepUpRequest"> & {
+};
+
+/**
+ * Describes the message mfa.v1.BeginStepUpRequest.
+ * Use `create(BeginStepUpRequestSchema)` to create a new message.
+ */
+export const BeginStepUpRequestSchema: GenMessage<BeginStepUpRequest> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 17);
+
+/**
+ * @generated from message mfa.v1.BeginStepUpResponse
+ */
+export type BeginStepUpResponse = Message<"mfa.v1.BeginStepUpResponse"> & {
+  /**
+   * @generated from field: string webauthn_options_json = 1;
+   */
+  webauthnOptionsJson: string;
+};
+
+/**
+ * Describes the message mfa.v1.BeginStepUpResponse.
+ * Use `create(BeginStepUpResponseSchema)` to create a new message.
+ */
+export const BeginStepUpResponseSchema: GenMessage<BeginStepUpResponse> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 18);
+
+/**
+ * @generated from message mfa.v1.StepUpRequest
+ */
+export type StepUpRequest = Message<"mfa.v1.StepUpRequest"> & {
+  /**
+   * @generated from field: string totp_code = 1;
+   */
+  totpCode: string;
+
+  /**
+   * @generated from field: string recovery_code = 2;
+   */
+  recoveryCode: string;
+
+  /**
+   * @generated from field: string webauthn_response_json = 3;
+   */
+  webauthnResponseJson: string;
+};
+
+/**
+ * Describes the message mfa.v1.StepUpRequest.
+ * Use `create(StepUpRequestSchema)` to create a new message.
+ */
+export const StepUpRequestSchema: GenMessage<StepUpRequest> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 19);
+
+/**
+ * @generated from message mfa.v1.StepUpResponse
+ */
+export type StepUpResponse = Message<"mfa.v1.StepUpResponse"> & {
+};
+
+/**
+ * Describes the message mfa.v1.StepUpResponse.
+ * Use `create(StepUpResponseSchema)` to create a new message.
+ */
+export const StepUpResponseSchema: GenMessage<StepUpResponse> = /*@__PURE__*/
+  messageDesc(file_mfa_v1_mfa, 20);
+
+/**
+ * @generated from service mfa.v1.MFAService
+ */
+export const MFAService: GenService<{
+  /**
+   * @generated from rpc mfa.v1.MFAService.GetMFAStatus
+   */
+  getMFAStatus: {
+    methodKind: "unary";
+    input: typeof GetMFAStatusRequestSchema;
+    output: typeof GetMFAStatusResponseSchema;
+  },
+  /**
+   * @generated from rpc mfa.v1.MFAService.BeginTOTPEnrollment
+   */
+  beginTOTPEnrollment: {
+    methodKind: "unary";
+    input: typeof BeginTOTPEnrollmentRequestSchema;
+    output: typeof BeginTOTPEnrollmentResponseSchema;
+  },
+  /**
+   * @generated from rpc mfa.v1.MFAService.ConfirmTOTPEnrollment
+   */
+  confirmTOTPEnrollment: {
+    methodKind: "unary";
+    input: typeof ConfirmTOTPEnrollmentRequestSchema;
+    output: typeof ConfirmTOTPEnrollmentResponseSchema;
+  },
+  /**
+   * @generated from rpc mfa.v1.MFAService.DisableTOTP
+   */
+  disableTOTP: {
+    methodKind: "unary";
+    input: typeof DisableTOTPRequestSchema;
+    output: typeof DisableTOTPResponseSchema;
+  },
+  /**
+   * @generated from rpc mfa.v1.MFAService.BeginWebAuthnRegistration
+   */
+  beginWebAuthnRegistration: {
+    methodKind: "unary";
+    input: typeof BeginWebAuthnRegistrationRequestSchema;
+    output: typeof BeginWebAuthnRegistrationResponseSchema;
+  },
+  /**
+   * @generated from rpc mfa.v1.MFAService.FinishWebAuthnRegistration
+   */
+  finishWebAuthnRegistration: {
+    methodKind: "unary";
+    input: typeof FinishWebAuthnRegistrationRequestSchema;
+    output: typeof FinishWebAuthnRegistrationResponseSchema;
+  },
+  /**
+   * @generated from rpc mfa.v1.MFAService.DeleteWebAuthnCredential
+   */
+  deleteWebAuthnCredential: {
+    methodKind: "unary";
+    input: typeof DeleteWebAuthnCredentialRequestSchema;
+    output: typeof DeleteWebAuthnCredentialResponseSchema;
+  },
+  /**
+   * @generated from rpc mfa.v1.MFAService.RegenerateRecoveryCodes
+   */
+  regenerateRecoveryCodes: {
+    methodKind: "unary";
+    input: typeof RegenerateRecoveryCodesRequestSchema;
+    output: typeof RegenerateRecoveryCodesResponseSchema;
+  },
+  /**
+   * @generated from rpc mfa.v1.MFAService.BeginStepUp
+   */
+  beginStepUp: {
+    methodKind: "unary";
+    input: typeof BeginStepUpRequestSchema;
+    output: typeof BeginStepUpResponseSchema;
+  },
+  /**
+   * @generated from rpc mfa.v1.MFAService.StepUp
+   */
+  stepUp: {
+    methodKind: "unary";
+    input: typeof StepUpRequestSchema;
+    output: typeof StepUpResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_mfa_v1_mfa, 0);
+
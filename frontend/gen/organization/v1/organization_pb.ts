@@ -0,0 +1,654 @@
+// @generated by protoc-gen-es v2.2.5 with parameter "target=ts"
+// @generated from file organization/v1/organization.proto (package organization.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file organization/v1/organization.proto.
+ */
+export const file_organization_v1_organization: GenFile = /*@__PURE__*/
+  fileDesc("CiJvcmdhbml6YXRpb24vdjEvb3JnYW5pemF0aW9uLnByb3RvEg9vcmdhbml6YXRpb24udjEicwoMT3JnYW5pemF0aW9uEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEAoIcGVyc29uYWwYAyABKAgSIwoEcm9sZRgEIAEoDjIVLm9yZ2FuaXphdGlvbi52MS5Sb2xlEhIKCmNyZWF0ZWRfYXQYBSABKAkicQoGTWVtYmVyEg8KB3VzZXJfaWQYASABKAkSDQoFZW1haWwYAiABKAkSDgoGYXZhdGFyGAMgASgJEiMKBHJvbGUYBCABKA4yFS5vcmdhbml6YXRpb24udjEuUm9sZRISCgpjcmVhdGVkX2F0GAUgASgJIogBCgpJbnZpdGF0aW9uEgoKAmlkGAEgASgJEg0KBWVtYWlsGAIgASgJEiMKBHJvbGUYAyABKA4yFS5vcmdhbml6YXRpb24udjEuUm9sZRISCgppbnZpdGVkX2J5GAQgASgJEhIKCmV4cGlyZXNfYXQYBSABKAkSEgoKY3JlYXRlZF9hdBgGIAEoCSIaChhMaXN0T3JnYW5pemF0aW9uc1JlcXVlc3QiUQoZTGlzdE9yZ2FuaXphdGlvbnNSZXNwb25zZRI0Cg1vcmdhbml6YXRpb25zGAEgAygLMh0ub3JnYW5pemF0aW9uLnYxLk9yZ2FuaXphdGlvbiIpChlDcmVhdGVPcmdhbml6YXRpb25SZXF1ZXN0EgwKBG5hbWUYASABKAkiUQoaQ3JlYXRlT3JnYW5pemF0aW9uUmVzcG9uc2USMwoMb3JnYW5pemF0aW9uGAEgASgLMh0ub3JnYW5pemF0aW9uLnYxLk9yZ2FuaXphdGlvbiI1ChlSZW5hbWVPcmdhbml6YXRpb25SZXF1ZXN0EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkiUQoaUmVuYW1lT3JnYW5pemF0aW9uUmVzcG9uc2USMwoMb3JnYW5pemF0aW9uGAEgASgLMh0ub3JnYW5pemF0aW9uLnYxLk9yZ2FuaXphdGlvbiInChlEZWxldGVPcmdhbml6YXRpb25SZXF1ZXN0EgoKAmlkGAEgASgJIhwKGkRlbGV0ZU9yZ2FuaXphdGlvblJlc3BvbnNlIi0KEkxpc3RNZW1iZXJzUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkiPwoTTGlzdE1lbWJlcnNSZXNwb25zZRIoCgdtZW1iZXJzGAEgAygLMhcub3JnYW5pemF0aW9uLnYxLk1lbWJlciJoChdVcGRhdGVNZW1iZXJSb2xlUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIjCgRyb2xlGAMgASgOMhUub3JnYW5pemF0aW9uLnYxLlJvbGUiQwoYVXBkYXRlTWVtYmVyUm9sZVJlc3BvbnNlEicKBm1lbWJlchgBIAEoCzIXLm9yZ2FuaXphdGlvbi52MS5NZW1iZXIiPwoTUmVtb3ZlTWVtYmVyUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCSIWChRSZW1vdmVNZW1iZXJSZXNwb25zZSJiChNJbnZpdGVNZW1iZXJSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoCRINCgVlbWFpbBgCIAEoCRIjCgRyb2xlGAMgASgOMhUub3JnYW5pemF0aW9uLnYxLlJvbGUiRwoUSW52aXRlTWVtYmVyUmVzcG9uc2USLwoKaW52aXRhdGlvbhgBIAEoCzIbLm9yZ2FuaXphdGlvbi52MS5JbnZpdGF0aW9uIjEKFkxpc3RJbnZpdGF0aW9uc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJIksKF0xpc3RJbnZpdGF0aW9uc1Jlc3BvbnNlEjAKC2ludml0YXRpb25zGAEgAygLMhsub3JnYW5pemF0aW9uLnYxLkludml0YXRpb24iPgoXUmV2b2tlSW52aXRhdGlvblJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJEgoKAmlkGAIgASgJIhoKGFJldm9rZUludml0YXRpb25SZXNwb25zZSIoChdBY2NlcHRJbnZpdGF0aW9uUmVxdWVzdBINCgV0b2tlbhgBIAEoCSJPChhBY2NlcHRJbnZpdGF0aW9uUmVzcG9uc2USMwoMb3JnYW5pemF0aW9uGAEgASgLMh0ub3JnYW5pemF0aW9uLnYxLk9yZ2FuaXphdGlvbipeCgRSb2xlEhQKEFJPTEVfVU5TUEVDSUZJRUQQABIPCgtST0xFX1ZJRVdFUhABEg8KC1JPTEVfRURJVE9SEAISDgoKUk9MRV9BRE1JThADEg4KClJPTEVfT1dORVIQBDKZCQoTT3JnYW5pemF0aW9uU2VydmljZRJsChFMaXN0T3JnYW5pemF0aW9ucxIpLm9yZ2FuaXphdGlvbi52MS5MaXN0T3JnYW5pemF0aW9uc1JlcXVlc3QaKi5vcmdhbml6YXRpb24udjEuTGlzdE9yZ2FuaXphdGlvbnNSZXNwb25zZSIAEm8KEkNyZWF0ZU9yZ2FuaXphdGlvbhIqLm9yZ2FuaXphdGlvbi52MS5DcmVhdGVPcmdhbml6YXRpb25SZXF1ZXN0Gisub3JnYW5pemF0aW9uLnYxLkNyZWF0ZU9yZ2FuaXphdGlvblJlc3BvbnNlIgASbwoSUmVuYW1lT3JnYW5pemF0aW9uEioub3JnYW5pemF0aW9uLnYxLlJlbmFtZU9yZ2FuaXphdGlvblJlcXVlc3QaKy5vcmdhbml6YXRpb24udjEuUmVuYW1lT3JnYW5pemF0aW9uUmVzcG9uc2UiABJvChJEZWxldGVPcmdhbml6YXRpb24SKi5vcmdhbml6YXRpb24udjEuRGVsZXRlT3JnYW5pemF0aW9uUmVxdWVzdBorLm9yZ2FuaXphdGlvbi52MS5EZWxldGVPcmdhbml6YXRpb25SZXNwb25zZSIAEloKC0xpc3RNZW1iZXJzEiMub3JnYW5pemF0aW9uLnYxLkxpc3RNZW1iZXJzUmVxdWVzdBokLm9yZ2FuaXphdGlvbi52MS5MaXN0TWVtYmVyc1Jlc3BvbnNlIgASaQoQVXBkYXRlTWVtYmVyUm9sZRIoLm9yZ2FuaXphdGlvbi52MS5VcGRhdGVNZW1iZXJSb2xlUmVxdWVzdBopLm9yZ2FuaXphdGlvbi52MS5VcGRhdGVNZW1iZXJSb2xlUmVzcG9uc2UiABJdCgxSZW1vdmVNZW1iZXISJC5vcmdhbml6YXRpb24udjEuUmVtb3ZlTWVtYmVyUmVxdWVzdBolLm9yZ2FuaXphdGlvbi52MS5SZW1vdmVNZW1iZXJSZXNwb25zZSIAEl0KDEludml0ZU1lbWJlchIkLm9yZ2FuaXphdGlvbi52MS5JbnZpdGVNZW1iZXJSZXF1ZXN0GiUub3JnYW5pemF0aW9uLnYxLkludml0ZU1lbWJlclJlc3BvbnNlIgASZgoPTGlzdEludml0YXRpb25zEicub3JnYW5pemF0aW9uLnYxLkxpc3RJbnZpdGF0aW9uc1JlcXVlc3QaKC5vcmdhbml6YXRpb24udjEuTGlzdEludml0YXRpb25zUmVzcG9uc2UiABJpChBSZXZva2VJbnZpdGF0aW9uEigub3JnYW5pemF0aW9uLnYxLlJldm9rZUludml0YXRpb25SZXF1ZXN0Gikub3JnYW5pemF0aW9uLnYxLlJldm9rZUludml0YXRpb25SZXNwb25zZSIAEmkKEEFjY2VwdEludml0YXRpb24SKC5vcmdhbml6YXRpb24udjEuQWNjZXB0SW52aXRhdGlvblJlcXVlc3QaKS5vcmdhbml6YXRpb24udjEuQWNjZXB0SW52aXRhdGlvblJlc3BvbnNlIgBCK1opZG5zYXJjL2dlbi9vcmdhbml6YXRpb24vdjE7b3JnYW5pemF0aW9udjFiBnByb3RvMw");
+
+/**
+ * @generated from message organization.v1.Organization
+ */
+export type Organization = Message<"organization.v1.Organization"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: bool personal = 3;
+   */
+  personal: boolean;
+
+  /**
+   * @generated from field: organization.v1.Role role = 4;
+   */
+  role: Role;
+
+  /**
+   * @generated from field: string created_at = 5;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message organization.v1.Organization.
+ * Use `create(OrganizationSchema)` to create a new message.
+ */
+export const OrganizationSchema: GenMessage<Organization> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 0);
+
+/**
+ * @generated from message organization.v1.Member
+ */
+export type Member = Message<"organization.v1.Member"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string avatar = 3;
+   */
+  avatar: string;
+
+  /**
+   * @generated from field: organization.v1.Role role = 4;
+   */
+  role: Role;
+
+  /**
+   * @generated from field: string created_at = 5;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message organization.v1.Member.
+ * Use `create(MemberSchema)` to create a new message.
+ */
+export const MemberSchema: GenMessage<Member> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 1);
+
+/**
+ * @generated from message organization.v1.Invitation
+ */
+export type Invitation = Message<"organization.v1.Invitation"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email: string;
+
+  /**
+   * @generated from field: organization.v1.Role role = 3;
+   */
+  role: Role;
+
+  /**
+   * @generated from field: string invited_by = 4;
+   */
+  invitedBy: string;
+
+  /**
+   * @generated from field: string expires_at = 5;
+   */
+  expiresAt: string;
+
+  /**
+   * @generated from field: string created_at = 6;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message organization.v1.Invitation.
+ * Use `create(InvitationSchema)` to create a new message.
+ */
+export const InvitationSchema: GenMessage<Invitation> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 2);
+
+/**
+ * @generated from message organization.v1.ListOrganizationsRequest
+ */
+export type ListOrganizationsRequest = Message<"organization.v1.ListOrganizationsRequest"> & {
+};
+
+/**
+ * Describes the message organization.v1.ListOrganizationsRequest.
+ * Use `create(ListOrganizationsRequestSchema)` to create a new message.
+ */
+export const ListOrganizationsRequestSchema: GenMessage<ListOrganizationsRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 3);
+
+/**
+ * @generated from message organization.v1.ListOrganizationsResponse
+ */
+export type ListOrganizationsResponse = Message<"organization.v1.ListOrganizationsResponse"> & {
+  /**
+   * @generated from field: repeated organization.v1.Organization organizations = 1;
+   */
+  organizations: Organization[];
+};
+
+/**
+ * Describes the message organization.v1.ListOrganizationsResponse.
+ * Use `create(ListOrganizationsResponseSchema)` to create a new message.
+ */
+export const ListOrganizationsResponseSchema: GenMessage<ListOrganizationsResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 4);
+
+/**
+ * @generated from message organization.v1.CreateOrganizationRequest
+ */
+export type CreateOrganizationRequest = Message<"organization.v1.CreateOrganizationRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message organization.v1.CreateOrganizationRequest.
+ * Use `create(CreateOrganizationRequestSchema)` to create a new message.
+ */
+export const CreateOrganizationRequestSchema: GenMessage<CreateOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 5);
+
+/**
+ * @generated from message organization.v1.CreateOrganizationResponse
+ */
+export type CreateOrganizationResponse = Message<"organization.v1.CreateOrganizationResponse"> & {
+  /**
+   * @generated from field: organization.v1.Organization organization = 1;
+   */
+  organization?: Organization;
+};
+
+/**
+ * Describes the message organization.v1.CreateOrganizationResponse.
+ * Use `create(CreateOrganizationResponseSchema)` to create a new message.
+ */
+export const CreateOrganizationResponseSchema: GenMessage<CreateOrganizationResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 6);
+
+/**
+ * @generated from message organization.v1.RenameOrganizationRequest
+ */
+export type RenameOrganizationRequest = Message<"organization.v1.RenameOrganizationRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message organization.v1.RenameOrganizationRequest.
+ * Use `create(RenameOrganizationRequestSchema)` to create a new message.
+ */
+export const RenameOrganizationRequestSchema: GenMessage<RenameOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 7);
+
+/**
+ * @generated from message organization.v1.RenameOrganizationResponse
+ */
+export type RenameOrganizationResponse = Message<"organization.v1.RenameOrganizationResponse"> & {
+  /**
+   * @generated from field: organization.v1.Organization organization = 1;
+   */
+  organization?: Organization;
+};
+
+/**
+ * Describes the message organization.v1.RenameOrganizationResponse.
+ * Use `create(RenameOrganizationResponseSchema)` to create a new message.
+ */
+export const RenameOrganizationResponseSchema: GenMessage<RenameOrganizationResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 8);
+
+/**
+ * @generated from message organization.v1.DeleteOrganizationRequest
+ */
+export type DeleteOrganizationRequest = Message<"organization.v1.DeleteOrganizationRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message organization.v1.DeleteOrganizationRequest.
+ * Use `create(DeleteOrganizationRequestSchema)` to create a new message.
+ */
+export const DeleteOrganizationRequestSchema: GenMessage<DeleteOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 9);
+
+/**
+ * @generated from message organization.v1.DeleteOrganizationResponse
+ */
+export type DeleteOrganizationResponse = Message<"organization.v1.DeleteOrganizationResponse"> & {
+};
+
+/**
+ * Describes the message organization.v1.DeleteOrganizationResponse.
+ * Use `create(DeleteOrganizationResponseSchema)` to create a new message.
+ */
+export const DeleteOrganizationResponseSchema: GenMessage<DeleteOrganizationResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 10);
+
+/**
+ * @generated from message organization.v1.ListMembersRequest
+ */
+export type ListMembersRequest = Message<"organization.v1.ListMembersRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+};
+
+/**
+ * Describes the message organization.v1.ListMembersRequest.
+ * Use `create(ListMembersRequestSchema)` to create a new message.
+ */
+export const ListMembersRequestSchema: GenMessage<ListMembersRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 11);
+
+/**
+ * @generated from message organization.v1.ListMembersResponse
+ */
+export type ListMembersResponse = Message<"organization.v1.ListMembersResponse"> & {
+  /**
+   * @generated from field: repeated organization.v1.Member members = 1;
+   */
+  members: Member[];
+};
+
+/**
+ * Describes the message organization.v1.ListMembersResponse.
+ * Use `create(ListMembersResponseSchema)` to create a new message.
+ */
+export const ListMembersResponseSchema: GenMessage<ListMembersResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 12);
+
+/**
+ * @generated from message organization.v1.UpdateMemberRoleRequest
+ */
+export type UpdateMemberRoleRequest = Message<"organization.v1.UpdateMemberRoleRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: organization.v1.Role role = 3;
+   */
+  role: Role;
+};
+
+/**
+ * Describes the message organization.v1.UpdateMemberRoleRequest.
+ * Use `create(UpdateMemberRoleRequestSchema)` to create a new message.
+ */
+export const UpdateMemberRoleRequestSchema: GenMessage<UpdateMemberRoleRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 13);
+
+/**
+ * @generated from message organization.v1.UpdateMemberRoleResponse
+ */
+export type UpdateMemberRoleResponse = Message<"organization.v1.UpdateMemberRoleResponse"> & {
+  /**
+   * @generated from field: organization.v1.Member member = 1;
+   */
+  member?: Member;
+};
+
+/**
+ * Describes the message organization.v1.UpdateMemberRoleResponse.
+ * Use `create(UpdateMemberRoleResponseSchema)` to create a new message.
+ */
+export const UpdateMemberRoleResponseSchema: GenMessage<UpdateMemberRoleResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 14);
+
+/**
+ * @generated from message organization.v1.RemoveMemberRequest
+ */
+export type RemoveMemberRequest = Message<"organization.v1.RemoveMemberRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message organization.v1.RemoveMemberRequest.
+ * Use `create(RemoveMemberRequestSchema)` to create a new message.
+ */
+export const RemoveMemberRequestSchema: GenMessage<RemoveMemberRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 15);
+
+/**
+ * @generated from message organization.v1.RemoveMemberResponse
+ */
+export type RemoveMemberResponse = Message<"organization.v1.RemoveMemberResponse"> & {
+};
+
+/**
+ * Describes the message organization.v1.RemoveMemberResponse.
+ * Use `create(RemoveMemberResponseSchema)` to create a new message.
+ */
+export const RemoveMemberResponseSchema: GenMessage<RemoveMemberResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 16);
+
+/**
+ * @generated from message organization.v1.InviteMemberRequest
+ */
+export type InviteMemberRequest = Message<"organization.v1.InviteMemberRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email: string;
+
+  /**
+   * @generated from field: organization.v1.Role role = 3;
+   */
+  role: Role;
+};
+
+/**
+ * Describes the message organization.v1.InviteMemberRequest.
+ * Use `create(InviteMemberRequestSchema)` to create a new message.
+ */
+export const InviteMemberRequestSchema: GenMessage<InviteMemberRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 17);
+
+/**
+ * @generated from message organization.v1.InviteMemberResponse
+ */
+export type InviteMemberResponse = Message<"organization.v1.InviteMemberResponse"> & {
+  /**
+   * @generated from field: organization.v1.Invitation invitation = 1;
+   */
+  invitation?: Invitation;
+};
+
+/**
+ * Describes the message organization.v1.InviteMemberResponse.
+ * Use `create(InviteMemberResponseSchema)` to create a new message.
+ */
+export const InviteMemberResponseSchema: GenMessage<InviteMemberResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 18);
+
+/**
+ * @generated from message organization.v1.ListInvitationsRequest
+ */
+export type ListInvitationsRequest = Message<"organization.v1.ListInvitationsRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+};
+
+/**
+ * Describes the message organization.v1.ListInvitationsRequest.
+ * Use `create(ListInvitationsRequestSchema)` to create a new message.
+ */
+export const ListInvitationsRequestSchema: GenMessage<ListInvitationsRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 19);
+
+/**
+ * @generated from message organization.v1.ListInvitationsResponse
+ */
+export type ListInvitationsResponse = Message<"organization.v1.ListInvitationsResponse"> & {
+  /**
+   * @generated from field: repeated organization.v1.Invitation invitations = 1;
+   */
+  invitations: Invitation[];
+};
+
+/**
+ * Describes the message organization.v1.ListInvitationsResponse.
+ * Use `create(ListInvitationsResponseSchema)` to create a new message.
+ */
+export const ListInvitationsResponseSchema: GenMessage<ListInvitationsResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 20);
+
+/**
+ * @generated from message organization.v1.RevokeInvitationRequest
+ */
+export type RevokeInvitationRequest = Message<"organization.v1.RevokeInvitationRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: string id = 2;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message organization.v1.RevokeInvitationRequest.
+ * Use `create(RevokeInvitationRequestSchema)` to create a new message.
+ */
+export const RevokeInvitationRequestSchema: GenMessage<RevokeInvitationRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 21);
+
+/**
+ * @generated from message organization.v1.RevokeInvitationResponse
+ */
+export type RevokeInvitationResponse = Message<"organization.v1.RevokeInvitationResponse"> & {
+};
+
+/**
+ * Describes the message organization.v1.RevokeInvitationResponse.
+ * Use `create(RevokeInvitationResponseSchema)` to create a new message.
+ */
+export const RevokeInvitationResponseSchema: GenMessage<RevokeInvitationResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 22);
+
+/**
+ * @generated from message organization.v1.AcceptInvitationRequest
+ */
+export type AcceptInvitationRequest = Message<"organization.v1.AcceptInvitationRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message organization.v1.AcceptInvitationRequest.
+ * Use `create(AcceptInvitationRequestSchema)` to create a new message.
+ */
+export const AcceptInvitationRequestSchema: GenMessage<AcceptInvitationRequest> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 23);
+
+/**
+ * @generated from message organization.v1.AcceptInvitationResponse
+ */
+export type AcceptInvitationResponse = Message<"organization.v1.AcceptInvitationResponse"> & {
+  /**
+   * @generated from field: organization.v1.Organization organization = 1;
+   */
+  organization?: Organization;
+};
+
+/**
+ * Describes the message organization.v1.AcceptInvitationResponse.
+ * Use `create(AcceptInvitationResponseSchema)` to create a new message.
+ */
+export const AcceptInvitationResponseSchema: GenMessage<AcceptInvitationResponse> = /*@__PURE__*/
+  messageDesc(file_organization_v1_organization, 24);
+
+/**
+ * @generated from enum organization.v1.Role
+ */
+export enum Role {
+  /**
+   * @generated from enum value: ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ROLE_VIEWER = 1;
+   */
+  VIEWER = 1,
+
+  /**
+   * @generated from enum value: ROLE_EDITOR = 2;
+   */
+  EDITOR = 2,
+
+  /**
+   * @generated from enum value: ROLE_ADMIN = 3;
+   */
+  ADMIN = 3,
+
+  /**
+   * @generated from enum value: ROLE_OWNER = 4;
+   */
+  OWNER = 4,
+}
+
+/**
+ * Describes the enum organization.v1.Role.
+ */
+export const RoleSchema: GenEnum<Role> = /*@__PURE__*/
+  enumDesc(file_organization_v1_organization, 0);
+
+/**
+ * @generated from service organization.v1.OrganizationService
+ */
+export const OrganizationService: GenService<{
+  /**
+   * @generated from rpc organization.v1.OrganizationService.ListOrganizations
+   */
+  listOrganizations: {
+    methodKind: "unary";
+    input: typeof ListOrganizationsRequestSchema;
+    output: typeof ListOrganizationsResponseSchema;
+  },
+  /**
+   * @generated from rpc organization.v1.OrganizationService.CreateOrganization
+   */
+  createOrganization: {
+    methodKind: "unary";
+    input: typeof CreateOrganizationRequestSchema;
+    output: typeof CreateOrganizationResponseSchema;
+  },
+  /**
+   * @generated from rpc organization.v1.OrganizationService.RenameOrganization
+   */
+  renameOrganization: {
+    methodKind: "unary";
+    input: typeof RenameOrganizationRequestSchema;
+    output: typeof RenameOrganizationResponseSchema;
+  },
+  /**
+   * @generated from rpc organization.v1.OrganizationService.DeleteOrganization
+   */
+  deleteOrganization: {
+    methodKind: "unary";
+    input: typeof DeleteOrganizationRequestSchema;
+    output: typeof DeleteOrganizationResponseSchema;
+  },
+  /**
+   * @generated from rpc organization.v1.OrganizationService.ListMembers
+   */
+  listMembers: {
+    methodKind: "unary";
+    input: typeof ListMembersRequestSchema;
+    output: typeof ListMembersResponseSchema;
+  },
+  /**
+   * @generated from rpc organization.v1.OrganizationService.UpdateMemberRole
+   */
+  updateMemberRole: {
+    methodKind: "unary";
+    input: typeof UpdateMemberRoleRequestSchema;
+    output: typeof UpdateMemberRoleResponseSchema;
+  },
+  /**
+   * @generated from rpc organization.v1.OrganizationService.RemoveMember
+   */
+  removeMember: {
+    methodKind: "unary";
+    input: typeof RemoveMemberRequestSchema;
+    output: typeof RemoveMemberResponseSchema;
+  },
+  /**
+   * @generated from rpc organization.v1.OrganizationService.InviteMember
+   */
+  inviteMember: {
+    methodKind: "unary";
+    input: typeof InviteMemberRequestSchema;
+    output: typeof InviteMemberResponseSchema;
+  },
+  /**
+   * @generated from rpc organization.v1.OrganizationService.ListInvitations
+   */
+  listInvitations: {
+    methodKind: "unary";
+    input: typeof ListInvitationsRequestSchema;
+    output: typeof ListInvitationsResponseSchema;
+  },
+  /**
+   * @generated from rpc organization.v1.OrganizationService.RevokeInvitation
+   */
+  revokeInvitation: {
+    methodKind: "unary";
+    input: typeof RevokeInvitationRequestSchema;
+    output: typeof RevokeInvitationResponseSchema;
+  },
+  /**
+   * @generated from rpc organization.v1.OrganizationService.AcceptInvitation
+   */
+  acceptInvitation: {
+    methodKind: "unary";
+    input: typeof AcceptInvitationRequestSchema;
+    output: typeof AcceptInvitationResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_organization_v1_organization, 0);
+
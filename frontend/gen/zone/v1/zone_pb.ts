@@ -2,15 +2,15 @@
 // @generated from file zone/v1/zone.proto (package zone.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file zone/v1/zone.proto.
  */
 export const file_zone_v1_zone: GenFile = /*@__PURE__*/
-  fileDesc("ChJ6b25lL3YxL3pvbmUucHJvdG8SB3pvbmUudjEiJgoRQ3JlYXRlWm9uZVJlcXVlc3QSEQoJem9uZV9uYW1lGAEgASgJImAKBFpvbmUSCgoCaWQYASABKAkSEQoJem9uZV9uYW1lGAIgASgJEhEKCWlzX2FjdGl2ZRgDIAEoCBISCgpjcmVhdGVkX2F0GAQgASgJEhIKCnVwZGF0ZWRfYXQYBSABKAkiMQoSQ3JlYXRlWm9uZVJlc3BvbnNlEhsKBHpvbmUYASABKAsyDS56b25lLnYxLlpvbmUiEgoQTGlzdFpvbmVzUmVxdWVzdCIxChFMaXN0Wm9uZXNSZXNwb25zZRIcCgV6b25lcxgBIAMoCzINLnpvbmUudjEuWm9uZSIcCg5HZXRab25lUmVxdWVzdBIKCgJpZBgBIAEoCSIuCg9HZXRab25lUmVzcG9uc2USGwoEem9uZRgBIAEoCzINLnpvbmUudjEuWm9uZSIpChRHZXRab25lQnlOYW1lUmVxdWVzdBIRCgl6b25lX25hbWUYASABKAkiNAoVR2V0Wm9uZUJ5TmFtZVJlc3BvbnNlEhsKBHpvbmUYASABKAsyDS56b25lLnYxLlpvbmUiHwoRRGVsZXRlWm9uZVJlcXVlc3QSCgoCaWQYASABKAkiFAoSRGVsZXRlWm9uZVJlc3BvbnNlMvcCCgtab25lU2VydmljZRJHCgpDcmVhdGVab25lEhouem9uZS52MS5DcmVhdGVab25lUmVxdWVzdBobLnpvbmUudjEuQ3JlYXRlWm9uZVJlc3BvbnNlIgASRAoJTGlzdFpvbmVzEhkuem9uZS52MS5MaXN0Wm9uZXNSZXF1ZXN0Ghouem9uZS52MS5MaXN0Wm9uZXNSZXNwb25zZSIAEj4KB0dldFpvbmUSFy56b25lLnYxLkdldFpvbmVSZXF1ZXN0Ghguem9uZS52MS5HZXRab25lUmVzcG9uc2UiABJQCg1HZXRab25lQnlOYW1lEh0uem9uZS52MS5HZXRab25lQnlOYW1lUmVxdWVzdBoeLnpvbmUudjEuR2V0Wm9uZUJ5TmFtZVJlc3BvbnNlIgASRwoKRGVsZXRlWm9uZRIaLnpvbmUudjEuRGVsZXRlWm9uZVJlcXVlc3QaGy56b25lLnYxLkRlbGV0ZVpvbmVSZXNwb25zZSIAQhtaGWRuc2FyYy9nZW4vem9uZS92MTt6b25ldjFiBnByb3RvMw");
+  fileDesc("ChJ6b25lL3YxL3pvbmUucHJvdG8SB3pvbmUudjEiPwoRQ3JlYXRlWm9uZVJlcXVlc3QSEQoJem9uZV9uYW1lGAEgASgJEhcKD29yZ2FuaXphdGlvbl9pZBgCIAEoCSKBAwoEWm9uZRIKCgJpZBgBIAEoCRIRCgl6b25lX25hbWUYAiABKAkSEQoJaXNfYWN0aXZlGAMgASgIEhIKCmNyZWF0ZWRfYXQYBCABKAkSEgoKdXBkYXRlZF9hdBgFIAEoCRITCgtuYW1lc2VydmVycxgGIAMoCRIQCghzb2FfbWJveBgHIAEoCRIaChJ2ZXJpZmljYXRpb25fdG9rZW4YCCABKAkSGwoTdmVyaWZpY2F0aW9uX3JlY29yZBgJIAEoCRITCgt2ZXJpZmllZF9hdBgKIAEoCRIjCgZzdGF0dXMYCyABKA4yEy56b25lLnYxLlpvbmVTdGF0dXMSHAoUb2JzZXJ2ZWRfbmFtZXNlcnZlcnMYDCADKAkSFwoPbGFzdF9jaGVja2VkX2F0GA0gASgJEhgKEGxhc3RfY2hlY2tfZXJyb3IYDiABKAkSFwoPb3JnYW5pemF0aW9uX2lkGA8gASgJEhsKE3BlbmRpbmdfbmFtZXNlcnZlcnMYECADKAkifQoJWm9uZUNoZWNrEgoKAmlkGAEgASgJEiMKBnN0YXR1cxgCIAEoDjITLnpvbmUudjEuWm9uZVN0YXR1cxIcChRvYnNlcnZlZF9uYW1lc2VydmVycxgDIAMoCRINCgVlcnJvchgEIAEoCRISCgpjaGVja2VkX2F0GAUgASgJIjEKEkNyZWF0ZVpvbmVSZXNwb25zZRIbCgR6b25lGAEgASgLMg0uem9uZS52MS5ab25lIisKEExpc3Rab25lc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgJIjEKEUxpc3Rab25lc1Jlc3BvbnNlEhwKBXpvbmVzGAEgAygLMg0uem9uZS52MS5ab25lIhwKDkdldFpvbmVSZXF1ZXN0EgoKAmlkGAEgASgJIi4KD0dldFpvbmVSZXNwb25zZRIbCgR6b25lGAEgASgLMg0uem9uZS52MS5ab25lIkIKFEdldFpvbmVCeU5hbWVSZXF1ZXN0EhEKCXpvbmVfbmFtZRgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkiNAoVR2V0Wm9uZUJ5TmFtZVJlc3BvbnNlEhsKBHpvbmUYASABKAsyDS56b25lLnYxLlpvbmUiHwoRRGVsZXRlWm9uZVJlcXVlc3QSCgoCaWQYASABKAkiFAoSRGVsZXRlWm9uZVJlc3BvbnNlIlEKHFVwZGF0ZVpvbmVOYW1lc2VydmVyc1JlcXVlc3QSCgoCaWQYASABKAkSEwoLbmFtZXNlcnZlcnMYAiADKAkSEAoIc29hX21ib3gYAyABKAkiPAodVXBkYXRlWm9uZU5hbWVzZXJ2ZXJzUmVzcG9uc2USGwoEem9uZRgBIAEoCzINLnpvbmUudjEuWm9uZSIfChFWZXJpZnlab25lUmVxdWVzdBIKCgJpZBgBIAEoCSIxChJWZXJpZnlab25lUmVzcG9uc2USGwoEem9uZRgBIAEoCzINLnpvbmUudjEuWm9uZSIhChNUYWtlb3ZlclpvbmVSZXF1ZXN0EgoKAmlkGAEgASgJIjMKFFRha2VvdmVyWm9uZVJlc3BvbnNlEhsKBHpvbmUYASABKAsyDS56b25lLnYxLlpvbmUiHgoQQ2hlY2tab25lUmVxdWVzdBIKCgJpZBgBIAEoCSJTChFDaGVja1pvbmVSZXNwb25zZRIbCgR6b25lGAEgASgLMg0uem9uZS52MS5ab25lEiEKBWNoZWNrGAIgASgLMhIuem9uZS52MS5ab25lQ2hlY2siKAoVTGlzdFpvbmVDaGVja3NSZXF1ZXN0Eg8KB3pvbmVfaWQYASABKAkiPAoWTGlzdFpvbmVDaGVja3NSZXNwb25zZRIiCgZjaGVja3MYASADKAsyEi56b25lLnYxLlpvbmVDaGVjayqvAQoKWm9uZVN0YXR1cxIbChdaT05FX1NUQVRVU19VTlNQRUNJRklFRBAAEhcKE1pPTkVfU1RBVFVTX1BFTkRJTkcQARIWChJaT05FX1NUQVRVU19BQ1RJVkUQAhIbChdaT05FX1NUQVRVU19OU19NSVNNQVRDSBADEhsKF1pPTkVfU1RBVFVTX0RFQUNUSVZBVEVEEAQSGQoVWk9ORV9TVEFUVVNfU1VTUEVOREVEEAUylAYKC1pvbmVTZXJ2aWNlEkcKCkNyZWF0ZVpvbmUSGi56b25lLnYxLkNyZWF0ZVpvbmVSZXF1ZXN0Ghsuem9uZS52MS5DcmVhdGVab25lUmVzcG9uc2UiABJECglMaXN0Wm9uZXMSGS56b25lLnYxLkxpc3Rab25lc1JlcXVlc3QaGi56b25lLnYxLkxpc3Rab25lc1Jlc3BvbnNlIgASPgoHR2V0Wm9uZRIXLnpvbmUudjEuR2V0Wm9uZVJlcXVlc3QaGC56b25lLnYxLkdldFpvbmVSZXNwb25zZSIAElAKDUdldFpvbmVCeU5hbWUSHS56b25lLnYxLkdldFpvbmVCeU5hbWVSZXF1ZXN0Gh4uem9uZS52MS5HZXRab25lQnlOYW1lUmVzcG9uc2UiABJHCgpEZWxldGVab25lEhouem9uZS52MS5EZWxldGVab25lUmVxdWVzdBobLnpvbmUudjEuRGVsZXRlWm9uZVJlc3BvbnNlIgASaAoVVXBkYXRlWm9uZU5hbWVzZXJ2ZXJzEiUuem9uZS52MS5VcGRhdGVab25lTmFtZXNlcnZlcnNSZXF1ZXN0GiYuem9uZS52MS5VcGRhdGVab25lTmFtZXNlcnZlcnNSZXNwb25zZSIAEkcKClZlcmlmeVpvbmUSGi56b25lLnYxLlZlcmlmeVpvbmVSZXF1ZXN0Ghsuem9uZS52MS5WZXJpZnlab25lUmVzcG9uc2UiABJNCgxUYWtlb3ZlclpvbmUSHC56b25lLnYxLlRha2VvdmVyWm9uZVJlcXVlc3QaHS56b25lLnYxLlRha2VvdmVyWm9uZVJlc3BvbnNlIgASRAoJQ2hlY2tab25lEhkuem9uZS52MS5DaGVja1pvbmVSZXF1ZXN0Ghouem9uZS52MS5DaGVja1pvbmVSZXNwb25zZSIAElMKDkxpc3Rab25lQ2hlY2tzEh4uem9uZS52MS5MaXN0Wm9uZUNoZWNrc1JlcXVlc3QaHy56b25lLnYxLkxpc3Rab25lQ2hlY2tzUmVzcG9uc2UiAEIbWhlkbnNhcmMvZ2VuL3pvbmUvdjE7em9uZXYxYgZwcm90bzM");
 
 /**
  * @generated from message zone.v1.CreateZoneRequest
@@ -20,6 +20,11 @@ export type CreateZoneRequest = Message<"zone.v1.CreateZoneRequest"> & {
    * @generated from field: string zone_name = 1;
    */
   zoneName: string;
+
+  /**
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId: string;
 };
 
 /**
@@ -57,6 +62,61 @@ export type Zone = Message<"zone.v1.Zone"> & {
    * @generated from field: string updated_at = 5;
    */
   updatedAt: string;
+
+  /**
+   * @generated from field: repeated string nameservers = 6;
+   */
+  nameservers: string[];
+
+  /**
+   * @generated from field: string soa_mbox = 7;
+   */
+  soaMbox: string;
+
+  /**
+   * @generated from field: string verification_token = 8;
+   */
+  verificationToken: string;
+
+  /**
+   * @generated from field: string verification_record = 9;
+   */
+  verificationRecord: string;
+
+  /**
+   * @generated from field: string verified_at = 10;
+   */
+  verifiedAt: string;
+
+  /**
+   * @generated from field: zone.v1.ZoneStatus status = 11;
+   */
+  status: ZoneStatus;
+
+  /**
+   * @generated from field: repeated string observed_nameservers = 12;
+   */
+  observedNameservers: string[];
+
+  /**
+   * @generated from field: string last_checked_at = 13;
+   */
+  lastCheckedAt: string;
+
+  /**
+   * @generated from field: string last_check_error = 14;
+   */
+  lastCheckError: string;
+
+  /**
+   * @generated from field: string organization_id = 15;
+   */
+  organizationId: string;
+
+  /**
+   * @generated from field: repeated string pending_nameservers = 16;
+   */
+  pendingNameservers: string[];
 };
 
 /**
@@ -66,6 +126,43 @@ export type Zone = Message<"zone.v1.Zone"> & {
 export const ZoneSchema: GenMessage<Zone> = /*@__PURE__*/
   messageDesc(file_zone_v1_zone, 1);
 
+/**
+ * @generated from message zone.v1.ZoneCheck
+ */
+export type ZoneCheck = Message<"zone.v1.ZoneCheck"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: zone.v1.ZoneStatus status = 2;
+   */
+  status: ZoneStatus;
+
+  /**
+   * @generated from field: repeated string observed_nameservers = 3;
+   */
+  observedNameservers: string[];
+
+  /**
+   * @generated from field: string error = 4;
+   */
+  error: string;
+
+  /**
+   * @generated from field: string checked_at = 5;
+   */
+  checkedAt: string;
+};
+
+/**
+ * Describes the message zone.v1.ZoneCheck.
+ * Use `create(ZoneCheckSchema)` to create a new message.
+ */
+export const ZoneCheckSchema: GenMessage<ZoneCheck> = /*@__PURE__*/
+  messageDesc(file_zone_v1_zone, 2);
+
 /**
  * @generated from message zone.v1.CreateZoneResponse
  */
@@ -81,12 +178,16 @@ export type CreateZoneResponse = Message<"zone.v1.CreateZoneResponse"> & {
  * Use `create(CreateZoneResponseSchema)` to create a new message.
  */
 export const CreateZoneResponseSchema: GenMessage<CreateZoneResponse> = /*@__PURE__*/
-  messageDesc(file_zone_v1_zone, 2);
+  messageDesc(file_zone_v1_zone, 3);
 
 /**
  * @generated from message zone.v1.ListZonesRequest
  */
 export type ListZonesRequest = Message<"zone.v1.ListZonesRequest"> & {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId: string;
 };
 
 /**
@@ -94,7 +195,7 @@ export type ListZonesRequest = Message<"zone.v1.ListZonesRequest"> & {
  * Use `create(ListZonesRequestSchema)` to create a new message.
  */
 export const ListZonesRequestSchema: GenMessage<ListZonesRequest> = /*@__PURE__*/
-  messageDesc(file_zone_v1_zone, 3);
+  messageDesc(file_zone_v1_zone, 4);
 
 /**
  * @generated from message zone.v1.ListZonesResponse
@@ -111,7 +212,7 @@ export type ListZonesResponse = Message<"zone.v1.ListZonesResponse"> & {
  * Use `create(ListZonesResponseSchema)` to create a new message.
  */
 export const ListZonesResponseSchema: GenMessage<ListZonesResponse> = /*@__PURE__*/
-  messageDesc(file_zone_v1_zone, 4);
+  messageDesc(file_zone_v1_zone, 5);
 
 /**
  * @generated from message zone.v1.GetZoneRequest
@@ -128,7 +229,7 @@ export type GetZoneRequest = Message<"zone.v1.GetZoneRequest"> & {
  * Use `create(GetZoneRequestSchema)` to create a new message.
  */
 export const GetZoneRequestSchema: GenMessage<GetZoneRequest> = /*@__PURE__*/
-  messageDesc(file_zone_v1_zone, 5);
+  messageDesc(file_zone_v1_zone, 6);
 
 /**
  * @generated from message zone.v1.GetZoneResponse
@@ -145,7 +246,7 @@ export type GetZoneResponse = Message<"zone.v1.GetZoneResponse"> & {
  * Use `create(GetZoneResponseSchema)` to create a new message.
  */
 export const GetZoneResponseSchema: GenMessage<GetZoneResponse> = /*@__PURE__*/
-  messageDesc(file_zone_v1_zone, 6);
+  messageDesc(file_zone_v1_zone, 7);
 
 /**
  * @generated from message zone.v1.GetZoneByNameRequest
@@ -155,6 +256,11 @@ export type GetZoneByNameRequest = Message<"zone.v1.GetZoneByNameRequest"> & {
    * @generated from field: string zone_name = 1;
    */
   zoneName: string;
+
+  /**
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId: string;
 };
 
 /**
@@ -162,7 +268,7 @@ export type GetZoneByNameRequest = Message<"zone.v1.GetZoneByNameRequest"> & {
  * Use `create(GetZoneByNameRequestSchema)` to create a new message.
  */
 export const GetZoneByNameRequestSchema: GenMessage<GetZoneByNameRequest> = /*@__PURE__*/
-  messageDesc(file_zone_v1_zone, 7);
+  messageDesc(file_zone_v1_zone, 8);
 
 /**
  * @generated from message zone.v1.GetZoneByNameResponse
@@ -179,7 +285,7 @@ export type GetZoneByNameResponse = Message<"zone.v1.GetZoneByNameResponse"> & {
  * Use `create(GetZoneByNameResponseSchema)` to create a new message.
  */
 export const GetZoneByNameResponseSchema: GenMessage<GetZoneByNameResponse> = /*@__PURE__*/
-  messageDesc(file_zone_v1_zone, 8);
+  messageDesc(file_zone_v1_zone, 9);
 
 /**
  * @generated from message zone.v1.DeleteZoneRequest
@@ -196,7 +302,7 @@ export type DeleteZoneRequest = Message<"zone.v1.DeleteZoneRequest"> & {
  * Use `create(DeleteZoneRequestSchema)` to create a new message.
  */
 export const DeleteZoneRequestSchema: GenMessage<DeleteZoneRequest> = /*@__PURE__*/
-  messageDesc(file_zone_v1_zone, 9);
+  messageDesc(file_zone_v1_zone, 10);
 
 /**
  * @generated from message zone.v1.DeleteZoneResponse
@@ -209,7 +315,233 @@ export type DeleteZoneResponse = Message<"zone.v1.DeleteZoneResponse"> & {
  * Use `create(DeleteZoneResponseSchema)` to create a new message.
  */
 export const DeleteZoneResponseSchema: GenMessage<DeleteZoneResponse> = /*@__PURE__*/
-  messageDesc(file_zone_v1_zone, 10);
+  messageDesc(file_zone_v1_zone, 11);
+
+/**
+ * @generated from message zone.v1.UpdateZoneNameserversRequest
+ */
+export type UpdateZoneNameserversRequest = Message<"zone.v1.UpdateZoneNameserversRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: repeated string nameservers = 2;
+   */
+  nameservers: string[];
+
+  /**
+   * @generated from field: string soa_mbox = 3;
+   */
+  soaMbox: string;
+};
+
+/**
+ * Describes the message zone.v1.UpdateZoneNameserversRequest.
+ * Use `create(UpdateZoneNameserversRequestSchema)` to create a new message.
+ */
+export const UpdateZoneNameserversRequestSchema: GenMessage<UpdateZoneNameserversRequest> = /*@__PURE__*/
+  messageDesc(file_zone_v1_zone, 12);
+
+/**
+ * @generated from message zone.v1.UpdateZoneNameserversResponse
+ */
+export type UpdateZoneNameserversResponse = Message<"zone.v1.UpdateZoneNameserversResponse"> & {
+  /**
+   * @generated from field: zone.v1.Zone zone = 1;
+   */
+  zone?: Zone;
+};
+
+/**
+ * Describes the message zone.v1.UpdateZoneNameserversResponse.
+ * Use `create(UpdateZoneNameserversResponseSchema)` to create a new message.
+ */
+export const UpdateZoneNameserversResponseSchema: GenMessage<UpdateZoneNameserversResponse> = /*@__PURE__*/
+  messageDesc(file_zone_v1_zone, 13);
+
+/**
+ * @generated from message zone.v1.VerifyZoneRequest
+ */
+export type VerifyZoneRequest = Message<"zone.v1.VerifyZoneRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message zone.v1.VerifyZoneRequest.
+ * Use `create(VerifyZoneRequestSchema)` to create a new message.
+ */
+export const VerifyZoneRequestSchema: GenMessage<VerifyZoneRequest> = /*@__PURE__*/
+  messageDesc(file_zone_v1_zone, 14);
+
+/**
+ * @generated from message zone.v1.VerifyZoneResponse
+ */
+export type VerifyZoneResponse = Message<"zone.v1.VerifyZoneResponse"> & {
+  /**
+   * @generated from field: zone.v1.Zone zone = 1;
+   */
+  zone?: Zone;
+};
+
+/**
+ * Describes the message zone.v1.VerifyZoneResponse.
+ * Use `create(VerifyZoneResponseSchema)` to create a new message.
+ */
+export const VerifyZoneResponseSchema: GenMessage<VerifyZoneResponse> = /*@__PURE__*/
+  messageDesc(file_zone_v1_zone, 15);
+
+/**
+ * @generated from message zone.v1.TakeoverZoneRequest
+ */
+export type TakeoverZoneRequest = Message<"zone.v1.TakeoverZoneRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message zone.v1.TakeoverZoneRequest.
+ * Use `create(TakeoverZoneRequestSchema)` to create a new message.
+ */
+export const TakeoverZoneRequestSchema: GenMessage<TakeoverZoneRequest> = /*@__PURE__*/
+  messageDesc(file_zone_v1_zone, 16);
+
+/**
+ * @generated from message zone.v1.TakeoverZoneResponse
+ */
+export type TakeoverZoneResponse = Message<"zone.v1.TakeoverZoneResponse"> & {
+  /**
+   * @generated from field: zone.v1.Zone zone = 1;
+   */
+  zone?: Zone;
+};
+
+/**
+ * Describes the message zone.v1.TakeoverZoneResponse.
+ * Use `create(TakeoverZoneResponseSchema)` to create a new message.
+ */
+export const TakeoverZoneResponseSchema: GenMessage<TakeoverZoneResponse> = /*@__PURE__*/
+  messageDesc(file_zone_v1_zone, 17);
+
+/**
+ * @generated from message zone.v1.CheckZoneRequest
+ */
+export type CheckZoneRequest = Message<"zone.v1.CheckZoneRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message zone.v1.CheckZoneRequest.
+ * Use `create(CheckZoneRequestSchema)` to create a new message.
+ */
+export const CheckZoneRequestSchema: GenMessage<CheckZoneRequest> = /*@__PURE__*/
+  messageDesc(file_zone_v1_zone, 18);
+
+/**
+ * @generated from message zone.v1.CheckZoneResponse
+ */
+export type CheckZoneResponse = Message<"zone.v1.CheckZoneResponse"> & {
+  /**
+   * @generated from field: zone.v1.Zone zone = 1;
+   */
+  zone?: Zone;
+
+  /**
+   * @generated from field: zone.v1.ZoneCheck check = 2;
+   */
+  check?: ZoneCheck;
+};
+
+/**
+ * Describes the message zone.v1.CheckZoneResponse.
+ * Use `create(CheckZoneResponseSchema)` to create a new message.
+ */
+export const CheckZoneResponseSchema: GenMessage<CheckZoneResponse> = /*@__PURE__*/
+  messageDesc(file_zone_v1_zone, 19);
+
+/**
+ * @generated from message zone.v1.ListZoneChecksRequest
+ */
+export type ListZoneChecksRequest = Message<"zone.v1.ListZoneChecksRequest"> & {
+  /**
+   * @generated from field: string zone_id = 1;
+   */
+  zoneId: string;
+};
+
+/**
+ * Describes the message zone.v1.ListZoneChecksRequest.
+ * Use `create(ListZoneChecksRequestSchema)` to create a new message.
+ */
+export const ListZoneChecksRequestSchema: GenMessage<ListZoneChecksRequest> = /*@__PURE__*/
+  messageDesc(file_zone_v1_zone, 20);
+
+/**
+ * @generated from message zone.v1.ListZoneChecksResponse
+ */
+export type ListZoneChecksResponse = Message<"zone.v1.ListZoneChecksResponse"> & {
+  /**
+   * @generated from field: repeated zone.v1.ZoneCheck checks = 1;
+   */
+  checks: ZoneCheck[];
+};
+
+/**
+ * Describes the message zone.v1.ListZoneChecksResponse.
+ * Use `create(ListZoneChecksResponseSchema)` to create a new message.
+ */
+export const ListZoneChecksResponseSchema: GenMessage<ListZoneChecksResponse> = /*@__PURE__*/
+  messageDesc(file_zone_v1_zone, 21);
+
+/**
+ * @generated from enum zone.v1.ZoneStatus
+ */
+export enum ZoneStatus {
+  /**
+   * @generated from enum value: ZONE_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ZONE_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: ZONE_STATUS_ACTIVE = 2;
+   */
+  ACTIVE = 2,
+
+  /**
+   * @generated from enum value: ZONE_STATUS_NS_MISMATCH = 3;
+   */
+  NS_MISMATCH = 3,
+
+  /**
+   * @generated from enum value: ZONE_STATUS_DEACTIVATED = 4;
+   */
+  DEACTIVATED = 4,
+
+  /**
+   * @generated from enum value: ZONE_STATUS_SUSPENDED = 5;
+   */
+  SUSPENDED = 5,
+}
+
+/**
+ * Describes the enum zone.v1.ZoneStatus.
+ */
+export const ZoneStatusSchema: GenEnum<ZoneStatus> = /*@__PURE__*/
+  enumDesc(file_zone_v1_zone, 0);
 
 /**
  * @generated from service zone.v1.ZoneService
@@ -255,6 +587,46 @@ export const ZoneService: GenService<{
     input: typeof DeleteZoneRequestSchema;
     output: typeof DeleteZoneResponseSchema;
   },
+  /**
+   * @generated from rpc zone.v1.ZoneService.UpdateZoneNameservers
+   */
+  updateZoneNameservers: {
+    methodKind: "unary";
+    input: typeof UpdateZoneNameserversRequestSchema;
+    output: typeof UpdateZoneNameserversResponseSchema;
+  },
+  /**
+   * @generated from rpc zone.v1.ZoneService.VerifyZone
+   */
+  verifyZone: {
+    methodKind: "unary";
+    input: typeof VerifyZoneRequestSchema;
+    output: typeof VerifyZoneResponseSchema;
+  },
+  /**
+   * @generated from rpc zone.v1.ZoneService.TakeoverZone
+   */
+  takeoverZone: {
+    methodKind: "unary";
+    input: typeof TakeoverZoneRequestSchema;
+    output: typeof TakeoverZoneResponseSchema;
+  },
+  /**
+   * @generated from rpc zone.v1.ZoneService.CheckZone
+   */
+  checkZone: {
+    methodKind: "unary";
+    input: typeof CheckZoneRequestSchema;
+    output: typeof CheckZoneResponseSchema;
+  },
+  /**
+   * @generated from rpc zone.v1.ZoneService.ListZoneChecks
+   */
+  listZoneChecks: {
+    methodKind: "unary";
+    input: typeof ListZoneChecksRequestSchema;
+    output: typeof ListZoneChecksResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_zone_v1_zone, 0);
 